@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/xryar/golang-grpc-ecommerce/internal/repository"
+	"github.com/xryar/golang-grpc-ecommerce/internal/service"
+	"github.com/xryar/golang-grpc-ecommerce/internal/storage"
+	"github.com/xryar/golang-grpc-ecommerce/pkg/database"
+)
+
+const usage = `Usage: cli <command> [flags]
+
+Commands:
+  cleanup-images       remove uploaded product images no product refers to
+  backfill-renditions  create the missing renditions of product images
+                       uploaded before renditions existed
+`
+
+func main() {
+	godotenv.Load()
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	switch os.Args[1] {
+	case "cleanup-images":
+		cleanupImages(os.Args[2:])
+	case "backfill-renditions":
+		backfillRenditions(os.Args[2:])
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n%s", os.Args[1], usage)
+		os.Exit(2)
+	}
+}
+
+func cleanupImages(args []string) {
+	defaultGracePeriod, err := time.ParseDuration(os.Getenv("PRODUCT_IMAGE_CLEANUP_GRACE_PERIOD"))
+	if err != nil {
+		defaultGracePeriod = time.Hour * 24
+	}
+
+	flags := flag.NewFlagSet("cleanup-images", flag.ExitOnError)
+	dryRun := flags.Bool("dry-run", false, "only list the files that would be removed")
+	gracePeriod := flags.Duration("grace-period", defaultGracePeriod, "keep uploads younger than this")
+	flags.Parse(args)
+
+	ctx := context.Background()
+	db := database.ConnectDB(ctx, os.Getenv("DB_URI"))
+
+	productStorage, err := storage.NewProductStorageFromEnv(ctx)
+	if err != nil {
+		log.Panicf("Error when creating storage %v", err)
+	}
+
+	productRepository := repository.NewProductRepository(db)
+	productImageCleanupService := service.NewProductImageCleanupService(productRepository, productStorage, *gracePeriod)
+
+	removed, err := productImageCleanupService.CleanupOrphanedImages(ctx, *dryRun)
+	for _, fileName := range removed {
+		fmt.Println(fileName)
+	}
+	if err != nil {
+		log.Fatalf("Error when cleaning up images %v", err)
+	}
+
+	if *dryRun {
+		fmt.Printf("%d files would be removed\n", len(removed))
+	} else {
+		fmt.Printf("%d files removed\n", len(removed))
+	}
+}
+
+func backfillRenditions(args []string) {
+	flags := flag.NewFlagSet("backfill-renditions", flag.ExitOnError)
+	dryRun := flags.Bool("dry-run", false, "only list the files that would be created")
+	flags.Parse(args)
+
+	ctx := context.Background()
+	db := database.ConnectDB(ctx, os.Getenv("DB_URI"))
+
+	productStorage, err := storage.NewProductStorageFromEnv(ctx)
+	if err != nil {
+		log.Panicf("Error when creating storage %v", err)
+	}
+
+	productRepository := repository.NewProductRepository(db)
+	productImageRenditionService := service.NewProductImageRenditionService(productRepository, productStorage)
+
+	created, err := productImageRenditionService.BackfillRenditions(ctx, *dryRun)
+	for _, fileName := range created {
+		fmt.Println(fileName)
+	}
+	if err != nil {
+		log.Fatalf("Error when backfilling renditions %v", err)
+	}
+
+	if *dryRun {
+		fmt.Printf("%d files would be created\n", len(created))
+	} else {
+		fmt.Printf("%d files created\n", len(created))
+	}
+}
@@ -5,18 +5,28 @@ import (
 	"log"
 	"net"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/xendit/xendit-go"
+	"github.com/xryar/golang-grpc-ecommerce/internal/downloadlink"
 	"github.com/xryar/golang-grpc-ecommerce/internal/grpcmiddleware"
 	"github.com/xryar/golang-grpc-ecommerce/internal/handler"
 	"github.com/xryar/golang-grpc-ecommerce/internal/repository"
+	"github.com/xryar/golang-grpc-ecommerce/internal/scheduler"
 	"github.com/xryar/golang-grpc-ecommerce/internal/service"
+	"github.com/xryar/golang-grpc-ecommerce/internal/storage"
 	"github.com/xryar/golang-grpc-ecommerce/pb/auth"
 	"github.com/xryar/golang-grpc-ecommerce/pb/cart"
+	"github.com/xryar/golang-grpc-ecommerce/pb/category"
+	"github.com/xryar/golang-grpc-ecommerce/pb/collection"
+	"github.com/xryar/golang-grpc-ecommerce/pb/currency"
 	"github.com/xryar/golang-grpc-ecommerce/pb/order"
 	"github.com/xryar/golang-grpc-ecommerce/pb/product"
+	"github.com/xryar/golang-grpc-ecommerce/pb/recommendation"
+	"github.com/xryar/golang-grpc-ecommerce/pb/review"
+	"github.com/xryar/golang-grpc-ecommerce/pb/wishlist"
 	"github.com/xryar/golang-grpc-ecommerce/pkg/database"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
@@ -44,29 +54,106 @@ func main() {
 	authService := service.NewAuthService(authRepository, cacheService)
 	authHandler := handler.NewAuthHandler(authService)
 
+	categoryRepository := repository.NewCategoryRepository(db)
+	categoryService := service.NewCategoryService(categoryRepository)
+	categoryHandler := handler.NewCategoryHandler(categoryService)
+
+	productStorage, err := storage.NewProductStorageFromEnv(ctx)
+	if err != nil {
+		log.Panicf("Error when creating storage %v", err)
+	}
+	digitalStorage, err := storage.NewDigitalStorageFromEnv(ctx)
+	if err != nil {
+		log.Panicf("Error when creating storage %v", err)
+	}
+	downloadSigner, err := downloadlink.NewSignerFromEnv()
+	if err != nil {
+		log.Panicf("Error when creating download signer %v", err)
+	}
+
+	exchangeRateRepository := repository.NewExchangeRateRepository(db)
+	currencyService := service.NewCurrencyService(exchangeRateRepository)
+	currencyHandler := handler.NewCurrencyHandler(currencyService)
+
+	collectionRepository := repository.NewCollectionRepository(db)
 	productRepository := repository.NewProductRepository(db)
-	productService := service.NewProductService(productRepository)
+	productService := service.NewProductService(db, productRepository, categoryRepository, collectionRepository, exchangeRateRepository, productStorage, digitalStorage)
 	productHandler := handler.NewProductHandler(productService)
 
+	collectionService := service.NewCollectionService(db, collectionRepository, productRepository)
+	collectionHandler := handler.NewCollectionHandler(collectionService)
+
 	cartRepository := repository.NewCartRepository(db)
-	cartService := service.NewCartService(productRepository, cartRepository)
+	cartService := service.NewCartService(productRepository, cartRepository, exchangeRateRepository, productStorage)
 	cartHandler := handler.NewCartHandler(cartService)
 
+	wishlistRepository := repository.NewWishlistRepository(db)
+	wishlistService := service.NewWishlistService(productRepository, wishlistRepository, cartService, productStorage)
+	wishlistHandler := handler.NewWishlistHandler(wishlistService)
+
 	orderRepository := repository.NewOrderRepository(db)
-	orderService := service.NewOrderService(db, orderRepository, productRepository)
+	downloadEntitlementRepository := repository.NewDownloadEntitlementRepository(db)
+	orderService := service.NewOrderService(db, orderRepository, productRepository, exchangeRateRepository, downloadEntitlementRepository, downloadSigner)
 	orderHandler := handler.NewOrderHandler(orderService)
 
+	reviewRepository := repository.NewReviewRepository(db)
+	reviewService := service.NewReviewService(reviewRepository, productRepository)
+	reviewHandler := handler.NewReviewHandler(reviewService)
+
+	recommendationRepository := repository.NewRecommendationRepository(db)
+	recommendationService := service.NewRecommendationService(db, recommendationRepository, cartRepository, productStorage)
+	recommendationHandler := handler.NewRecommendationHandler(recommendationService)
+
+	orderExpiryInterval, err := time.ParseDuration(os.Getenv("ORDER_EXPIRY_INTERVAL"))
+	if err != nil {
+		orderExpiryInterval = time.Minute
+	}
+	digitalDownloadLimit, err := strconv.ParseInt(os.Getenv("DIGITAL_DOWNLOAD_LIMIT"), 10, 64)
+	if err != nil || digitalDownloadLimit <= 0 {
+		digitalDownloadLimit = 5
+	}
+	webhookService := service.NewWebhookService(db, orderRepository, productRepository, downloadEntitlementRepository, digitalDownloadLimit)
+	orderExpiryService := service.NewOrderExpiryService(db, orderRepository, productRepository, webhookService)
+	scheduler.Start(ctx, "order expiry", orderExpiryInterval, orderExpiryService.ExpireOrders)
+
+	recommendationRefreshInterval, err := time.ParseDuration(os.Getenv("RECOMMENDATION_REFRESH_INTERVAL"))
+	if err != nil {
+		recommendationRefreshInterval = time.Hour
+	}
+	scheduler.Start(ctx, "recommendation refresh", recommendationRefreshInterval, recommendationService.RefreshRecommendations)
+
+	productImageCleanupInterval, err := time.ParseDuration(os.Getenv("PRODUCT_IMAGE_CLEANUP_INTERVAL"))
+	if err != nil {
+		productImageCleanupInterval = time.Hour * 6
+	}
+	productImageCleanupGracePeriod, err := time.ParseDuration(os.Getenv("PRODUCT_IMAGE_CLEANUP_GRACE_PERIOD"))
+	if err != nil {
+		productImageCleanupGracePeriod = time.Hour * 24
+	}
+	productImageCleanupService := service.NewProductImageCleanupService(productRepository, productStorage, productImageCleanupGracePeriod)
+	scheduler.Start(ctx, "product image cleanup", productImageCleanupInterval, productImageCleanupService.RemoveOrphanedImages)
+
 	server := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
 			grpcmiddleware.ErrorMiddleware,
 			authMiddleware.Middleware,
 		),
+		grpc.ChainStreamInterceptor(
+			grpcmiddleware.ErrorStreamMiddleware,
+			authMiddleware.StreamMiddleware,
+		),
 	)
 
 	auth.RegisterAuthServiceServer(server, authHandler)
 	product.RegisterProductServiceServer(server, productHandler)
+	category.RegisterCategoryServiceServer(server, categoryHandler)
+	currency.RegisterCurrencyServiceServer(server, currencyHandler)
+	collection.RegisterCollectionServiceServer(server, collectionHandler)
 	cart.RegisterCartServiceServer(server, cartHandler)
+	wishlist.RegisterWishlistServiceServer(server, wishlistHandler)
 	order.RegisterOrderServiceServer(server, orderHandler)
+	review.RegisterReviewServiceServer(server, reviewHandler)
+	recommendation.RegisterRecommendationServiceServer(server, recommendationHandler)
 
 	if os.Getenv("ENVIRONTMENT") == "dev" {
 		reflection.Register(server)
@@ -2,61 +2,111 @@ package main
 
 import (
 	"context"
+	"errors"
 	"log"
 	"mime"
 	"net/http"
 	"os"
 	"path"
+	"strconv"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
 	"github.com/joho/godotenv"
+	"github.com/xryar/golang-grpc-ecommerce/internal/downloadlink"
 	"github.com/xryar/golang-grpc-ecommerce/internal/handler"
+	"github.com/xryar/golang-grpc-ecommerce/internal/imageprocessor"
 	"github.com/xryar/golang-grpc-ecommerce/internal/repository"
+	"github.com/xryar/golang-grpc-ecommerce/internal/restmiddleware"
 	"github.com/xryar/golang-grpc-ecommerce/internal/service"
+	"github.com/xryar/golang-grpc-ecommerce/internal/storage"
 	"github.com/xryar/golang-grpc-ecommerce/pkg/database"
 )
 
-func handleGetFileName(c *fiber.Ctx) error {
-	fileNameParam := c.Params("filename")
-	filePath := path.Join("storage", "product", fileNameParam)
-	if _, err := os.Stat(filePath); err != nil {
-		if os.IsNotExist(err) {
-			return c.Status(http.StatusNotFound).SendString("Not Found")
+func handleGetFileName(fileStorage storage.IStorage) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		fileNameParam := c.Params("filename")
+		file, err := fileStorage.Open(c.UserContext(), fileNameParam)
+		if errors.Is(err, storage.ErrNotFound) {
+			// images uploaded before renditions existed only have the original
+			if originalFileName, ok := imageprocessor.OriginalFileName(fileNameParam); ok {
+				file, err = fileStorage.Open(c.UserContext(), originalFileName)
+			}
 		}
+		if err != nil {
+			if errors.Is(err, storage.ErrNotFound) {
+				return c.Status(http.StatusNotFound).SendString("Not Found")
+			}
 
-		log.Println(err)
-		return c.Status(http.StatusInternalServerError).SendString("Internal Server Error")
-	}
-
-	file, err := os.Open(filePath)
-	if err != nil {
-		log.Println(err)
-		return c.Status(http.StatusInternalServerError).SendString("Internal Server Error")
-	}
+			log.Println(err)
+			return c.Status(http.StatusInternalServerError).SendString("Internal Server Error")
+		}
 
-	ext := path.Ext(filePath)
-	mimeType := mime.TypeByExtension(ext)
+		ext := path.Ext(fileNameParam)
+		mimeType := mime.TypeByExtension(ext)
 
-	c.Set("Content-Type", mimeType)
-	return c.SendStream(file)
+		c.Set("Content-Type", mimeType)
+		return c.SendStream(file)
+	}
 }
 
 func main() {
 	godotenv.Load()
 	ctx := context.Background()
-	app := fiber.New()
+	// large enough for csv imports, images are limited by the image processor
+	app := fiber.New(fiber.Config{
+		BodyLimit: 32 << 20,
+	})
 
 	db := database.ConnectDB(ctx, os.Getenv("DB_URI"))
 	log.Println("Connected to database")
 
+	productStorage, err := storage.NewProductStorageFromEnv(ctx)
+	if err != nil {
+		log.Panicf("Error when creating storage %v", err)
+	}
+	digitalStorage, err := storage.NewDigitalStorageFromEnv(ctx)
+	if err != nil {
+		log.Panicf("Error when creating storage %v", err)
+	}
+	downloadSigner, err := downloadlink.NewSignerFromEnv()
+	if err != nil {
+		log.Panicf("Error when creating download signer %v", err)
+	}
+
+	xenditCallbackToken := os.Getenv("XENDIT_CALLBACK_TOKEN")
+	if xenditCallbackToken == "" {
+		log.Panic("XENDIT_CALLBACK_TOKEN is not set")
+	}
+
+	digitalDownloadLimit, err := strconv.ParseInt(os.Getenv("DIGITAL_DOWNLOAD_LIMIT"), 10, 64)
+	if err != nil || digitalDownloadLimit <= 0 {
+		digitalDownloadLimit = 5
+	}
+
 	orderRepository := repository.NewOrderRepository(db)
-	webhookService := service.NewWebhookService(orderRepository)
-	webhookHandler := handler.NewWebhookHandler(webhookService)
+	productRepository := repository.NewProductRepository(db)
+	downloadEntitlementRepository := repository.NewDownloadEntitlementRepository(db)
+	webhookService := service.NewWebhookService(db, orderRepository, productRepository, downloadEntitlementRepository, digitalDownloadLimit)
+	webhookHandler := handler.NewWebhookHandler(webhookService, xenditCallbackToken)
+	productUploadImageHandler := handler.NewProductUploadImageHandler(productStorage)
+	productUploadDigitalFileHandler := handler.NewProductUploadDigitalFileHandler(digitalStorage)
+	downloadService := service.NewDownloadService(downloadEntitlementRepository, digitalStorage, downloadSigner)
+	downloadHandler := handler.NewDownloadHandler(downloadService)
+
+	categoryRepository := repository.NewCategoryRepository(db)
+	collectionRepository := repository.NewCollectionRepository(db)
+	exchangeRateRepository := repository.NewExchangeRateRepository(db)
+	productService := service.NewProductService(db, productRepository, categoryRepository, collectionRepository, exchangeRateRepository, productStorage, digitalStorage)
+	productCsvHandler := handler.NewProductCsvHandler(productService)
 
 	app.Use(cors.New())
-	app.Get("/storage/product/:filename", handleGetFileName)
-	app.Post("/product/upload", handler.UploadProductImageHandler)
+	app.Get("/storage/product/:filename", handleGetFileName(productStorage))
+	app.Get("/storage/download/:id", downloadHandler.Download)
+	app.Post("/product/upload", productUploadImageHandler.UploadProductImage)
+	app.Post("/product/digital/upload", restmiddleware.AdminMiddleware, productUploadDigitalFileHandler.UploadProductDigitalFile)
+	app.Post("/product/import", restmiddleware.AdminMiddleware, productCsvHandler.ImportProduct)
+	app.Get("/product/export", restmiddleware.AdminMiddleware, productCsvHandler.ExportProduct)
 	app.Post("/webhook/xendit/invoice", webhookHandler.ReceiveInvoice)
 
 	app.Listen(":3000")
@@ -2,15 +2,30 @@ package entity
 
 import "time"
 
+// the reasons a cart item may not be ordered as it is
+const (
+	CartWarningUnavailable         = "unavailable"
+	CartWarningOutOfStock          = "out_of_stock"
+	CartWarningReducedAvailability = "reduced_availability"
+	CartWarningBelowMinQuantity    = "below_min_quantity"
+	CartWarningAboveMaxQuantity    = "above_max_quantity"
+	CartWarningPriceChanged        = "price_changed"
+)
+
 type UserCart struct {
-	Id        string
-	UserId    string
-	ProductId string
-	Quantity  int
-	CreatedAt time.Time
-	CreatedBy string
-	UpdateAt  *time.Time
-	UpdatedBy *string
+	Id               string
+	UserId           string
+	ProductId        string
+	ProductVariantId *string
+	Quantity         int
+	CreatedAt        time.Time
+	CreatedBy        string
+	UpdateAt         *time.Time
+	UpdatedBy        *string
+	// the price when the item was last added or updated, nil for items
+	// added before prices were kept
+	Price *float64
 
-	Product *Product
+	Product        *Product
+	ProductVariant *ProductVariant
 }
@@ -0,0 +1,16 @@
+package entity
+
+import "time"
+
+type Category struct {
+	Id        string
+	Name      string
+	ParentId  *string
+	CreatedAt time.Time
+	CreatedBy string
+	UpdatedAt *time.Time
+	UpdatedBy *string
+	DeletedAt *time.Time
+	DeletedBy *string
+	IsDeleted bool
+}
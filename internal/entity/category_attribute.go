@@ -0,0 +1,40 @@
+package entity
+
+import "time"
+
+const (
+	CategoryAttributeTypeText    = "text"
+	CategoryAttributeTypeNumber  = "number"
+	CategoryAttributeTypeBoolean = "boolean"
+)
+
+// CategoryAttribute is a typed property, like brand or weight, that products
+// of the category and of its subcategories can have. Code names it in
+// product filters and is shared by attributes of different categories that
+// mean the same thing.
+type CategoryAttribute struct {
+	Id         string
+	CategoryId string
+	Code       string
+	Name       string
+	Type       string
+	Unit       *string
+	CreatedAt  time.Time
+	CreatedBy  string
+	UpdatedAt  *time.Time
+	UpdatedBy  *string
+	DeletedAt  *time.Time
+	DeletedBy  *string
+	IsDeleted  bool
+}
+
+// ProductAttributeValue is the value a product has for an attribute. Every
+// value is kept as text, ValueNumber is also set for number attributes.
+type ProductAttributeValue struct {
+	ProductId   string
+	AttributeId string
+	ValueText   string
+	ValueNumber *float64
+
+	Attribute *CategoryAttribute
+}
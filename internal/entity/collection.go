@@ -0,0 +1,28 @@
+package entity
+
+import "time"
+
+const (
+	CollectionRuleTypeManual     = "manual"
+	CollectionRuleTypeBestSeller = "best_seller"
+	CollectionRuleTypeNewest     = "newest"
+)
+
+type Collection struct {
+	Id           string
+	Key          string
+	Name         string
+	RuleType     string
+	RuleDays     int32
+	ProductLimit int32
+	StartsAt     *time.Time
+	EndsAt       *time.Time
+	ProductIds   []string
+	CreatedAt    time.Time
+	CreatedBy    string
+	UpdatedAt    *time.Time
+	UpdatedBy    *string
+	DeletedAt    *time.Time
+	DeletedBy    *string
+	IsDeleted    bool
+}
@@ -0,0 +1,20 @@
+package entity
+
+import "time"
+
+// DownloadEntitlement lets the buyer of a digital product download FileName
+// up to MaxDownloads times.
+type DownloadEntitlement struct {
+	Id               string
+	OrderId          string
+	OrderItemId      string
+	UserId           string
+	ProductId        string
+	ProductName      string
+	FileName         string
+	MaxDownloads     int64
+	DownloadCount    int64
+	LastDownloadedAt *time.Time
+	CreatedAt        time.Time
+	CreatedBy        string
+}
@@ -0,0 +1,28 @@
+package entity
+
+import "time"
+
+// CurrencyIDR is the currency prices are stored and orders are paid in.
+const CurrencyIDR = "IDR"
+
+// CurrencyDecimals lists the currencies prices can be shown in and how many
+// decimals their prices are rounded to.
+var CurrencyDecimals = map[string]int{
+	CurrencyIDR: 0,
+	"MYR":       2,
+	"SGD":       2,
+}
+
+// ExchangeRate is how many IDR one unit of CurrencyCode is worth from
+// EffectiveAt until the next rate of the currency takes effect.
+type ExchangeRate struct {
+	Id           string
+	CurrencyCode string
+	Rate         float64
+	EffectiveAt  time.Time
+	CreatedAt    time.Time
+	CreatedBy    string
+	DeletedAt    *time.Time
+	DeletedBy    *string
+	IsDeleted    bool
+}
@@ -34,23 +34,33 @@ type Order struct {
 	XenditPaidAt         *time.Time
 	XenditPaymentMethod  *string
 	XenditPaymentChannel *string
+	Currency             string
+	DisplayCurrency      string
+	ExchangeRate         float64
 
 	Items []*OrderItem
 }
 
 type OrderItem struct {
-	Id                   string
-	ProductId            string
-	ProductName          string
-	ProductImageFileName string
-	ProductPrice         float64
-	Quantity             int64
-	OrderId              string
-	CreatedAt            time.Time
-	CreatedBy            string
-	UpdatedAt            *time.Time
-	UpdatedBy            *string
-	DeletedAt            *time.Time
-	DeletedBy            *string
-	IsDeleted            bool
+	Id                       string
+	ProductId                string
+	ProductVariantId         *string
+	ProductVariantSku        *string
+	ProductVariantAttributes ProductVariantAttributes
+	ProductName              string
+	ProductImageFileName     string
+	ProductPrice             float64
+	ProductOriginalPrice     float64
+	Quantity                 int64
+	BundleProductId          *string
+	BundleProductName        *string
+	ProductDigitalFileName   *string
+	OrderId                  string
+	CreatedAt                time.Time
+	CreatedBy                string
+	UpdatedAt                *time.Time
+	UpdatedBy                *string
+	DeletedAt                *time.Time
+	DeletedBy                *string
+	IsDeleted                bool
 }
@@ -2,17 +2,66 @@ package entity
 
 import "time"
 
+const (
+	ProductStatusDraft     = "draft"
+	ProductStatusPublished = "published"
+	ProductStatusArchived  = "archived"
+)
+
+const (
+	ProductTypeSimple = "simple"
+	// ProductTypeBundle is sold as one item but made of other products,
+	// its stock is whatever its components allow
+	ProductTypeBundle = "bundle"
+	// ProductTypeDigital is delivered as a download once its order is paid,
+	// it is never shipped
+	ProductTypeDigital = "digital"
+)
+
 type Product struct {
-	Id            string
-	Name          string
-	Description   string
-	Price         float64
-	ImageFileName string
-	CreatedAt     time.Time
-	CreatedBy     string
-	UpdatedAt     time.Time
-	UpdatedBy     *string
-	DeletedAt     time.Time
-	DeletedBy     *string
-	IsDeleted     bool
+	Id             string
+	Sku            *string
+	Slug           string
+	Name           string
+	Description    string
+	SeoTitle       *string
+	SeoDescription *string
+	Price          float64
+	SalePrice      *float64
+	SaleStartsAt   *time.Time
+	SaleEndsAt     *time.Time
+	ImageFileName  string
+	Stock          int64
+	CategoryId     *string
+	HasVariants    bool
+	RatingAverage  float64
+	ReviewCount    int64
+	CreatedAt      time.Time
+	CreatedBy      string
+	UpdatedAt      time.Time
+	UpdatedBy      *string
+	DeletedAt      time.Time
+	DeletedBy      *string
+	IsDeleted      bool
+	Status         string
+	PublishAt      *time.Time
+	Type           string
+	// the file of a digital product in the digital storage
+	DigitalFileName *string
+	// how many units one order may contain, nil when unlimited
+	MinOrderQuantity *int64
+	MaxOrderQuantity *int64
+}
+
+// ProductBundleItem is a component of a bundle, Quantity units of Product
+// go into one bundle.
+type ProductBundleItem struct {
+	Id              string
+	BundleProductId string
+	ProductId       string
+	Quantity        int64
+	CreatedAt       time.Time
+	CreatedBy       string
+
+	Product *Product
 }
@@ -0,0 +1,15 @@
+package entity
+
+import "time"
+
+type ProductImage struct {
+	Id            string
+	ProductId     string
+	ImageFileName string
+	Position      int
+	IsPrimary     bool
+	CreatedAt     time.Time
+	CreatedBy     string
+	UpdatedAt     *time.Time
+	UpdatedBy     *string
+}
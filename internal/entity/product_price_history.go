@@ -0,0 +1,14 @@
+package entity
+
+import "time"
+
+type ProductPriceHistory struct {
+	Id           string
+	ProductId    string
+	Price        float64
+	SalePrice    *float64
+	SaleStartsAt *time.Time
+	SaleEndsAt   *time.Time
+	CreatedAt    time.Time
+	CreatedBy    string
+}
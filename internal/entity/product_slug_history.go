@@ -0,0 +1,11 @@
+package entity
+
+import "time"
+
+type ProductSlugHistory struct {
+	Id        string
+	ProductId string
+	Slug      string
+	CreatedAt time.Time
+	CreatedBy string
+}
@@ -0,0 +1,59 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type ProductVariant struct {
+	Id            string
+	ProductId     string
+	Sku           string
+	Attributes    ProductVariantAttributes
+	Price         *float64
+	ImageFileName *string
+	Stock         int64
+	CreatedAt     time.Time
+	CreatedBy     string
+	UpdatedAt     *time.Time
+	UpdatedBy     *string
+	DeletedAt     *time.Time
+	DeletedBy     *string
+	IsDeleted     bool
+}
+
+// ProductVariantAttributes holds the options that make a variant, e.g. size
+// and colour. It is stored as a JSONB object.
+type ProductVariantAttributes map[string]string
+
+func (a ProductVariantAttributes) Value() (driver.Value, error) {
+	if a == nil {
+		return nil, nil
+	}
+
+	b, err := json.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(b), nil
+}
+
+func (a *ProductVariantAttributes) Scan(src any) error {
+	var b []byte
+	switch v := src.(type) {
+	case nil:
+		*a = nil
+		return nil
+	case []byte:
+		b = v
+	case string:
+		b = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan %T into ProductVariantAttributes", src)
+	}
+
+	return json.Unmarshal(b, a)
+}
@@ -0,0 +1,8 @@
+package entity
+
+// ProductRecommendation is a product that was bought together with others in
+// OrderCount paid orders.
+type ProductRecommendation struct {
+	Product    *Product
+	OrderCount int64
+}
@@ -0,0 +1,27 @@
+package entity
+
+import "time"
+
+const (
+	ReviewStatusCodePending  = "pending"
+	ReviewStatusCodeApproved = "approved"
+	ReviewStatusCodeHidden   = "hidden"
+)
+
+type Review struct {
+	Id           string
+	ProductId    string
+	ProductName  string
+	UserId       string
+	UserFullName string
+	Rating       int32
+	Comment      string
+	StatusCode   string
+	CreatedAt    time.Time
+	CreatedBy    string
+	UpdatedAt    *time.Time
+	UpdatedBy    *string
+	DeletedAt    *time.Time
+	DeletedBy    *string
+	IsDeleted    bool
+}
@@ -0,0 +1,16 @@
+package entity
+
+import "time"
+
+type UserWishlist struct {
+	Id               string
+	UserId           string
+	ProductId        string
+	ProductVariantId *string
+	PriceWhenAdded   float64
+	CreatedAt        time.Time
+	CreatedBy        string
+
+	Product        *Product
+	ProductVariant *ProductVariant
+}
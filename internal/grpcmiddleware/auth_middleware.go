@@ -15,11 +15,15 @@ type authMiddleware struct {
 }
 
 var publicApis = map[string]bool{
-	"/auth.AuthService/Login":                   true,
-	"/auth.AuthService/Register":                true,
-	"/product.ProductService/DetailProduct":     true,
-	"/product.ProductService/ListProduct":       true,
-	"/product.ProductService/HighlightProducts": true,
+	"/auth.AuthService/Login":                                        true,
+	"/auth.AuthService/Register":                                     true,
+	"/product.ProductService/DetailProduct":                          true,
+	"/product.ProductService/ListProduct":                            true,
+	"/product.ProductService/HighlightProducts":                      true,
+	"/category.CategoryService/ListCategory":                         true,
+	"/category.CategoryService/ListCategoryAttribute":                true,
+	"/review.ReviewService/ListReview":                               true,
+	"/recommendation.RecommendationService/FrequentlyBoughtTogether": true,
 }
 
 func (am *authMiddleware) Middleware(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
@@ -27,6 +31,30 @@ func (am *authMiddleware) Middleware(ctx context.Context, req any, info *grpc.Un
 		return handler(ctx, req)
 	}
 
+	ctx, err = am.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := handler(ctx, req)
+
+	return res, err
+}
+
+func (am *authMiddleware) StreamMiddleware(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if publicApis[info.FullMethod] {
+		return handler(srv, ss)
+	}
+
+	ctx, err := am.authenticate(ss.Context())
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, &authServerStream{ServerStream: ss, ctx: ctx})
+}
+
+func (am *authMiddleware) authenticate(ctx context.Context) (context.Context, error) {
 	tokenStr, err := jwtentity.ParseTokenFromContext(ctx)
 	if err != nil {
 		return nil, err
@@ -42,11 +70,17 @@ func (am *authMiddleware) Middleware(ctx context.Context, req any, info *grpc.Un
 		return nil, err
 	}
 
-	ctx = claims.SetToContext(ctx)
+	return claims.SetToContext(ctx), nil
+}
 
-	res, err := handler(ctx, req)
+// authServerStream hands the claims context to stream handlers.
+type authServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
 
-	return res, err
+func (s *authServerStream) Context() context.Context {
+	return s.ctx
 }
 
 func NewAuthMiddleware(cacheService *gocache.Cache) *authMiddleware {
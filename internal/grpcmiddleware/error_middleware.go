@@ -31,3 +31,25 @@ func ErrorMiddleware(ctx context.Context, req any, info *grpc.UnaryServerInfo, h
 	}
 	return res, err
 }
+
+func ErrorStreamMiddleware(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Println(r)
+			debug.PrintStack()
+			err = status.Errorf(codes.Internal, "Internal Server Error")
+		}
+	}()
+	err = handler(srv, ss)
+	if err != nil {
+		log.Println(err)
+
+		if st, ok := status.FromError(err); ok {
+			if st.Code() == codes.Unauthenticated {
+				return err
+			}
+		}
+		return status.Error(codes.Internal, "Internal Server Error")
+	}
+	return nil
+}
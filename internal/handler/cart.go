@@ -90,6 +90,25 @@ func (ch *cartHandler) UpdateCartQuantity(ctx context.Context, request *cart.Upd
 	return res, nil
 }
 
+func (ch *cartHandler) AcceptCartPrice(ctx context.Context, request *cart.AcceptCartPriceRequest) (*cart.AcceptCartPriceResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &cart.AcceptCartPriceResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ch.cartService.AcceptCartPrice(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
 func NewCartHandler(cartService service.ICartService) *cartHandler {
 	return &cartHandler{
 		cartService: cartService,
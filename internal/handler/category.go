@@ -0,0 +1,163 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/xryar/golang-grpc-ecommerce/internal/service"
+	"github.com/xryar/golang-grpc-ecommerce/internal/utils"
+	"github.com/xryar/golang-grpc-ecommerce/pb/category"
+)
+
+type categoryHandler struct {
+	category.UnimplementedCategoryServiceServer
+
+	categoryService service.ICategoryService
+}
+
+func (ch *categoryHandler) CreateCategory(ctx context.Context, request *category.CreateCategoryRequest) (*category.CreateCategoryResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &category.CreateCategoryResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ch.categoryService.CreateCategory(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ch *categoryHandler) EditCategory(ctx context.Context, request *category.EditCategoryRequest) (*category.EditCategoryResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &category.EditCategoryResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ch.categoryService.EditCategory(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ch *categoryHandler) DeleteCategory(ctx context.Context, request *category.DeleteCategoryRequest) (*category.DeleteCategoryResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &category.DeleteCategoryResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ch.categoryService.DeleteCategory(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ch *categoryHandler) ListCategory(ctx context.Context, request *category.ListCategoryRequest) (*category.ListCategoryResponse, error) {
+	res, err := ch.categoryService.ListCategory(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ch *categoryHandler) CreateCategoryAttribute(ctx context.Context, request *category.CreateCategoryAttributeRequest) (*category.CreateCategoryAttributeResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &category.CreateCategoryAttributeResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ch.categoryService.CreateCategoryAttribute(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ch *categoryHandler) EditCategoryAttribute(ctx context.Context, request *category.EditCategoryAttributeRequest) (*category.EditCategoryAttributeResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &category.EditCategoryAttributeResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ch.categoryService.EditCategoryAttribute(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ch *categoryHandler) DeleteCategoryAttribute(ctx context.Context, request *category.DeleteCategoryAttributeRequest) (*category.DeleteCategoryAttributeResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &category.DeleteCategoryAttributeResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ch.categoryService.DeleteCategoryAttribute(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ch *categoryHandler) ListCategoryAttribute(ctx context.Context, request *category.ListCategoryAttributeRequest) (*category.ListCategoryAttributeResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &category.ListCategoryAttributeResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ch.categoryService.ListCategoryAttribute(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func NewCategoryHandler(categoryService service.ICategoryService) *categoryHandler {
+	return &categoryHandler{
+		categoryService: categoryService,
+	}
+}
@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/xryar/golang-grpc-ecommerce/internal/service"
+	"github.com/xryar/golang-grpc-ecommerce/internal/utils"
+	"github.com/xryar/golang-grpc-ecommerce/pb/collection"
+)
+
+type collectionHandler struct {
+	collection.UnimplementedCollectionServiceServer
+
+	collectionService service.ICollectionService
+}
+
+func (ch *collectionHandler) CreateCollection(ctx context.Context, request *collection.CreateCollectionRequest) (*collection.CreateCollectionResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &collection.CreateCollectionResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ch.collectionService.CreateCollection(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ch *collectionHandler) EditCollection(ctx context.Context, request *collection.EditCollectionRequest) (*collection.EditCollectionResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &collection.EditCollectionResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ch.collectionService.EditCollection(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ch *collectionHandler) DeleteCollection(ctx context.Context, request *collection.DeleteCollectionRequest) (*collection.DeleteCollectionResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &collection.DeleteCollectionResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ch.collectionService.DeleteCollection(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ch *collectionHandler) ListCollection(ctx context.Context, request *collection.ListCollectionRequest) (*collection.ListCollectionResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &collection.ListCollectionResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ch.collectionService.ListCollection(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ch *collectionHandler) SetCollectionProducts(ctx context.Context, request *collection.SetCollectionProductsRequest) (*collection.SetCollectionProductsResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &collection.SetCollectionProductsResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ch.collectionService.SetCollectionProducts(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func NewCollectionHandler(collectionService service.ICollectionService) *collectionHandler {
+	return &collectionHandler{
+		collectionService: collectionService,
+	}
+}
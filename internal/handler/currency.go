@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/xryar/golang-grpc-ecommerce/internal/service"
+	"github.com/xryar/golang-grpc-ecommerce/internal/utils"
+	"github.com/xryar/golang-grpc-ecommerce/pb/currency"
+)
+
+type currencyHandler struct {
+	currency.UnimplementedCurrencyServiceServer
+
+	currencyService service.ICurrencyService
+}
+
+func (ch *currencyHandler) CreateExchangeRate(ctx context.Context, request *currency.CreateExchangeRateRequest) (*currency.CreateExchangeRateResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &currency.CreateExchangeRateResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ch.currencyService.CreateExchangeRate(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ch *currencyHandler) DeleteExchangeRate(ctx context.Context, request *currency.DeleteExchangeRateRequest) (*currency.DeleteExchangeRateResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &currency.DeleteExchangeRateResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ch.currencyService.DeleteExchangeRate(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ch *currencyHandler) ListExchangeRate(ctx context.Context, request *currency.ListExchangeRateRequest) (*currency.ListExchangeRateResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &currency.ListExchangeRateResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ch.currencyService.ListExchangeRate(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func NewCurrencyHandler(currencyService service.ICurrencyService) *currencyHandler {
+	return &currencyHandler{
+		currencyService: currencyService,
+	}
+}
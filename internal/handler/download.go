@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/xryar/golang-grpc-ecommerce/internal/downloadlink"
+	"github.com/xryar/golang-grpc-ecommerce/internal/service"
+)
+
+type downloadHandler struct {
+	downloadService service.IDownloadService
+}
+
+func (dh *downloadHandler) Download(c *fiber.Ctx) error {
+	file, err := dh.downloadService.OpenDownload(c.UserContext(), c.Params("id"), c.Query("expires"), c.Query("signature"))
+	if err != nil {
+		if errors.Is(err, downloadlink.ErrInvalidSignature) {
+			return c.Status(http.StatusForbidden).SendString("Forbidden")
+		}
+		if errors.Is(err, downloadlink.ErrExpired) {
+			return c.Status(http.StatusForbidden).SendString("Download link is expired")
+		}
+		if errors.Is(err, service.ErrDownloadNotFound) {
+			return c.Status(http.StatusNotFound).SendString("Not Found")
+		}
+		if errors.Is(err, service.ErrDownloadLimitReached) {
+			return c.Status(http.StatusGone).SendString("Download limit reached")
+		}
+
+		log.Println(err)
+		return c.Status(http.StatusInternalServerError).SendString("Internal Server Error")
+	}
+
+	c.Attachment(file.Name)
+	c.Set("Cache-Control", "no-store")
+	return c.SendStream(file.Reader)
+}
+
+func NewDownloadHandler(downloadService service.IDownloadService) *downloadHandler {
+	return &downloadHandler{
+		downloadService: downloadService,
+	}
+}
@@ -109,6 +109,25 @@ func (oh *orderHandler) UpdateOrderStatus(ctx context.Context, request *order.Up
 	return res, nil
 }
 
+func (oh *orderHandler) ListOrderDownload(ctx context.Context, request *order.ListOrderDownloadRequest) (*order.ListOrderDownloadResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &order.ListOrderDownloadResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := oh.orderService.ListOrderDownload(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
 func NewOrderHandler(orderService service.IOrderService) *orderHandler {
 	return &orderHandler{
 		orderService: orderService,
@@ -1,13 +1,18 @@
 package handler
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"io"
 
 	"github.com/xryar/golang-grpc-ecommerce/internal/service"
 	"github.com/xryar/golang-grpc-ecommerce/internal/utils"
 	"github.com/xryar/golang-grpc-ecommerce/pb/product"
 )
 
+const productImportMaxSize = 32 << 20
+
 type productHandler struct {
 	product.UnimplementedProductServiceServer
 
@@ -147,6 +152,267 @@ func (ph *productHandler) HighlightProducts(ctx context.Context, request *produc
 	return res, nil
 }
 
+func (ph *productHandler) CreateProductVariant(ctx context.Context, request *product.CreateProductVariantRequest) (*product.CreateProductVariantResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &product.CreateProductVariantResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ph.productService.CreateProductVariant(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ph *productHandler) EditProductVariant(ctx context.Context, request *product.EditProductVariantRequest) (*product.EditProductVariantResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &product.EditProductVariantResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ph.productService.EditProductVariant(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ph *productHandler) DeleteProductVariant(ctx context.Context, request *product.DeleteProductVariantRequest) (*product.DeleteProductVariantResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &product.DeleteProductVariantResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ph.productService.DeleteProductVariant(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ph *productHandler) AddProductImage(ctx context.Context, request *product.AddProductImageRequest) (*product.AddProductImageResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &product.AddProductImageResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ph.productService.AddProductImage(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ph *productHandler) RemoveProductImage(ctx context.Context, request *product.RemoveProductImageRequest) (*product.RemoveProductImageResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &product.RemoveProductImageResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ph.productService.RemoveProductImage(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ph *productHandler) ReorderProductImages(ctx context.Context, request *product.ReorderProductImagesRequest) (*product.ReorderProductImagesResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &product.ReorderProductImagesResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ph.productService.ReorderProductImages(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ph *productHandler) SetPrimaryProductImage(ctx context.Context, request *product.SetPrimaryProductImageRequest) (*product.SetPrimaryProductImageResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &product.SetPrimaryProductImageResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ph.productService.SetPrimaryProductImage(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ph *productHandler) ListProductPriceHistory(ctx context.Context, request *product.ListProductPriceHistoryRequest) (*product.ListProductPriceHistoryResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &product.ListProductPriceHistoryResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ph.productService.ListProductPriceHistory(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ph *productHandler) ListDeletedProduct(ctx context.Context, request *product.ListDeletedProductRequest) (*product.ListDeletedProductResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &product.ListDeletedProductResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ph.productService.ListDeletedProduct(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ph *productHandler) RestoreProduct(ctx context.Context, request *product.RestoreProductRequest) (*product.RestoreProductResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &product.RestoreProductResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ph.productService.RestoreProduct(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ph *productHandler) PurgeProduct(ctx context.Context, request *product.PurgeProductRequest) (*product.PurgeProductResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &product.PurgeProductResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := ph.productService.PurgeProduct(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (ph *productHandler) ImportProduct(stream product.ProductService_ImportProductServer) error {
+	var buffer bytes.Buffer
+	dryRun := false
+	for i := 0; ; i++ {
+		request, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		// the options are read from the first message, later ones only carry data
+		if i == 0 {
+			dryRun = request.DryRun
+		}
+		if buffer.Len()+len(request.Chunk) > productImportMaxSize {
+			return stream.SendAndClose(&product.ImportProductResponse{
+				Base: utils.BadRequestResponse("CSV file is too large"),
+			})
+		}
+		buffer.Write(request.Chunk)
+	}
+
+	res, err := ph.productService.ImportProducts(stream.Context(), &buffer, dryRun)
+	if err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(res)
+}
+
+func (ph *productHandler) ExportProduct(request *product.ExportProductRequest, stream product.ProductService_ExportProductServer) error {
+	return ph.productService.ExportProducts(stream.Context(), &productExportWriter{stream: stream})
+}
+
+// productExportWriter sends every write as one chunk of the export stream.
+type productExportWriter struct {
+	stream product.ProductService_ExportProductServer
+}
+
+func (w *productExportWriter) Write(p []byte) (int, error) {
+	err := w.stream.Send(&product.ExportProductResponse{
+		Chunk: p,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
 func NewProductHandler(productService service.IProductService) *productHandler {
 	return &productHandler{
 		productService: productService,
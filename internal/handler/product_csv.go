@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"bufio"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/xryar/golang-grpc-ecommerce/internal/service"
+)
+
+type productCsvHandler struct {
+	productService service.IProductService
+}
+
+func (ph *productCsvHandler) ImportProduct(c *fiber.Ctx) error {
+	file, err := c.FormFile("file")
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "csv file not found",
+		})
+	}
+	if file.Size > productImportMaxSize {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "CSV file is too large",
+		})
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		fmt.Println(err)
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Internal server error",
+		})
+	}
+	defer src.Close()
+
+	res, err := ph.productService.ImportProducts(c.UserContext(), src, c.QueryBool("dry_run"))
+	if err != nil {
+		fmt.Println(err)
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Internal server error",
+		})
+	}
+
+	return c.Status(int(res.Base.StatusCode)).JSON(res)
+}
+
+func (ph *productCsvHandler) ExportProduct(c *fiber.Ctx) error {
+	// the fiber context is released before the body is written, so the user
+	// context is taken out here
+	ctx := c.UserContext()
+
+	c.Set("Content-Type", "text/csv")
+	c.Set("Content-Disposition", `attachment; filename="products.csv"`)
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		err := ph.productService.ExportProducts(ctx, w)
+		if err != nil {
+			log.Println(err)
+		}
+		w.Flush()
+	})
+
+	return nil
+}
+
+func NewProductCsvHandler(productService service.IProductService) *productCsvHandler {
+	return &productCsvHandler{
+		productService: productService,
+	}
+}
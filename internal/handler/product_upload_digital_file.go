@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/xryar/golang-grpc-ecommerce/internal/storage"
+)
+
+var digitalFileExtRegex = regexp.MustCompile(`^\.[a-z0-9]{1,10}$`)
+
+type productUploadDigitalFileHandler struct {
+	storage storage.IStorage
+}
+
+// UploadProductDigitalFile stores the file of a digital product in the
+// private digital storage. Any file type is accepted, it is only ever handed
+// back as a download.
+func (ph *productUploadDigitalFileHandler) UploadProductDigitalFile(c *fiber.Ctx) error {
+	file, err := c.FormFile("file")
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "file data not found",
+		})
+	}
+
+	ext := strings.ToLower(filepath.Ext(file.Filename))
+	if !digitalFileExtRegex.MatchString(ext) {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"message": "file extension is not allowed",
+		})
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		fmt.Println(err)
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Internal server error",
+		})
+	}
+	defer src.Close()
+
+	fileName := fmt.Sprintf("digital_%d%s", time.Now().UnixNano(), ext)
+	err = ph.storage.Save(c.UserContext(), fileName, src, file.Size, "application/octet-stream")
+	if err != nil {
+		fmt.Println(err)
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{
+			"success": false,
+			"message": "Internal server error",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success":   true,
+		"message":   "Upload success",
+		"file_name": fileName,
+	})
+}
+
+func NewProductUploadDigitalFileHandler(storage storage.IStorage) *productUploadDigitalFileHandler {
+	return &productUploadDigitalFileHandler{
+		storage: storage,
+	}
+}
@@ -1,16 +1,23 @@
 package handler
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
-	"path/filepath"
-	"strings"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/xryar/golang-grpc-ecommerce/internal/imageprocessor"
+	"github.com/xryar/golang-grpc-ecommerce/internal/storage"
 )
 
-func UploadProductImageHandler(c *fiber.Ctx) error {
+type productUploadImageHandler struct {
+	storage storage.IStorage
+}
+
+func (ph *productUploadImageHandler) UploadProductImage(c *fiber.Ctx) error {
 	file, err := c.FormFile("image")
 	if err != nil {
 		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
@@ -19,50 +26,66 @@ func UploadProductImageHandler(c *fiber.Ctx) error {
 		})
 	}
 
-	// validasi extention
-	ext := strings.ToLower(filepath.Ext(file.Filename))
-	allowedExts := map[string]bool{
-		".jpg":  true,
-		".jpeg": true,
-		".png":  true,
-		".webp": true,
-	}
-	if !allowedExts[ext] {
-		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+	src, err := file.Open()
+	if err != nil {
+		fmt.Println(err)
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{
 			"success": false,
-			"message": "image extension is not allowed (jpg, jpeg, png, webp)",
+			"message": "Internal server error",
 		})
 	}
+	defer src.Close()
 
-	// validasi content type
-	contentType := file.Header.Get("Content-Type")
-	allowedContentType := map[string]bool{
-		"image/jpeg": true,
-		"image/png":  true,
-		"image/webp": true,
-	}
-	if !allowedContentType[contentType] {
-		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+	data, err := io.ReadAll(src)
+	if err != nil {
+		fmt.Println(err)
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{
 			"success": false,
-			"message": "content type is not allowed",
+			"message": "Internal server error",
 		})
 	}
 
+	// the format is checked from the file content, the client's extension and
+	// content type are ignored
 	timestamp := time.Now().UnixNano()
-	fileName := fmt.Sprintf("product_%d%s", timestamp, filepath.Ext(file.Filename))
-	uploadPath := "./storage/product/" + fileName
-	err = c.SaveFile(file, uploadPath)
+	images, err := imageprocessor.Process(data, fmt.Sprintf("product_%d", timestamp))
 	if err != nil {
-		fmt.Println(err)
-		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{
+		if errors.Is(err, imageprocessor.ErrUnsupportedFormat) {
+			return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+				"success": false,
+				"message": "image format is not allowed (jpg, jpeg, png, webp)",
+			})
+		}
+
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
 			"success": false,
-			"message": "Internal server error",
+			"message": err.Error(),
 		})
 	}
 
+	// the main image goes last so it only exists once its renditions do
+	for i := len(images) - 1; i >= 0; i-- {
+		image := images[i]
+		err = ph.storage.Save(c.UserContext(), image.FileName, bytes.NewReader(image.Data), int64(len(image.Data)), image.ContentType)
+		if err != nil {
+			fmt.Println(err)
+			return c.Status(http.StatusInternalServerError).JSON(fiber.Map{
+				"success": false,
+				"message": "Internal server error",
+			})
+		}
+	}
+	fileName := images[0].FileName
+
 	return c.JSON(fiber.Map{
 		"success":   true,
 		"message":   "Upload success",
 		"file_name": fileName,
 	})
 }
+
+func NewProductUploadImageHandler(storage storage.IStorage) *productUploadImageHandler {
+	return &productUploadImageHandler{
+		storage: storage,
+	}
+}
@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/xryar/golang-grpc-ecommerce/internal/service"
+	"github.com/xryar/golang-grpc-ecommerce/internal/utils"
+	"github.com/xryar/golang-grpc-ecommerce/pb/recommendation"
+)
+
+type recommendationHandler struct {
+	recommendation.UnimplementedRecommendationServiceServer
+
+	recommendationService service.IRecommendationService
+}
+
+func (rh *recommendationHandler) FrequentlyBoughtTogether(ctx context.Context, request *recommendation.FrequentlyBoughtTogetherRequest) (*recommendation.FrequentlyBoughtTogetherResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &recommendation.FrequentlyBoughtTogetherResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := rh.recommendationService.FrequentlyBoughtTogether(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (rh *recommendationHandler) CartRecommendation(ctx context.Context, request *recommendation.CartRecommendationRequest) (*recommendation.CartRecommendationResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &recommendation.CartRecommendationResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := rh.recommendationService.CartRecommendation(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func NewRecommendationHandler(recommendationService service.IRecommendationService) *recommendationHandler {
+	return &recommendationHandler{
+		recommendationService: recommendationService,
+	}
+}
@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/xryar/golang-grpc-ecommerce/internal/service"
+	"github.com/xryar/golang-grpc-ecommerce/internal/utils"
+	"github.com/xryar/golang-grpc-ecommerce/pb/review"
+)
+
+type reviewHandler struct {
+	review.UnimplementedReviewServiceServer
+
+	reviewService service.IReviewService
+}
+
+func (rh *reviewHandler) CreateReview(ctx context.Context, request *review.CreateReviewRequest) (*review.CreateReviewResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &review.CreateReviewResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := rh.reviewService.CreateReview(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (rh *reviewHandler) ListReview(ctx context.Context, request *review.ListReviewRequest) (*review.ListReviewResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &review.ListReviewResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := rh.reviewService.ListReview(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (rh *reviewHandler) ListReviewAdmin(ctx context.Context, request *review.ListReviewAdminRequest) (*review.ListReviewAdminResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &review.ListReviewAdminResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := rh.reviewService.ListReviewAdmin(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (rh *reviewHandler) ApproveReview(ctx context.Context, request *review.ApproveReviewRequest) (*review.ApproveReviewResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &review.ApproveReviewResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := rh.reviewService.ApproveReview(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (rh *reviewHandler) HideReview(ctx context.Context, request *review.HideReviewRequest) (*review.HideReviewResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &review.HideReviewResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := rh.reviewService.HideReview(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func NewReviewHandler(reviewService service.IReviewService) *reviewHandler {
+	return &reviewHandler{
+		reviewService: reviewService,
+	}
+}
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"crypto/subtle"
 	"log"
 	"net/http"
 
@@ -11,9 +12,16 @@ import (
 
 type webhookHandler struct {
 	webhookService service.IWebhookService
+	callbackToken  string
 }
 
 func (wh *webhookHandler) ReceiveInvoice(c *fiber.Ctx) error {
+	// only Xendit knows the callback token of the account
+	callbackToken := c.Get("x-callback-token")
+	if subtle.ConstantTimeCompare([]byte(callbackToken), []byte(wh.callbackToken)) != 1 {
+		return c.SendStatus(http.StatusUnauthorized)
+	}
+
 	var request dto.XenditInvoiceRequest
 	err := c.BodyParser(&request)
 	if err != nil {
@@ -29,8 +37,11 @@ func (wh *webhookHandler) ReceiveInvoice(c *fiber.Ctx) error {
 	return c.SendStatus(http.StatusOK)
 }
 
-func NewWebhookHandler(webhookService service.IWebhookService) *webhookHandler {
+// NewWebhookHandler accepts callbacks carrying callbackToken, the
+// verification token of the Xendit account.
+func NewWebhookHandler(webhookService service.IWebhookService, callbackToken string) *webhookHandler {
 	return &webhookHandler{
 		webhookService: webhookService,
+		callbackToken:  callbackToken,
 	}
 }
@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/xryar/golang-grpc-ecommerce/internal/service"
+	"github.com/xryar/golang-grpc-ecommerce/internal/utils"
+	"github.com/xryar/golang-grpc-ecommerce/pb/wishlist"
+)
+
+type wishlistHandler struct {
+	wishlist.UnimplementedWishlistServiceServer
+
+	wishlistService service.IWishlistService
+}
+
+func (wh *wishlistHandler) AddProductToWishlist(ctx context.Context, request *wishlist.AddProductToWishlistRequest) (*wishlist.AddProductToWishlistResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &wishlist.AddProductToWishlistResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := wh.wishlistService.AddProductToWishlist(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (wh *wishlistHandler) ListWishlist(ctx context.Context, request *wishlist.ListWishlistRequest) (*wishlist.ListWishlistResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &wishlist.ListWishlistResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := wh.wishlistService.ListWishlist(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (wh *wishlistHandler) DeleteWishlist(ctx context.Context, request *wishlist.DeleteWishlistRequest) (*wishlist.DeleteWishlistResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &wishlist.DeleteWishlistResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := wh.wishlistService.DeleteWishlist(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (wh *wishlistHandler) MoveWishlistToCart(ctx context.Context, request *wishlist.MoveWishlistToCartRequest) (*wishlist.MoveWishlistToCartResponse, error) {
+	validationErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, err
+	}
+	if validationErrors != nil {
+		return &wishlist.MoveWishlistToCartResponse{
+			Base: utils.ValidationErrorResponse(validationErrors),
+		}, nil
+	}
+
+	res, err := wh.wishlistService.MoveWishlistToCart(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func NewWishlistHandler(wishlistService service.IWishlistService) *wishlistHandler {
+	return &wishlistHandler{
+		wishlistService: wishlistService,
+	}
+}
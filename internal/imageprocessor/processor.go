@@ -0,0 +1,311 @@
+package imageprocessor
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/rwcarlsen/goexif/exif"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+const (
+	RenditionMedium    = "medium"
+	RenditionThumbnail = "thumb"
+)
+
+const (
+	maxDimension       = 2048
+	mediumDimension    = 800
+	thumbnailDimension = 320
+	// uploads above this are rejected before decoding the pixels
+	maxPixels   = 40_000_000
+	jpegQuality = 85
+)
+
+var ErrUnsupportedFormat = errors.New("unsupported image format")
+
+var allowedContentTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/webp": true,
+}
+
+type ProcessedImage struct {
+	FileName    string
+	ContentType string
+	Data        []byte
+}
+
+// Process decodes an upload, detecting the format from its magic bytes, and
+// returns the normalised image followed by its medium and thumbnail
+// renditions, all named after baseName. Every output is re-encoded, which
+// drops EXIF and any other metadata. Images with transparency are stored as
+// PNG, everything else as JPEG.
+func Process(data []byte, baseName string) ([]*ProcessedImage, error) {
+	if !allowedContentTypes[http.DetectContentType(data)] {
+		return nil, ErrUnsupportedFormat
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedFormat
+	}
+	if config.Width*config.Height > maxPixels {
+		return nil, fmt.Errorf("image is too large (%dx%d)", config.Width, config.Height)
+	}
+
+	decoded, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedFormat
+	}
+
+	img := toNRGBA(decoded)
+	if format == "jpeg" {
+		img = applyOrientation(img, exifOrientation(data))
+	}
+
+	ext, contentType := ".jpg", "image/jpeg"
+	if hasAlpha(img) {
+		ext, contentType = ".png", "image/png"
+	}
+
+	fileName := baseName + ext
+	normalised := fit(img, maxDimension)
+	medium := fit(normalised, mediumDimension)
+	thumbnail := fit(medium, thumbnailDimension)
+
+	results := make([]*ProcessedImage, 0, 3)
+	for _, output := range []struct {
+		fileName string
+		img      image.Image
+	}{
+		{fileName, normalised},
+		{RenditionFileName(fileName, RenditionMedium), medium},
+		{RenditionFileName(fileName, RenditionThumbnail), thumbnail},
+	} {
+		var buf bytes.Buffer
+		if contentType == "image/png" {
+			err = png.Encode(&buf, output.img)
+		} else {
+			err = jpeg.Encode(&buf, output.img, &jpeg.Options{Quality: jpegQuality})
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, &ProcessedImage{
+			FileName:    output.fileName,
+			ContentType: contentType,
+			Data:        buf.Bytes(),
+		})
+	}
+
+	return results, nil
+}
+
+// Renditions returns the medium and thumbnail renditions of a stored
+// original, for images uploaded before renditions existed. They are encoded
+// in the format the extension of fileName names, WebP originals have none.
+func Renditions(data []byte, fileName string) ([]*ProcessedImage, error) {
+	ext := strings.ToLower(filepath.Ext(fileName))
+	if ext == ".webp" {
+		return nil, ErrUnsupportedFormat
+	}
+
+	decoded, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedFormat
+	}
+
+	img := toNRGBA(decoded)
+	if format == "jpeg" {
+		img = applyOrientation(img, exifOrientation(data))
+	}
+
+	medium := fit(img, mediumDimension)
+	thumbnail := fit(medium, thumbnailDimension)
+
+	results := make([]*ProcessedImage, 0, 2)
+	for _, output := range []struct {
+		fileName string
+		img      image.Image
+	}{
+		{RenditionFileName(fileName, RenditionMedium), medium},
+		{RenditionFileName(fileName, RenditionThumbnail), thumbnail},
+	} {
+		var buf bytes.Buffer
+		var contentType string
+		switch ext {
+		case ".png":
+			contentType = "image/png"
+			err = png.Encode(&buf, output.img)
+		default:
+			contentType = "image/jpeg"
+			err = jpeg.Encode(&buf, output.img, &jpeg.Options{Quality: jpegQuality})
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, &ProcessedImage{
+			FileName:    output.fileName,
+			ContentType: contentType,
+			Data:        buf.Bytes(),
+		})
+	}
+
+	return results, nil
+}
+
+// RenditionFileName returns the name of a rendition of fileName, e.g.
+// product_1.jpg becomes product_1_thumb.jpg. A WebP original, which only an
+// image uploaded before renditions existed can be, is its own rendition.
+func RenditionFileName(fileName string, rendition string) string {
+	ext := filepath.Ext(fileName)
+	if strings.ToLower(ext) == ".webp" {
+		return fileName
+	}
+
+	return fmt.Sprintf("%s_%s%s", strings.TrimSuffix(fileName, ext), rendition, ext)
+}
+
+// FileNames returns every stored file that belongs to fileName.
+func FileNames(fileName string) []string {
+	return slices.Compact([]string{
+		fileName,
+		RenditionFileName(fileName, RenditionMedium),
+		RenditionFileName(fileName, RenditionThumbnail),
+	})
+}
+
+// OriginalFileName returns the file a same-format rendition was made from,
+// so images uploaded before renditions existed can still be served.
+func OriginalFileName(fileName string) (string, bool) {
+	ext := filepath.Ext(fileName)
+	name := strings.TrimSuffix(fileName, ext)
+	for _, rendition := range []string{RenditionMedium, RenditionThumbnail} {
+		if original, found := strings.CutSuffix(name, "_"+rendition); found {
+			return original + ext, true
+		}
+	}
+
+	return "", false
+}
+
+// SourceFileNames returns the names fileName may have been uploaded as: the
+// name itself for an original and the original of a rendition.
+func SourceFileNames(fileName string) []string {
+	if original, ok := OriginalFileName(fileName); ok {
+		return []string{original}
+	}
+
+	return []string{fileName}
+}
+
+func toNRGBA(img image.Image) *image.NRGBA {
+	bounds := img.Bounds()
+	nrgba := image.NewNRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(nrgba, nrgba.Bounds(), img, bounds.Min, draw.Src)
+
+	return nrgba
+}
+
+func hasAlpha(img *image.NRGBA) bool {
+	for i := 3; i < len(img.Pix); i += 4 {
+		if img.Pix[i] != 0xff {
+			return true
+		}
+	}
+
+	return false
+}
+
+// fit scales img down so that neither side exceeds maxSize.
+func fit(img *image.NRGBA, maxSize int) *image.NRGBA {
+	width, height := img.Bounds().Dx(), img.Bounds().Dy()
+	if width <= maxSize && height <= maxSize {
+		return img
+	}
+
+	if width >= height {
+		height = max(1, height*maxSize/width)
+		width = maxSize
+	} else {
+		width = max(1, width*maxSize/height)
+		height = maxSize
+	}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, img.Bounds(), draw.Src, nil)
+
+	return dst
+}
+
+func exifOrientation(data []byte) int {
+	x, err := exif.Decode(bytes.NewReader(data))
+	if err != nil {
+		return 1
+	}
+
+	tag, err := x.Get(exif.Orientation)
+	if err != nil {
+		return 1
+	}
+
+	orientation, err := tag.Int(0)
+	if err != nil {
+		return 1
+	}
+
+	return orientation
+}
+
+// applyOrientation rotates and flips img so it displays upright once the EXIF
+// orientation tag is gone.
+func applyOrientation(img *image.NRGBA, orientation int) *image.NRGBA {
+	if orientation < 2 || orientation > 8 {
+		return img
+	}
+
+	width, height := img.Bounds().Dx(), img.Bounds().Dy()
+	dstWidth, dstHeight := width, height
+	if orientation >= 5 {
+		dstWidth, dstHeight = height, width
+	}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, dstWidth, dstHeight))
+	for y := 0; y < dstHeight; y++ {
+		for x := 0; x < dstWidth; x++ {
+			var srcX, srcY int
+			switch orientation {
+			case 2:
+				srcX, srcY = width-1-x, y
+			case 3:
+				srcX, srcY = width-1-x, height-1-y
+			case 4:
+				srcX, srcY = x, height-1-y
+			case 5:
+				srcX, srcY = y, x
+			case 6:
+				srcX, srcY = y, height-1-x
+			case 7:
+				srcX, srcY = width-1-y, height-1-x
+			case 8:
+				srcX, srcY = width-1-y, x
+			}
+
+			copy(dst.Pix[dst.PixOffset(x, y):dst.PixOffset(x, y)+4], img.Pix[img.PixOffset(srcX, srcY):img.PixOffset(srcX, srcY)+4])
+		}
+	}
+
+	return dst
+}
@@ -4,28 +4,31 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 
 	"github.com/xryar/golang-grpc-ecommerce/internal/entity"
 )
 
 type ICartRepository interface {
-	GetCartByProductAndUserId(ctx context.Context, productId, userId string) (*entity.UserCart, error)
+	GetCartByProductAndUserId(ctx context.Context, productId string, productVariantId *string, userId string) (*entity.UserCart, error)
 	CreateNewCart(ctx context.Context, cart *entity.UserCart) error
 	UpdateCart(ctx context.Context, cart *entity.UserCart) error
 	GetListCart(ctx context.Context, userId string) ([]*entity.UserCart, error)
 	GetCartById(ctx context.Context, cartId string) (*entity.UserCart, error)
 	DeleteCart(ctx context.Context, cartId string) error
+	GetCartProductQuantity(ctx context.Context, userId string, productId string, excludeCartId *string) (int64, error)
 }
 
 type cartRepository struct {
 	db *sql.DB
 }
 
-func (cr *cartRepository) GetCartByProductAndUserId(ctx context.Context, productId, userId string) (*entity.UserCart, error) {
+func (cr *cartRepository) GetCartByProductAndUserId(ctx context.Context, productId string, productVariantId *string, userId string) (*entity.UserCart, error) {
 	row := cr.db.QueryRowContext(
 		ctx,
-		"SELECT id, product_id, user_id, quantity, created_at, created_by, updated_at, updated_by FROM user_cart WHERE product_id = $1 AND user_id = $2",
+		"SELECT id, product_id, product_variant_id, user_id, quantity, created_at, created_by, updated_at, updated_by, price FROM user_cart WHERE product_id = $1 AND product_variant_id IS NOT DISTINCT FROM $2 AND user_id = $3",
 		productId,
+		productVariantId,
 		userId,
 	)
 	if row.Err() != nil {
@@ -36,12 +39,14 @@ func (cr *cartRepository) GetCartByProductAndUserId(ctx context.Context, product
 	err := row.Scan(
 		&cartEntity.Id,
 		&cartEntity.ProductId,
+		&cartEntity.ProductVariantId,
 		&cartEntity.UserId,
 		&cartEntity.Quantity,
 		&cartEntity.CreatedAt,
 		&cartEntity.CreatedBy,
 		&cartEntity.UpdateAt,
 		&cartEntity.UpdatedBy,
+		&cartEntity.Price,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -56,15 +61,17 @@ func (cr *cartRepository) GetCartByProductAndUserId(ctx context.Context, product
 func (cr *cartRepository) CreateNewCart(ctx context.Context, cart *entity.UserCart) error {
 	_, err := cr.db.ExecContext(
 		ctx,
-		"INSERT INTO user_cart (id, product_id, user_id, quantity, created_at, created_by, updated_at, updated_by) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
+		"INSERT INTO user_cart (id, product_id, product_variant_id, user_id, quantity, created_at, created_by, updated_at, updated_by, price) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
 		cart.Id,
 		cart.ProductId,
+		cart.ProductVariantId,
 		cart.UserId,
 		cart.Quantity,
 		cart.CreatedAt,
 		cart.CreatedBy,
 		cart.UpdateAt,
 		cart.UpdatedBy,
+		cart.Price,
 	)
 	if err != nil {
 		return err
@@ -76,12 +83,14 @@ func (cr *cartRepository) CreateNewCart(ctx context.Context, cart *entity.UserCa
 func (cr *cartRepository) UpdateCart(ctx context.Context, cart *entity.UserCart) error {
 	_, err := cr.db.ExecContext(
 		ctx,
-		"UPDATE user_cart SET product_id = $1, user_id = $2, quantity = $3, updated_at = $4, updated_by = $5 WHERE id = $6",
+		"UPDATE user_cart SET product_id = $1, product_variant_id = $2, user_id = $3, quantity = $4, updated_at = $5, updated_by = $6, price = $7 WHERE id = $8",
 		cart.ProductId,
+		cart.ProductVariantId,
 		cart.UserId,
 		cart.Quantity,
 		cart.UpdateAt,
 		cart.UpdatedBy,
+		cart.Price,
 		cart.Id,
 	)
 	if err != nil {
@@ -94,7 +103,18 @@ func (cr *cartRepository) UpdateCart(ctx context.Context, cart *entity.UserCart)
 func (cr *cartRepository) GetListCart(ctx context.Context, userId string) ([]*entity.UserCart, error) {
 	rows, err := cr.db.QueryContext(
 		ctx,
-		"SELECT uc.*, p.id, p.name, p.image_file_name, p.price FROM user_cart uc JOIN product p ON uc.product_id = p.id WHERE uc.user_id = $1 AND p.is_deleted = false",
+		fmt.Sprintf(`
+		SELECT
+			uc.id, uc.product_id, uc.product_variant_id, uc.user_id, uc.quantity, uc.created_at, uc.created_by, uc.updated_at, uc.updated_by, uc.price,
+			product.id, product.name, product.image_file_name, product.price, product.sale_price, product.sale_starts_at, product.sale_ends_at,
+			product.status, product.publish_at, product.type, %s, product.min_order_quantity, product.max_order_quantity,
+			pv.id, pv.sku, pv.attributes, pv.price, pv.image_file_name, pv.stock
+		FROM user_cart uc
+		JOIN product ON uc.product_id = product.id
+		LEFT JOIN product_variant pv ON uc.product_variant_id = pv.id AND pv.is_deleted = false
+		WHERE uc.user_id = $1 AND product.is_deleted = false AND (uc.product_variant_id IS NULL OR pv.id IS NOT NULL)
+		ORDER BY uc.created_at ASC, uc.id ASC
+		`, productStockColumn),
 		userId,
 	)
 	if err != nil {
@@ -104,26 +124,54 @@ func (cr *cartRepository) GetListCart(ctx context.Context, userId string) ([]*en
 	var carts []*entity.UserCart = make([]*entity.UserCart, 0)
 	for rows.Next() {
 		var cart entity.UserCart
+		var variantId, variantSku *string
+		var variantStock *int64
+		var variant entity.ProductVariant
 		cart.Product = &entity.Product{}
 
 		err = rows.Scan(
 			&cart.Id,
 			&cart.ProductId,
+			&cart.ProductVariantId,
 			&cart.UserId,
 			&cart.Quantity,
 			&cart.CreatedAt,
 			&cart.CreatedBy,
 			&cart.UpdateAt,
 			&cart.UpdatedBy,
+			&cart.Price,
 			&cart.Product.Id,
 			&cart.Product.Name,
 			&cart.Product.ImageFileName,
 			&cart.Product.Price,
+			&cart.Product.SalePrice,
+			&cart.Product.SaleStartsAt,
+			&cart.Product.SaleEndsAt,
+			&cart.Product.Status,
+			&cart.Product.PublishAt,
+			&cart.Product.Type,
+			&cart.Product.Stock,
+			&cart.Product.MinOrderQuantity,
+			&cart.Product.MaxOrderQuantity,
+			&variantId,
+			&variantSku,
+			&variant.Attributes,
+			&variant.Price,
+			&variant.ImageFileName,
+			&variantStock,
 		)
 		if err != nil {
 			return nil, err
 		}
 
+		if variantId != nil {
+			variant.Id = *variantId
+			variant.ProductId = cart.ProductId
+			variant.Sku = *variantSku
+			variant.Stock = *variantStock
+			cart.ProductVariant = &variant
+		}
+
 		carts = append(carts, &cart)
 	}
 
@@ -133,7 +181,7 @@ func (cr *cartRepository) GetListCart(ctx context.Context, userId string) ([]*en
 func (cr *cartRepository) GetCartById(ctx context.Context, cartId string) (*entity.UserCart, error) {
 	row := cr.db.QueryRowContext(
 		ctx,
-		"SELECT * FROM user_cart WHERE id = $1",
+		"SELECT id, product_id, product_variant_id, user_id, quantity, created_at, created_by, updated_at, updated_by, price FROM user_cart WHERE id = $1",
 		cartId,
 	)
 	if row.Err() != nil {
@@ -144,12 +192,14 @@ func (cr *cartRepository) GetCartById(ctx context.Context, cartId string) (*enti
 	err := row.Scan(
 		&cart.Id,
 		&cart.ProductId,
+		&cart.ProductVariantId,
 		&cart.UserId,
 		&cart.Quantity,
 		&cart.CreatedAt,
 		&cart.CreatedBy,
 		&cart.UpdateAt,
 		&cart.UpdatedBy,
+		&cart.Price,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -175,6 +225,35 @@ func (cr *cartRepository) DeleteCart(ctx context.Context, cartId string) error {
 	return nil
 }
 
+// GetCartProductQuantity returns how many units of the product, all its
+// variants together, the user has in the cart, leaving out the item with
+// excludeCartId when it is not nil.
+func (cr *cartRepository) GetCartProductQuantity(ctx context.Context, userId string, productId string, excludeCartId *string) (int64, error) {
+	row := cr.db.QueryRowContext(
+		ctx,
+		`
+		SELECT COALESCE(SUM(uc.quantity), 0)
+		FROM user_cart uc
+		LEFT JOIN product_variant pv ON uc.product_variant_id = pv.id AND pv.is_deleted = false
+		WHERE uc.user_id = $1 AND uc.product_id = $2 AND uc.id IS DISTINCT FROM $3 AND (uc.product_variant_id IS NULL OR pv.id IS NOT NULL)
+		`,
+		userId,
+		productId,
+		excludeCartId,
+	)
+	if row.Err() != nil {
+		return 0, row.Err()
+	}
+
+	var quantity int64
+	err := row.Scan(&quantity)
+	if err != nil {
+		return 0, err
+	}
+
+	return quantity, nil
+}
+
 func NewCartRepository(db *sql.DB) ICartRepository {
 	return &cartRepository{
 		db: db,
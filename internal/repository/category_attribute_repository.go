@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/xryar/golang-grpc-ecommerce/internal/entity"
+)
+
+func (cr *categoryRepository) CreateCategoryAttribute(ctx context.Context, attribute *entity.CategoryAttribute) error {
+	_, err := cr.db.ExecContext(
+		ctx,
+		"INSERT INTO category_attribute (id, category_id, code, name, type, unit, created_at, created_by, updated_at, updated_by, deleted_at, deleted_by, is_deleted) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)",
+		attribute.Id,
+		attribute.CategoryId,
+		attribute.Code,
+		attribute.Name,
+		attribute.Type,
+		attribute.Unit,
+		attribute.CreatedAt,
+		attribute.CreatedBy,
+		attribute.UpdatedAt,
+		attribute.UpdatedBy,
+		attribute.DeletedAt,
+		attribute.DeletedBy,
+		attribute.IsDeleted,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (cr *categoryRepository) GetCategoryAttributeById(ctx context.Context, id string) (*entity.CategoryAttribute, error) {
+	row := cr.db.QueryRowContext(
+		ctx,
+		"SELECT id, category_id, code, name, type, unit, created_at, created_by FROM category_attribute WHERE id = $1 AND is_deleted = false",
+		id,
+	)
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+
+	var attribute entity.CategoryAttribute
+	err := row.Scan(
+		&attribute.Id,
+		&attribute.CategoryId,
+		&attribute.Code,
+		&attribute.Name,
+		&attribute.Type,
+		&attribute.Unit,
+		&attribute.CreatedAt,
+		&attribute.CreatedBy,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &attribute, nil
+}
+
+// GetCategoryAttributesByCategoryIds returns the attributes defined on any of
+// the categories, ordered by name.
+func (cr *categoryRepository) GetCategoryAttributesByCategoryIds(ctx context.Context, categoryIds []string) ([]*entity.CategoryAttribute, error) {
+	qb := newQueryBuilder("is_deleted = false")
+	qb.WhereIn("category_id", categoryIds)
+
+	return cr.getCategoryAttributes(ctx, qb)
+}
+
+// GetCategoryAttributesByCode returns the attributes of every category that
+// use code.
+func (cr *categoryRepository) GetCategoryAttributesByCode(ctx context.Context, code string) ([]*entity.CategoryAttribute, error) {
+	qb := newQueryBuilder("is_deleted = false")
+	qb.Where("code = " + qb.Bind(code))
+
+	return cr.getCategoryAttributes(ctx, qb)
+}
+
+func (cr *categoryRepository) getCategoryAttributes(ctx context.Context, qb *queryBuilder) ([]*entity.CategoryAttribute, error) {
+	rows, err := cr.db.QueryContext(
+		ctx,
+		"SELECT id, category_id, code, name, type, unit, created_at, created_by FROM category_attribute "+qb.WhereQuery()+" ORDER BY name ASC, id ASC",
+		qb.Args()...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := make([]*entity.CategoryAttribute, 0)
+	for rows.Next() {
+		var attribute entity.CategoryAttribute
+		err = rows.Scan(
+			&attribute.Id,
+			&attribute.CategoryId,
+			&attribute.Code,
+			&attribute.Name,
+			&attribute.Type,
+			&attribute.Unit,
+			&attribute.CreatedAt,
+			&attribute.CreatedBy,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		attributes = append(attributes, &attribute)
+	}
+
+	return attributes, nil
+}
+
+func (cr *categoryRepository) UpdateCategoryAttribute(ctx context.Context, attribute *entity.CategoryAttribute) error {
+	_, err := cr.db.ExecContext(
+		ctx,
+		"UPDATE category_attribute SET name = $1, unit = $2, updated_at = $3, updated_by = $4 WHERE id = $5",
+		attribute.Name,
+		attribute.Unit,
+		attribute.UpdatedAt,
+		attribute.UpdatedBy,
+		attribute.Id,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteCategoryAttribute soft deletes the attribute. The values products
+// have for it are kept but no longer shown or filtered by.
+func (cr *categoryRepository) DeleteCategoryAttribute(ctx context.Context, id string, deletedAt time.Time, deletedBy string) error {
+	_, err := cr.db.ExecContext(
+		ctx,
+		"UPDATE category_attribute SET deleted_at = $1, deleted_by = $2, is_deleted = true WHERE id = $3",
+		deletedAt,
+		deletedBy,
+		id,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
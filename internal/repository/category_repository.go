@@ -0,0 +1,251 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/xryar/golang-grpc-ecommerce/internal/entity"
+	"github.com/xryar/golang-grpc-ecommerce/pkg/database"
+)
+
+// categoryTreeQuery selects the id of a category and all of its descendants.
+// It expects the root category id as its only argument.
+const categoryTreeQuery = `
+	WITH RECURSIVE category_tree AS (
+		SELECT id FROM category WHERE id = $1 AND is_deleted = false
+		UNION ALL
+		SELECT c.id FROM category c JOIN category_tree ct ON c.parent_id = ct.id WHERE c.is_deleted = false
+	)
+	SELECT id FROM category_tree
+`
+
+type ICategoryRepository interface {
+	CreateNewCategory(ctx context.Context, category *entity.Category) error
+	GetCategoryById(ctx context.Context, id string) (*entity.Category, error)
+	GetCategories(ctx context.Context) ([]*entity.Category, error)
+	GetCategoryDescendantIds(ctx context.Context, id string) ([]string, error)
+	GetCategoryAncestors(ctx context.Context, id string) ([]*entity.Category, error)
+	CountCategoryChildren(ctx context.Context, id string) (int, error)
+	CountCategoryProducts(ctx context.Context, id string) (int, error)
+	UpdateCategory(ctx context.Context, category *entity.Category) error
+	DeleteCategory(ctx context.Context, id string, deletedAt time.Time, deletedBy string) error
+	CreateCategoryAttribute(ctx context.Context, attribute *entity.CategoryAttribute) error
+	GetCategoryAttributeById(ctx context.Context, id string) (*entity.CategoryAttribute, error)
+	GetCategoryAttributesByCategoryIds(ctx context.Context, categoryIds []string) ([]*entity.CategoryAttribute, error)
+	GetCategoryAttributesByCode(ctx context.Context, code string) ([]*entity.CategoryAttribute, error)
+	UpdateCategoryAttribute(ctx context.Context, attribute *entity.CategoryAttribute) error
+	DeleteCategoryAttribute(ctx context.Context, id string, deletedAt time.Time, deletedBy string) error
+}
+
+type categoryRepository struct {
+	db database.DatabaseQuery
+}
+
+func (cr *categoryRepository) CreateNewCategory(ctx context.Context, category *entity.Category) error {
+	_, err := cr.db.ExecContext(
+		ctx,
+		"INSERT INTO category (id, name, parent_id, created_at, created_by, updated_at, updated_by, deleted_at, deleted_by, is_deleted) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
+		category.Id,
+		category.Name,
+		category.ParentId,
+		category.CreatedAt,
+		category.CreatedBy,
+		category.UpdatedAt,
+		category.UpdatedBy,
+		category.DeletedAt,
+		category.DeletedBy,
+		category.IsDeleted,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (cr *categoryRepository) GetCategoryById(ctx context.Context, id string) (*entity.Category, error) {
+	row := cr.db.QueryRowContext(
+		ctx,
+		"SELECT id, name, parent_id FROM category WHERE id = $1 AND is_deleted = false",
+		id,
+	)
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+
+	var category entity.Category
+	err := row.Scan(
+		&category.Id,
+		&category.Name,
+		&category.ParentId,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &category, nil
+}
+
+func (cr *categoryRepository) GetCategories(ctx context.Context) ([]*entity.Category, error) {
+	rows, err := cr.db.QueryContext(
+		ctx,
+		"SELECT id, name, parent_id FROM category WHERE is_deleted = false ORDER BY name ASC",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	categories := make([]*entity.Category, 0)
+	for rows.Next() {
+		var category entity.Category
+		err = rows.Scan(
+			&category.Id,
+			&category.Name,
+			&category.ParentId,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		categories = append(categories, &category)
+	}
+
+	return categories, nil
+}
+
+func (cr *categoryRepository) GetCategoryDescendantIds(ctx context.Context, id string) ([]string, error) {
+	rows, err := cr.db.QueryContext(ctx, categoryTreeQuery, id)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0)
+	for rows.Next() {
+		var categoryId string
+		err = rows.Scan(&categoryId)
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, categoryId)
+	}
+
+	return ids, nil
+}
+
+func (cr *categoryRepository) GetCategoryAncestors(ctx context.Context, id string) ([]*entity.Category, error) {
+	rows, err := cr.db.QueryContext(
+		ctx,
+		`
+		WITH RECURSIVE category_path AS (
+			SELECT id, name, parent_id, 0 AS depth FROM category WHERE id = $1 AND is_deleted = false
+			UNION ALL
+			SELECT c.id, c.name, c.parent_id, cp.depth + 1 FROM category c JOIN category_path cp ON c.id = cp.parent_id WHERE c.is_deleted = false
+		)
+		SELECT id, name, parent_id FROM category_path ORDER BY depth DESC
+		`,
+		id,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	categories := make([]*entity.Category, 0)
+	for rows.Next() {
+		var category entity.Category
+		err = rows.Scan(
+			&category.Id,
+			&category.Name,
+			&category.ParentId,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		categories = append(categories, &category)
+	}
+
+	return categories, nil
+}
+
+func (cr *categoryRepository) CountCategoryChildren(ctx context.Context, id string) (int, error) {
+	row := cr.db.QueryRowContext(
+		ctx,
+		"SELECT COUNT(*) FROM category WHERE parent_id = $1 AND is_deleted = false",
+		id,
+	)
+	if row.Err() != nil {
+		return 0, row.Err()
+	}
+
+	var count int
+	err := row.Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (cr *categoryRepository) CountCategoryProducts(ctx context.Context, id string) (int, error) {
+	row := cr.db.QueryRowContext(
+		ctx,
+		"SELECT COUNT(*) FROM product WHERE category_id = $1",
+		id,
+	)
+	if row.Err() != nil {
+		return 0, row.Err()
+	}
+
+	var count int
+	err := row.Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (cr *categoryRepository) UpdateCategory(ctx context.Context, category *entity.Category) error {
+	_, err := cr.db.ExecContext(
+		ctx,
+		"UPDATE category SET name = $1, parent_id = $2, updated_at = $3, updated_by = $4 WHERE id = $5",
+		category.Name,
+		category.ParentId,
+		category.UpdatedAt,
+		category.UpdatedBy,
+		category.Id,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (cr *categoryRepository) DeleteCategory(ctx context.Context, id string, deletedAt time.Time, deletedBy string) error {
+	_, err := cr.db.ExecContext(
+		ctx,
+		"UPDATE category SET deleted_at = $1, deleted_by = $2, is_deleted = true WHERE id = $3",
+		deletedAt,
+		deletedBy,
+		id,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewCategoryRepository(db database.DatabaseQuery) ICategoryRepository {
+	return &categoryRepository{
+		db: db,
+	}
+}
@@ -0,0 +1,242 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/xryar/golang-grpc-ecommerce/internal/entity"
+	"github.com/xryar/golang-grpc-ecommerce/pkg/database"
+)
+
+type ICollectionRepository interface {
+	WithTransaction(tx *sql.Tx) ICollectionRepository
+	CreateNewCollection(ctx context.Context, collection *entity.Collection) error
+	GetCollectionById(ctx context.Context, id string) (*entity.Collection, error)
+	GetCollectionByKey(ctx context.Context, key string) (*entity.Collection, error)
+	GetCollections(ctx context.Context) ([]*entity.Collection, error)
+	UpdateCollection(ctx context.Context, collection *entity.Collection) error
+	DeleteCollection(ctx context.Context, id string, deletedAt time.Time, deletedBy string) error
+	SetCollectionProducts(ctx context.Context, collectionId string, productIds []string) error
+}
+
+type collectionRepository struct {
+	db database.DatabaseQuery
+}
+
+func (cr *collectionRepository) WithTransaction(tx *sql.Tx) ICollectionRepository {
+	return &collectionRepository{
+		db: tx,
+	}
+}
+
+func (cr *collectionRepository) CreateNewCollection(ctx context.Context, collection *entity.Collection) error {
+	_, err := cr.db.ExecContext(
+		ctx,
+		"INSERT INTO product_collection (id, key, name, rule_type, rule_days, product_limit, starts_at, ends_at, created_at, created_by, updated_at, updated_by, deleted_at, deleted_by, is_deleted) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)",
+		collection.Id,
+		collection.Key,
+		collection.Name,
+		collection.RuleType,
+		collection.RuleDays,
+		collection.ProductLimit,
+		collection.StartsAt,
+		collection.EndsAt,
+		collection.CreatedAt,
+		collection.CreatedBy,
+		collection.UpdatedAt,
+		collection.UpdatedBy,
+		collection.DeletedAt,
+		collection.DeletedBy,
+		collection.IsDeleted,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (cr *collectionRepository) GetCollectionById(ctx context.Context, id string) (*entity.Collection, error) {
+	row := cr.db.QueryRowContext(
+		ctx,
+		"SELECT id, key, name, rule_type, rule_days, product_limit, starts_at, ends_at FROM product_collection WHERE id = $1 AND is_deleted = false",
+		id,
+	)
+
+	return scanCollection(row)
+}
+
+func (cr *collectionRepository) GetCollectionByKey(ctx context.Context, key string) (*entity.Collection, error) {
+	row := cr.db.QueryRowContext(
+		ctx,
+		"SELECT id, key, name, rule_type, rule_days, product_limit, starts_at, ends_at FROM product_collection WHERE key = $1 AND is_deleted = false",
+		key,
+	)
+
+	return scanCollection(row)
+}
+
+func scanCollection(row *sql.Row) (*entity.Collection, error) {
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+
+	var collection entity.Collection
+	err := row.Scan(
+		&collection.Id,
+		&collection.Key,
+		&collection.Name,
+		&collection.RuleType,
+		&collection.RuleDays,
+		&collection.ProductLimit,
+		&collection.StartsAt,
+		&collection.EndsAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &collection, nil
+}
+
+// GetCollections returns every collection with the ids of its hand-picked
+// products in order.
+func (cr *collectionRepository) GetCollections(ctx context.Context) ([]*entity.Collection, error) {
+	rows, err := cr.db.QueryContext(
+		ctx,
+		"SELECT id, key, name, rule_type, rule_days, product_limit, starts_at, ends_at FROM product_collection WHERE is_deleted = false ORDER BY name ASC",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	collections := make([]*entity.Collection, 0)
+	collectionMap := make(map[string]*entity.Collection)
+	for rows.Next() {
+		var collection entity.Collection
+		err = rows.Scan(
+			&collection.Id,
+			&collection.Key,
+			&collection.Name,
+			&collection.RuleType,
+			&collection.RuleDays,
+			&collection.ProductLimit,
+			&collection.StartsAt,
+			&collection.EndsAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		collection.ProductIds = make([]string, 0)
+		collections = append(collections, &collection)
+		collectionMap[collection.Id] = &collection
+	}
+
+	if len(collections) == 0 {
+		return collections, nil
+	}
+
+	collectionIds := make([]string, len(collections))
+	for i, collection := range collections {
+		collectionIds[i] = collection.Id
+	}
+	itemRows, err := cr.db.QueryContext(
+		ctx,
+		"SELECT collection_id, product_id FROM product_collection_item WHERE collection_id = ANY($1) ORDER BY position ASC",
+		pq.Array(collectionIds),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for itemRows.Next() {
+		var collectionId, productId string
+		err = itemRows.Scan(&collectionId, &productId)
+		if err != nil {
+			return nil, err
+		}
+
+		collectionMap[collectionId].ProductIds = append(collectionMap[collectionId].ProductIds, productId)
+	}
+
+	return collections, nil
+}
+
+func (cr *collectionRepository) UpdateCollection(ctx context.Context, collection *entity.Collection) error {
+	_, err := cr.db.ExecContext(
+		ctx,
+		"UPDATE product_collection SET key = $1, name = $2, rule_type = $3, rule_days = $4, product_limit = $5, starts_at = $6, ends_at = $7, updated_at = $8, updated_by = $9 WHERE id = $10",
+		collection.Key,
+		collection.Name,
+		collection.RuleType,
+		collection.RuleDays,
+		collection.ProductLimit,
+		collection.StartsAt,
+		collection.EndsAt,
+		collection.UpdatedAt,
+		collection.UpdatedBy,
+		collection.Id,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (cr *collectionRepository) DeleteCollection(ctx context.Context, id string, deletedAt time.Time, deletedBy string) error {
+	_, err := cr.db.ExecContext(
+		ctx,
+		"UPDATE product_collection SET deleted_at = $1, deleted_by = $2, is_deleted = true WHERE id = $3",
+		deletedAt,
+		deletedBy,
+		id,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SetCollectionProducts replaces the hand-picked products of a collection,
+// keeping them in the order of productIds. Run it in a transaction.
+func (cr *collectionRepository) SetCollectionProducts(ctx context.Context, collectionId string, productIds []string) error {
+	_, err := cr.db.ExecContext(
+		ctx,
+		"DELETE FROM product_collection_item WHERE collection_id = $1",
+		collectionId,
+	)
+	if err != nil {
+		return err
+	}
+
+	for i, productId := range productIds {
+		_, err = cr.db.ExecContext(
+			ctx,
+			"INSERT INTO product_collection_item (collection_id, product_id, position) VALUES ($1, $2, $3)",
+			collectionId,
+			productId,
+			i,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func NewCollectionRepository(db database.DatabaseQuery) ICollectionRepository {
+	return &collectionRepository{
+		db: db,
+	}
+}
@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/xryar/golang-grpc-ecommerce/internal/entity"
+	"github.com/xryar/golang-grpc-ecommerce/pkg/database"
+)
+
+type IDownloadEntitlementRepository interface {
+	WithTransaction(tx *sql.Tx) IDownloadEntitlementRepository
+	CreateDownloadEntitlement(ctx context.Context, entitlement *entity.DownloadEntitlement) error
+	GetDownloadEntitlementById(ctx context.Context, id string) (*entity.DownloadEntitlement, error)
+	GetDownloadEntitlementsByOrderId(ctx context.Context, orderId string) ([]*entity.DownloadEntitlement, error)
+	UseDownloadEntitlement(ctx context.Context, id string, usedAt time.Time) (bool, error)
+}
+
+type downloadEntitlementRepository struct {
+	db database.DatabaseQuery
+}
+
+func (dr *downloadEntitlementRepository) WithTransaction(tx *sql.Tx) IDownloadEntitlementRepository {
+	return &downloadEntitlementRepository{
+		db: tx,
+	}
+}
+
+// CreateDownloadEntitlement does nothing when the order item already has an
+// entitlement.
+func (dr *downloadEntitlementRepository) CreateDownloadEntitlement(ctx context.Context, entitlement *entity.DownloadEntitlement) error {
+	_, err := dr.db.ExecContext(
+		ctx,
+		"INSERT INTO download_entitlement (id, order_id, order_item_id, user_id, product_id, product_name, file_name, max_downloads, download_count, last_downloaded_at, created_at, created_by) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) ON CONFLICT (order_item_id) DO NOTHING",
+		entitlement.Id,
+		entitlement.OrderId,
+		entitlement.OrderItemId,
+		entitlement.UserId,
+		entitlement.ProductId,
+		entitlement.ProductName,
+		entitlement.FileName,
+		entitlement.MaxDownloads,
+		entitlement.DownloadCount,
+		entitlement.LastDownloadedAt,
+		entitlement.CreatedAt,
+		entitlement.CreatedBy,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (dr *downloadEntitlementRepository) GetDownloadEntitlementById(ctx context.Context, id string) (*entity.DownloadEntitlement, error) {
+	row := dr.db.QueryRowContext(
+		ctx,
+		"SELECT id, order_id, order_item_id, user_id, product_id, product_name, file_name, max_downloads, download_count, last_downloaded_at, created_at, created_by FROM download_entitlement WHERE id = $1",
+		id,
+	)
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+
+	var entitlement entity.DownloadEntitlement
+	err := row.Scan(
+		&entitlement.Id,
+		&entitlement.OrderId,
+		&entitlement.OrderItemId,
+		&entitlement.UserId,
+		&entitlement.ProductId,
+		&entitlement.ProductName,
+		&entitlement.FileName,
+		&entitlement.MaxDownloads,
+		&entitlement.DownloadCount,
+		&entitlement.LastDownloadedAt,
+		&entitlement.CreatedAt,
+		&entitlement.CreatedBy,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &entitlement, nil
+}
+
+func (dr *downloadEntitlementRepository) GetDownloadEntitlementsByOrderId(ctx context.Context, orderId string) ([]*entity.DownloadEntitlement, error) {
+	rows, err := dr.db.QueryContext(
+		ctx,
+		"SELECT id, order_id, order_item_id, user_id, product_id, product_name, file_name, max_downloads, download_count, last_downloaded_at, created_at, created_by FROM download_entitlement WHERE order_id = $1 ORDER BY product_name ASC, id ASC",
+		orderId,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var entitlements []*entity.DownloadEntitlement = make([]*entity.DownloadEntitlement, 0)
+	for rows.Next() {
+		var entitlement entity.DownloadEntitlement
+		err = rows.Scan(
+			&entitlement.Id,
+			&entitlement.OrderId,
+			&entitlement.OrderItemId,
+			&entitlement.UserId,
+			&entitlement.ProductId,
+			&entitlement.ProductName,
+			&entitlement.FileName,
+			&entitlement.MaxDownloads,
+			&entitlement.DownloadCount,
+			&entitlement.LastDownloadedAt,
+			&entitlement.CreatedAt,
+			&entitlement.CreatedBy,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		entitlements = append(entitlements, &entitlement)
+	}
+
+	return entitlements, nil
+}
+
+// UseDownloadEntitlement counts one download and reports false, counting
+// nothing, when the entitlement has no downloads left.
+func (dr *downloadEntitlementRepository) UseDownloadEntitlement(ctx context.Context, id string, usedAt time.Time) (bool, error) {
+	result, err := dr.db.ExecContext(
+		ctx,
+		"UPDATE download_entitlement SET download_count = download_count + 1, last_downloaded_at = $1 WHERE id = $2 AND download_count < max_downloads",
+		usedAt,
+		id,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func NewDownloadEntitlementRepository(db database.DatabaseQuery) IDownloadEntitlementRepository {
+	return &downloadEntitlementRepository{
+		db: db,
+	}
+}
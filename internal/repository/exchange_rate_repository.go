@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/xryar/golang-grpc-ecommerce/internal/entity"
+	"github.com/xryar/golang-grpc-ecommerce/pkg/database"
+)
+
+type IExchangeRateRepository interface {
+	CreateExchangeRate(ctx context.Context, exchangeRate *entity.ExchangeRate) error
+	GetExchangeRateById(ctx context.Context, id string) (*entity.ExchangeRate, error)
+	GetEffectiveExchangeRate(ctx context.Context, currencyCode string, at time.Time) (*entity.ExchangeRate, error)
+	GetExchangeRates(ctx context.Context, currencyCode string) ([]*entity.ExchangeRate, error)
+	DeleteExchangeRate(ctx context.Context, id string, deletedAt time.Time, deletedBy string) error
+}
+
+type exchangeRateRepository struct {
+	db database.DatabaseQuery
+}
+
+func (er *exchangeRateRepository) CreateExchangeRate(ctx context.Context, exchangeRate *entity.ExchangeRate) error {
+	_, err := er.db.ExecContext(
+		ctx,
+		"INSERT INTO exchange_rate (id, currency_code, rate, effective_at, created_at, created_by, deleted_at, deleted_by, is_deleted) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)",
+		exchangeRate.Id,
+		exchangeRate.CurrencyCode,
+		exchangeRate.Rate,
+		exchangeRate.EffectiveAt,
+		exchangeRate.CreatedAt,
+		exchangeRate.CreatedBy,
+		exchangeRate.DeletedAt,
+		exchangeRate.DeletedBy,
+		exchangeRate.IsDeleted,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (er *exchangeRateRepository) GetExchangeRateById(ctx context.Context, id string) (*entity.ExchangeRate, error) {
+	row := er.db.QueryRowContext(
+		ctx,
+		"SELECT id, currency_code, rate, effective_at, created_at, created_by FROM exchange_rate WHERE id = $1 AND is_deleted = false",
+		id,
+	)
+
+	return scanExchangeRate(row)
+}
+
+// GetEffectiveExchangeRate returns the latest rate of currencyCode that took
+// effect at or before at, or nil when there is none.
+func (er *exchangeRateRepository) GetEffectiveExchangeRate(ctx context.Context, currencyCode string, at time.Time) (*entity.ExchangeRate, error) {
+	row := er.db.QueryRowContext(
+		ctx,
+		"SELECT id, currency_code, rate, effective_at, created_at, created_by FROM exchange_rate WHERE currency_code = $1 AND effective_at <= $2 AND is_deleted = false ORDER BY effective_at DESC, created_at DESC LIMIT 1",
+		currencyCode,
+		at,
+	)
+
+	return scanExchangeRate(row)
+}
+
+// GetExchangeRates lists the rates of currencyCode, or of every currency when
+// it is empty, the latest effective first.
+func (er *exchangeRateRepository) GetExchangeRates(ctx context.Context, currencyCode string) ([]*entity.ExchangeRate, error) {
+	rows, err := er.db.QueryContext(
+		ctx,
+		"SELECT id, currency_code, rate, effective_at, created_at, created_by FROM exchange_rate WHERE ($1 = '' OR currency_code = $1) AND is_deleted = false ORDER BY currency_code ASC, effective_at DESC, created_at DESC",
+		currencyCode,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var exchangeRates []*entity.ExchangeRate = make([]*entity.ExchangeRate, 0)
+	for rows.Next() {
+		var exchangeRate entity.ExchangeRate
+		err = rows.Scan(
+			&exchangeRate.Id,
+			&exchangeRate.CurrencyCode,
+			&exchangeRate.Rate,
+			&exchangeRate.EffectiveAt,
+			&exchangeRate.CreatedAt,
+			&exchangeRate.CreatedBy,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		exchangeRates = append(exchangeRates, &exchangeRate)
+	}
+
+	return exchangeRates, nil
+}
+
+func (er *exchangeRateRepository) DeleteExchangeRate(ctx context.Context, id string, deletedAt time.Time, deletedBy string) error {
+	_, err := er.db.ExecContext(
+		ctx,
+		"UPDATE exchange_rate SET deleted_at = $1, deleted_by = $2, is_deleted = true WHERE id = $3",
+		deletedAt,
+		deletedBy,
+		id,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func scanExchangeRate(row *sql.Row) (*entity.ExchangeRate, error) {
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+
+	var exchangeRate entity.ExchangeRate
+	err := row.Scan(
+		&exchangeRate.Id,
+		&exchangeRate.CurrencyCode,
+		&exchangeRate.Rate,
+		&exchangeRate.EffectiveAt,
+		&exchangeRate.CreatedAt,
+		&exchangeRate.CreatedBy,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &exchangeRate, nil
+}
+
+func NewExchangeRateRepository(db database.DatabaseQuery) IExchangeRateRepository {
+	return &exchangeRateRepository{
+		db: db,
+	}
+}
@@ -5,7 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
-	"strings"
+	"time"
 
 	"github.com/xryar/golang-grpc-ecommerce/internal/entity"
 	"github.com/xryar/golang-grpc-ecommerce/pb/common"
@@ -19,7 +19,11 @@ type IOrderRepository interface {
 	UpdateNumbering(ctx context.Context, numbering *entity.Numbering) error
 	CreateOrderItem(ctx context.Context, orderItem *entity.OrderItem) error
 	GetOrderById(ctx context.Context, orderId string) (*entity.Order, error)
+	GetExpiredUnpaidOrderIds(ctx context.Context, now time.Time, limit int) ([]string, error)
+	DeferOrderExpiry(ctx context.Context, orderId string, now time.Time) error
+	LockOrderWithStatus(ctx context.Context, orderId string, statusCode string) (bool, error)
 	UpdateOrder(ctx context.Context, order *entity.Order) error
+	UpdateOrderFromStatus(ctx context.Context, order *entity.Order, fromStatusCode string) (bool, error)
 	GetListOrderAdminPagination(ctx context.Context, pagination *common.PaginationRequest) ([]*entity.Order, *common.PaginationResponse, error)
 	GetListOrderPagination(ctx context.Context, pagination *common.PaginationRequest, userId string) ([]*entity.Order, *common.PaginationResponse, error)
 }
@@ -63,7 +67,7 @@ func (or *orderRepository) GetNumbering(ctx context.Context, module string) (*en
 func (or *orderRepository) CreateOrder(ctx context.Context, order *entity.Order) error {
 	_, err := or.db.ExecContext(
 		ctx,
-		"INSERT INTO \"order\" (id, number, user_id, order_status_code, user_full_name, address, phone_number, notes, total, expired_at, created_at, created_by, updated_at, updated_by, deleted_at, deleted_by, is_deleted, xendit_invoice_id, xendit_invoice_url) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)",
+		"INSERT INTO \"order\" (id, number, user_id, order_status_code, user_full_name, address, phone_number, notes, total, expired_at, created_at, created_by, updated_at, updated_by, deleted_at, deleted_by, is_deleted, xendit_invoice_id, xendit_invoice_url, currency, display_currency, exchange_rate) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22)",
 		order.Id,
 		order.Number,
 		order.UserId,
@@ -83,6 +87,9 @@ func (or *orderRepository) CreateOrder(ctx context.Context, order *entity.Order)
 		order.IsDeleted,
 		order.XenditInvoiceId,
 		order.XenditInvoiceUrl,
+		order.Currency,
+		order.DisplayCurrency,
+		order.ExchangeRate,
 	)
 	if err != nil {
 		return err
@@ -108,12 +115,16 @@ func (or *orderRepository) UpdateNumbering(ctx context.Context, numbering *entit
 func (or *orderRepository) CreateOrderItem(ctx context.Context, orderItem *entity.OrderItem) error {
 	_, err := or.db.ExecContext(
 		ctx,
-		"INSERT INTO order_item (id, product_id, product_name, product_image_file_name, product_price, quantity, order_id, created_at, created_by, updated_at, updated_by, deleted_at, deleted_by, is_deleted) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)",
+		"INSERT INTO order_item (id, product_id, product_variant_id, product_variant_sku, product_variant_attributes, product_name, product_image_file_name, product_price, product_original_price, quantity, order_id, created_at, created_by, updated_at, updated_by, deleted_at, deleted_by, is_deleted, bundle_product_id, bundle_product_name, product_digital_file_name) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21)",
 		orderItem.Id,
 		orderItem.ProductId,
+		orderItem.ProductVariantId,
+		orderItem.ProductVariantSku,
+		orderItem.ProductVariantAttributes,
 		orderItem.ProductName,
 		orderItem.ProductImageFileName,
 		orderItem.ProductPrice,
+		orderItem.ProductOriginalPrice,
 		orderItem.Quantity,
 		orderItem.OrderId,
 		orderItem.CreatedAt,
@@ -123,6 +134,9 @@ func (or *orderRepository) CreateOrderItem(ctx context.Context, orderItem *entit
 		orderItem.DeletedAt,
 		orderItem.DeletedBy,
 		orderItem.IsDeleted,
+		orderItem.BundleProductId,
+		orderItem.BundleProductName,
+		orderItem.ProductDigitalFileName,
 	)
 	if err != nil {
 		return err
@@ -134,7 +148,7 @@ func (or *orderRepository) CreateOrderItem(ctx context.Context, orderItem *entit
 func (or *orderRepository) GetOrderById(ctx context.Context, orderId string) (*entity.Order, error) {
 	row := or.db.QueryRowContext(
 		ctx,
-		"SELECT id, number, user_full_name, address, phone_number, notes, order_status_code, total, created_at, xendit_invoice_url, user_id, expired_at, xendit_paid_at, xendit_payment_channel, xendit_payment_method FROM \"order\" WHERE id = $1 AND is_deleted = false",
+		"SELECT id, number, user_full_name, address, phone_number, notes, order_status_code, total, created_at, xendit_invoice_id, xendit_invoice_url, user_id, expired_at, xendit_paid_at, xendit_payment_channel, xendit_payment_method, currency, display_currency, exchange_rate FROM \"order\" WHERE id = $1 AND is_deleted = false",
 		orderId,
 	)
 	if row.Err() != nil {
@@ -152,12 +166,16 @@ func (or *orderRepository) GetOrderById(ctx context.Context, orderId string) (*e
 		&order.OrderStatusCode,
 		&order.Total,
 		&order.CreatedAt,
+		&order.XenditInvoiceId,
 		&order.XenditInvoiceUrl,
 		&order.UserId,
 		&order.ExpiredAt,
 		&order.XenditPaidAt,
 		&order.XenditPaymentChannel,
 		&order.XenditPaymentMethod,
+		&order.Currency,
+		&order.DisplayCurrency,
+		&order.ExchangeRate,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -168,7 +186,7 @@ func (or *orderRepository) GetOrderById(ctx context.Context, orderId string) (*e
 
 	rows, err := or.db.QueryContext(
 		ctx,
-		"SELECT product_id, product_name, product_price, quantity FROM order_item WHERE order_id = $1 AND is_deleted = false",
+		"SELECT id, product_id, product_variant_id, product_variant_sku, product_variant_attributes, product_name, product_price, product_original_price, quantity, bundle_product_id, bundle_product_name, product_digital_file_name FROM order_item WHERE order_id = $1 AND is_deleted = false",
 		orderId,
 	)
 	if err != nil {
@@ -180,10 +198,18 @@ func (or *orderRepository) GetOrderById(ctx context.Context, orderId string) (*e
 		var item entity.OrderItem
 
 		err := rows.Scan(
+			&item.Id,
 			&item.ProductId,
+			&item.ProductVariantId,
+			&item.ProductVariantSku,
+			&item.ProductVariantAttributes,
 			&item.ProductName,
 			&item.ProductPrice,
+			&item.ProductOriginalPrice,
 			&item.Quantity,
+			&item.BundleProductId,
+			&item.BundleProductName,
+			&item.ProductDigitalFileName,
 		)
 		if err != nil {
 			return nil, err
@@ -197,6 +223,72 @@ func (or *orderRepository) GetOrderById(ctx context.Context, orderId string) (*e
 	return &order, nil
 }
 
+func (or *orderRepository) GetExpiredUnpaidOrderIds(ctx context.Context, now time.Time, limit int) ([]string, error) {
+	rows, err := or.db.QueryContext(
+		ctx,
+		"SELECT id FROM \"order\" WHERE order_status_code = $1 AND expired_at < $2 AND (expiry_retry_at IS NULL OR expiry_retry_at <= $2) AND is_deleted = false ORDER BY expired_at LIMIT $3",
+		entity.OrderStatusCodeUnpaid,
+		now,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0)
+	for rows.Next() {
+		var id string
+		err = rows.Scan(&id)
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// DeferOrderExpiry records a failed attempt to expire the order and holds it
+// back from the expiry job for 1, 2, 4 and so on up to 1024 minutes.
+func (or *orderRepository) DeferOrderExpiry(ctx context.Context, orderId string, now time.Time) error {
+	_, err := or.db.ExecContext(
+		ctx,
+		"UPDATE \"order\" SET expiry_attempts = expiry_attempts + 1, expiry_retry_at = $1::timestamptz + make_interval(mins => CAST(POWER(2, LEAST(expiry_attempts, 10)) AS INTEGER)) WHERE id = $2",
+		now,
+		orderId,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (or *orderRepository) LockOrderWithStatus(ctx context.Context, orderId string, statusCode string) (bool, error) {
+	row := or.db.QueryRowContext(
+		ctx,
+		"SELECT id FROM \"order\" WHERE id = $1 AND order_status_code = $2 AND is_deleted = false FOR UPDATE SKIP LOCKED",
+		orderId,
+		statusCode,
+	)
+	if row.Err() != nil {
+		return false, row.Err()
+	}
+
+	var id string
+	err := row.Scan(&id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
 func (or *orderRepository) UpdateOrder(ctx context.Context, order *entity.Order) error {
 	_, err := or.db.ExecContext(
 		ctx,
@@ -216,10 +308,64 @@ func (or *orderRepository) UpdateOrder(ctx context.Context, order *entity.Order)
 	return nil
 }
 
+func (or *orderRepository) UpdateOrderFromStatus(ctx context.Context, order *entity.Order, fromStatusCode string) (bool, error) {
+	result, err := or.db.ExecContext(
+		ctx,
+		"UPDATE \"order\" SET updated_at = $1, updated_by = $2, xendit_paid_at = $3, xendit_payment_channel = $4, xendit_payment_method = $5, order_status_code = $6 WHERE id = $7 AND order_status_code = $8",
+		order.UpdatedAt,
+		order.UpdatedBy,
+		order.XenditPaidAt,
+		order.XenditPaymentChannel,
+		order.XenditPaymentMethod,
+		order.OrderStatusCode,
+		order.Id,
+		fromStatusCode,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// orderSortFields and orderFilterFields are what order listings may be
+// sorted and filtered by.
+var (
+	orderSortFields = queryFields{
+		"number":     {Column: "number", Type: queryValueText},
+		"customer":   {Column: "user_full_name", Type: queryValueText},
+		"total":      {Column: "total", Type: queryValueNumber},
+		"created_at": {Column: "created_at", Type: queryValueTime},
+	}
+	orderFilterFields = queryFields{
+		"number":      {Column: "number", Type: queryValueText},
+		"customer":    {Column: "user_full_name", Type: queryValueText},
+		"status_code": {Column: "order_status_code", Type: queryValueText},
+		"total":       {Column: "total", Type: queryValueNumber},
+		"created_at":  {Column: "created_at", Type: queryValueTime},
+	}
+)
+
 func (or *orderRepository) GetListOrderAdminPagination(ctx context.Context, pagination *common.PaginationRequest) ([]*entity.Order, *common.PaginationResponse, error) {
+	qb := newQueryBuilder("is_deleted = false")
+	qb.Filter(orderFilterFields, pagination.Filters)
+	sort := qb.Sort(orderSortFields, pagination.Sort)
+	if isCursorPagination(pagination) {
+		return or.getListOrderAdminKeyset(ctx, pagination, qb, sort)
+	}
+	if err := qb.Err(); err != nil {
+		return nil, nil, err
+	}
+
 	row := or.db.QueryRowContext(
 		ctx,
-		"SELECT COUNT(*) FROM \"order\" WHERE is_deleted = false",
+		fmt.Sprintf("SELECT COUNT(*) FROM \"order\" %s", qb.WhereQuery()),
+		qb.Args()...,
 	)
 	if row.Err() != nil {
 		return nil, nil, row.Err()
@@ -234,38 +380,22 @@ func (or *orderRepository) GetListOrderAdminPagination(ctx context.Context, pagi
 	offset := (pagination.CurrentPage - 1) * pagination.ItemPerPage
 	totalPages := (totalCount + int(pagination.ItemPerPage) - 1) / int(pagination.ItemPerPage)
 
-	allowedSorts := map[string]string{
-		"number":     "number",
-		"customer":   "user_full_name",
-		"total":      "total",
-		"created_at": "created_at",
-	}
-	sort := "ORDER BY created_at DESC"
-	if pagination.Sort != nil {
-		direction := "ASC"
-		sortField, ok := allowedSorts[pagination.Sort.Field]
-		if ok {
-			if pagination.Sort.Direction == "desc" {
-				direction = "DESC"
-			}
-			sort = fmt.Sprintf("ORDER BY %s %s", sortField, direction)
-		}
+	orderQuery := "ORDER BY created_at DESC"
+	if sort != nil {
+		orderQuery = sort.OrderQuery() + ", id ASC"
 	}
 
-	baseQuery := fmt.Sprintf("SELECT id, number, order_status_code, total, user_full_name, created_at, expired_at FROM \"order\" WHERE is_deleted = false %s LIMIT $1 OFFSET $2", sort)
+	baseQuery := fmt.Sprintf("SELECT id, number, order_status_code, total, user_full_name, created_at, expired_at FROM \"order\" %s %s LIMIT %s OFFSET %s", qb.WhereQuery(), orderQuery, qb.Bind(pagination.ItemPerPage), qb.Bind(offset))
 	rows, err := or.db.QueryContext(
 		ctx,
 		baseQuery,
-		pagination.ItemPerPage,
-		offset,
+		qb.Args()...,
 	)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	orders := make([]*entity.Order, 0)
-	ids := make([]string, 0)
-	orderItemsMap := make(map[string][]*entity.OrderItem)
 	for rows.Next() {
 		var orderEntity entity.Order
 		err = rows.Scan(
@@ -282,57 +412,145 @@ func (or *orderRepository) GetListOrderAdminPagination(ctx context.Context, pagi
 		}
 
 		orders = append(orders, &orderEntity)
-		ids = append(ids, fmt.Sprintf("'%s'", orderEntity.Id))
-		orderItemsMap[orderEntity.Id] = make([]*entity.OrderItem, 0)
 	}
 
-	if len(orders) > 0 {
-		idsJoined := strings.Join(ids, ", ")
-		baseOrderItemQuery := fmt.Sprintf("SELECT product_id, product_name, product_price, quantity, order_id FROM order_item WHERE is_deleted = false AND order_id IN (%s)", idsJoined)
-		rows, err = or.db.QueryContext(
-			ctx,
-			baseOrderItemQuery,
+	err = or.attachOrderItems(ctx, orders)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var metadata common.PaginationResponse = common.PaginationResponse{
+		CurrentPage:    pagination.CurrentPage,
+		TotalPageCount: int32(totalPages),
+		ItemPerPage:    pagination.ItemPerPage,
+		TotalItemCount: int32(totalCount),
+	}
+
+	return orders, &metadata, nil
+}
+
+// getListOrderAdminKeyset pages orders by the requested sort, the newest
+// first by default, without counting them.
+func (or *orderRepository) getListOrderAdminKeyset(ctx context.Context, pagination *common.PaginationRequest, qb *queryBuilder, sort *querySort) ([]*entity.Order, *common.PaginationResponse, error) {
+	if sort == nil {
+		sort = &querySort{Field: orderSortFields["created_at"], Descending: true}
+	}
+
+	keyset := buildKeysetQuery(qb, pagination, sort.Field.Column, sort.Field.sqlType(), "id", sort.Descending)
+	if err := qb.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	baseQuery := fmt.Sprintf("SELECT id, number, order_status_code, total, user_full_name, created_at, expired_at, (%s)::text FROM \"order\" %s %s LIMIT %s", sort.Field.Column, qb.WhereQuery(), keyset.OrderQuery, qb.Bind(keyset.Limit))
+	rows, err := or.db.QueryContext(
+		ctx,
+		baseQuery,
+		qb.Args()...,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	orders := make([]*entity.Order, 0)
+	cursorValues := make(map[string]string)
+	for rows.Next() {
+		var orderEntity entity.Order
+		var cursorValue string
+		err = rows.Scan(
+			&orderEntity.Id,
+			&orderEntity.Number,
+			&orderEntity.OrderStatusCode,
+			&orderEntity.Total,
+			&orderEntity.UserFullName,
+			&orderEntity.CreatedAt,
+			&orderEntity.ExpiredAt,
+			&cursorValue,
 		)
 		if err != nil {
 			return nil, nil, err
 		}
 
-		for rows.Next() {
-			var item entity.OrderItem
-			err = rows.Scan(
-				&item.ProductId,
-				&item.ProductName,
-				&item.ProductPrice,
-				&item.Quantity,
-				&item.OrderId,
-			)
-			if err != nil {
-				return nil, nil, err
-			}
-
-			orderItemsMap[item.OrderId] = append(orderItemsMap[item.OrderId], &item)
-		}
+		orders = append(orders, &orderEntity)
+		cursorValues[orderEntity.Id] = cursorValue
+	}
+
+	orders, paginationResponse := keysetPage(keyset, orders, func(orderEntity *entity.Order) (string, string) {
+		return cursorValues[orderEntity.Id], orderEntity.Id
+	})
+
+	err = or.attachOrderItems(ctx, orders)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return orders, paginationResponse, nil
+}
+
+// attachOrderItems loads the items of orders.
+func (or *orderRepository) attachOrderItems(ctx context.Context, orders []*entity.Order) error {
+	if len(orders) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0)
+	orderItemsMap := make(map[string][]*entity.OrderItem)
+	for _, o := range orders {
+		ids = append(ids, o.Id)
+		orderItemsMap[o.Id] = make([]*entity.OrderItem, 0)
+	}
 
-		for i, o := range orders {
-			orders[i].Items = orderItemsMap[o.Id]
+	qb := newQueryBuilder("is_deleted = false")
+	qb.WhereIn("order_id", ids)
+	baseOrderItemQuery := fmt.Sprintf("SELECT product_id, product_variant_id, product_variant_sku, product_variant_attributes, product_name, product_price, product_original_price, quantity, order_id FROM order_item %s", qb.WhereQuery())
+	rows, err := or.db.QueryContext(
+		ctx,
+		baseOrderItemQuery,
+		qb.Args()...,
+	)
+	if err != nil {
+		return err
+	}
+
+	for rows.Next() {
+		var item entity.OrderItem
+		err = rows.Scan(
+			&item.ProductId,
+			&item.ProductVariantId,
+			&item.ProductVariantSku,
+			&item.ProductVariantAttributes,
+			&item.ProductName,
+			&item.ProductPrice,
+			&item.ProductOriginalPrice,
+			&item.Quantity,
+			&item.OrderId,
+		)
+		if err != nil {
+			return err
 		}
+
+		orderItemsMap[item.OrderId] = append(orderItemsMap[item.OrderId], &item)
 	}
 
-	var metadata common.PaginationResponse = common.PaginationResponse{
-		CurrentPage:    pagination.CurrentPage,
-		TotalPageCount: int32(totalPages),
-		ItemPerPage:    pagination.ItemPerPage,
-		TotalItemCount: int32(totalCount),
+	for i, o := range orders {
+		orders[i].Items = orderItemsMap[o.Id]
 	}
 
-	return orders, &metadata, nil
+	return nil
 }
 
 func (or *orderRepository) GetListOrderPagination(ctx context.Context, pagination *common.PaginationRequest, userId string) ([]*entity.Order, *common.PaginationResponse, error) {
+	qb := newQueryBuilder("is_deleted = false")
+	qb.Where("user_id = " + qb.Bind(userId))
+	qb.Filter(orderFilterFields, pagination.Filters)
+	sort := qb.Sort(orderSortFields, pagination.Sort)
+	if err := qb.Err(); err != nil {
+		return nil, nil, err
+	}
+
 	row := or.db.QueryRowContext(
 		ctx,
-		"SELECT COUNT(*) FROM \"order\" WHERE is_deleted = false AND user_id = $1",
-		userId,
+		fmt.Sprintf("SELECT COUNT(*) FROM \"order\" %s", qb.WhereQuery()),
+		qb.Args()...,
 	)
 	if row.Err() != nil {
 		return nil, nil, row.Err()
@@ -347,39 +565,22 @@ func (or *orderRepository) GetListOrderPagination(ctx context.Context, paginatio
 	offset := (pagination.CurrentPage - 1) * pagination.ItemPerPage
 	totalPages := (totalCount + int(pagination.ItemPerPage) - 1) / int(pagination.ItemPerPage)
 
-	allowedSorts := map[string]string{
-		"number":     "number",
-		"customer":   "user_full_name",
-		"total":      "total",
-		"created_at": "created_at",
-	}
-	sort := "ORDER BY created_at DESC"
-	if pagination.Sort != nil {
-		direction := "ASC"
-		sortField, ok := allowedSorts[pagination.Sort.Field]
-		if ok {
-			if pagination.Sort.Direction == "desc" {
-				direction = "DESC"
-			}
-			sort = fmt.Sprintf("ORDER BY %s %s", sortField, direction)
-		}
+	orderQuery := "ORDER BY created_at DESC"
+	if sort != nil {
+		orderQuery = sort.OrderQuery() + ", id ASC"
 	}
 
-	baseQuery := fmt.Sprintf("SELECT id, number, order_status_code, total, user_full_name, created_at, expired_at, xendit_invoice_url FROM \"order\" WHERE is_deleted = false AND user_id = $1 %s LIMIT $2 OFFSET $3", sort)
+	baseQuery := fmt.Sprintf("SELECT id, number, order_status_code, total, user_full_name, created_at, expired_at, xendit_invoice_url FROM \"order\" %s %s LIMIT %s OFFSET %s", qb.WhereQuery(), orderQuery, qb.Bind(pagination.ItemPerPage), qb.Bind(offset))
 	rows, err := or.db.QueryContext(
 		ctx,
 		baseQuery,
-		userId,
-		pagination.ItemPerPage,
-		offset,
+		qb.Args()...,
 	)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	orders := make([]*entity.Order, 0)
-	ids := make([]string, 0)
-	orderItemsMap := make(map[string][]*entity.OrderItem)
 	for rows.Next() {
 		var orderEntity entity.Order
 		err = rows.Scan(
@@ -397,40 +598,11 @@ func (or *orderRepository) GetListOrderPagination(ctx context.Context, paginatio
 		}
 
 		orders = append(orders, &orderEntity)
-		ids = append(ids, fmt.Sprintf("'%s'", orderEntity.Id))
-		orderItemsMap[orderEntity.Id] = make([]*entity.OrderItem, 0)
 	}
 
-	if len(orders) > 0 {
-		idsJoined := strings.Join(ids, ", ")
-		baseOrderItemQuery := fmt.Sprintf("SELECT product_id, product_name, product_price, quantity, order_id FROM order_item WHERE is_deleted = false AND order_id IN (%s)", idsJoined)
-		rows, err = or.db.QueryContext(
-			ctx,
-			baseOrderItemQuery,
-		)
-		if err != nil {
-			return nil, nil, err
-		}
-
-		for rows.Next() {
-			var item entity.OrderItem
-			err = rows.Scan(
-				&item.ProductId,
-				&item.ProductName,
-				&item.ProductPrice,
-				&item.Quantity,
-				&item.OrderId,
-			)
-			if err != nil {
-				return nil, nil, err
-			}
-
-			orderItemsMap[item.OrderId] = append(orderItemsMap[item.OrderId], &item)
-		}
-
-		for i, o := range orders {
-			orders[i].Items = orderItemsMap[o.Id]
-		}
+	err = or.attachOrderItems(ctx, orders)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	var metadata common.PaginationResponse = common.PaginationResponse{
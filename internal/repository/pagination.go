@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/xryar/golang-grpc-ecommerce/pb/common"
+)
+
+// paginationCursor points at the row a cursor page continues from. Value is
+// the row's sort key as text, Backward marks a previous page cursor.
+type paginationCursor struct {
+	Value    string `json:"v"`
+	Id       string `json:"id"`
+	Backward bool   `json:"b,omitempty"`
+}
+
+// keysetQuery is the part of a listing query that selects a cursor page.
+type keysetQuery struct {
+	// OrderQuery sorts by the sort key and then by id, both flipped when
+	// paging backward.
+	OrderQuery string
+	// Limit asks for one row more than the page so the next page is known.
+	Limit    int32
+	cursor   *paginationCursor
+	pageSize int32
+}
+
+// isCursorPagination reports whether pagination asks for cursor mode.
+func isCursorPagination(pagination *common.PaginationRequest) bool {
+	return pagination.UseCursor || pagination.Cursor != ""
+}
+
+// buildKeysetQuery pages over (sortExpr, idExpr) in the given direction,
+// adding the condition for the rows after the cursor to qb. sortType is the
+// sql type the cursor value is cast back to.
+func buildKeysetQuery(qb *queryBuilder, pagination *common.PaginationRequest, sortExpr string, sortType string, idExpr string, descending bool) *keysetQuery {
+	query := &keysetQuery{
+		pageSize: pagination.ItemPerPage,
+		Limit:    pagination.ItemPerPage + 1,
+	}
+
+	if pagination.Cursor != "" {
+		cursor, ok := decodePaginationCursor(pagination.Cursor)
+		if !ok {
+			qb.invalid("cursor", "value is not a valid cursor")
+		}
+		query.cursor = cursor
+	}
+
+	// going backward the rows before the cursor are read in reverse order
+	if query.cursor != nil && query.cursor.Backward {
+		descending = !descending
+	}
+	direction, comparison := "ASC", ">"
+	if descending {
+		direction, comparison = "DESC", "<"
+	}
+
+	if query.cursor != nil {
+		qb.Where(fmt.Sprintf("(%s, %s) %s (%s::%s, %s)", sortExpr, idExpr, comparison, qb.Bind(query.cursor.Value), sortType, qb.Bind(query.cursor.Id)))
+	}
+	query.OrderQuery = fmt.Sprintf("ORDER BY %s %s, %s %s", sortExpr, direction, idExpr, direction)
+
+	return query
+}
+
+// keysetPage trims the extra row off items, restores their order when paging
+// backward and builds the cursors to the pages around them. key returns an
+// item's sort key as text and its id.
+func keysetPage[T any](query *keysetQuery, items []T, key func(T) (string, string)) ([]T, *common.PaginationResponse) {
+	hasMore := int32(len(items)) > query.pageSize
+	if hasMore {
+		items = items[:query.pageSize]
+	}
+
+	backward := query.cursor != nil && query.cursor.Backward
+	if backward {
+		slices.Reverse(items)
+	}
+
+	paginationResponse := &common.PaginationResponse{
+		ItemPerPage: query.pageSize,
+	}
+	if len(items) == 0 {
+		return items, paginationResponse
+	}
+
+	// the page a cursor came from is always there to go back to
+	if (!backward && hasMore) || (backward && query.cursor != nil) {
+		value, id := key(items[len(items)-1])
+		paginationResponse.NextCursor = encodePaginationCursor(&paginationCursor{Value: value, Id: id})
+	}
+	if (backward && hasMore) || (!backward && query.cursor != nil) {
+		value, id := key(items[0])
+		paginationResponse.PreviousCursor = encodePaginationCursor(&paginationCursor{Value: value, Id: id, Backward: true})
+	}
+
+	return items, paginationResponse
+}
+
+func encodePaginationCursor(cursor *paginationCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodePaginationCursor(s string) (*paginationCursor, bool) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, false
+	}
+
+	var cursor paginationCursor
+	err = json.Unmarshal(data, &cursor)
+	if err != nil || cursor.Id == "" {
+		return nil, false
+	}
+
+	return &cursor, true
+}
@@ -0,0 +1,194 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/xryar/golang-grpc-ecommerce/internal/entity"
+	"github.com/xryar/golang-grpc-ecommerce/pb/common"
+)
+
+// ProductFacets sums up every product a listing matches, not only its
+// current page, for the storefront's filter sidebar.
+type ProductFacets struct {
+	Attributes []*ProductAttributeFacet
+	// nil when no product matches
+	MinPrice *float64
+	MaxPrice *float64
+}
+
+// ProductAttributeFacet sums up the values the matched products have for
+// the attributes named Code. Count is how many of them have a value.
+type ProductAttributeFacet struct {
+	Code  string
+	Name  string
+	Type  string
+	Unit  *string
+	Count int64
+	// the values of text and boolean attributes, the most common first
+	Values []*ProductAttributeFacetValue
+	// the range of number attributes
+	MinNumber *float64
+	MaxNumber *float64
+}
+
+type ProductAttributeFacetValue struct {
+	Value string
+	Count int64
+}
+
+// GetProductAttributeValues returns the values the product has for
+// attributes that are not deleted, ordered by attribute name.
+func (repo *productRepository) GetProductAttributeValues(ctx context.Context, productId string) ([]*entity.ProductAttributeValue, error) {
+	rows, err := repo.db.QueryContext(
+		ctx,
+		"SELECT pav.product_id, pav.attribute_id, pav.value_text, pav.value_number, ca.category_id, ca.code, ca.name, ca.type, ca.unit FROM product_attribute_value pav JOIN category_attribute ca ON ca.id = pav.attribute_id WHERE pav.product_id = $1 AND ca.is_deleted = false ORDER BY ca.name ASC, ca.id ASC",
+		productId,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]*entity.ProductAttributeValue, 0)
+	for rows.Next() {
+		var value entity.ProductAttributeValue
+		var attribute entity.CategoryAttribute
+		err = rows.Scan(
+			&value.ProductId,
+			&value.AttributeId,
+			&value.ValueText,
+			&value.ValueNumber,
+			&attribute.CategoryId,
+			&attribute.Code,
+			&attribute.Name,
+			&attribute.Type,
+			&attribute.Unit,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		attribute.Id = value.AttributeId
+		value.Attribute = &attribute
+		values = append(values, &value)
+	}
+
+	return values, nil
+}
+
+// ReplaceProductAttributeValues makes values the only attribute values of the
+// product.
+func (repo *productRepository) ReplaceProductAttributeValues(ctx context.Context, productId string, values []*entity.ProductAttributeValue) error {
+	_, err := repo.db.ExecContext(
+		ctx,
+		"DELETE FROM product_attribute_value WHERE product_id = $1",
+		productId,
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, value := range values {
+		_, err = repo.db.ExecContext(
+			ctx,
+			"INSERT INTO product_attribute_value (product_id, attribute_id, value_text, value_number) VALUES ($1, $2, $3, $4)",
+			productId,
+			value.AttributeId,
+			value.ValueText,
+			value.ValueNumber,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetProductFacets sums up the products GetProductsPagination matches with
+// the same pagination filters and filter.
+func (repo *productRepository) GetProductFacets(ctx context.Context, pagination *common.PaginationRequest, filter *ProductFilter) (*ProductFacets, error) {
+	qb, _ := buildProductFilterQuery(filter)
+	qb.Filter(productFilterFields, pagination.Filters)
+	if err := qb.Err(); err != nil {
+		return nil, err
+	}
+
+	var facets ProductFacets
+	row := repo.db.QueryRowContext(
+		ctx,
+		fmt.Sprintf("SELECT MIN(%[1]s), MAX(%[1]s) FROM product %[2]s", productCurrentPriceColumn, qb.WhereQuery()),
+		qb.Args()...,
+	)
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+
+	err := row.Scan(&facets.MinPrice, &facets.MaxPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	// number attributes are summed up by range, so their values all fall in
+	// one group
+	rows, err := repo.db.QueryContext(
+		ctx,
+		fmt.Sprintf(`
+		SELECT ca.code, MIN(ca.name), MIN(ca.type), MIN(ca.unit), CASE WHEN ca.type = '%[1]s' THEN NULL ELSE pav.value_text END AS value, COUNT(DISTINCT pav.product_id) AS product_count, MIN(pav.value_number), MAX(pav.value_number)
+		FROM product_attribute_value pav JOIN category_attribute ca ON ca.id = pav.attribute_id AND ca.is_deleted = false
+		WHERE pav.product_id IN (SELECT id FROM product %[2]s)
+		GROUP BY ca.code, value
+		ORDER BY ca.code ASC, product_count DESC, value ASC
+		`, entity.CategoryAttributeTypeNumber, qb.WhereQuery()),
+		qb.Args()...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	facets.Attributes = make([]*ProductAttributeFacet, 0)
+	var facet *ProductAttributeFacet
+	for rows.Next() {
+		var code, name, attributeType string
+		var unit, value *string
+		var count int64
+		var minNumber, maxNumber *float64
+		err = rows.Scan(
+			&code,
+			&name,
+			&attributeType,
+			&unit,
+			&value,
+			&count,
+			&minNumber,
+			&maxNumber,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if facet == nil || facet.Code != code {
+			facet = &ProductAttributeFacet{
+				Code:   code,
+				Name:   name,
+				Type:   attributeType,
+				Unit:   unit,
+				Values: make([]*ProductAttributeFacetValue, 0),
+			}
+			facets.Attributes = append(facets.Attributes, facet)
+		}
+
+		facet.Count += count
+		if value != nil {
+			facet.Values = append(facet.Values, &ProductAttributeFacetValue{
+				Value: *value,
+				Count: count,
+			})
+		} else {
+			facet.MinNumber = minNumber
+			facet.MaxNumber = maxNumber
+		}
+	}
+
+	return &facets, nil
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/xryar/golang-grpc-ecommerce/internal/entity"
+)
+
+// GetProductBundleItems returns the components of the given bundles with the
+// component products, deleted ones included so callers can tell a bundle is
+// no longer complete.
+func (repo *productRepository) GetProductBundleItems(ctx context.Context, bundleProductIds []string) ([]*entity.ProductBundleItem, error) {
+	qb := newQueryBuilder()
+	qb.WhereIn("pbi.bundle_product_id", bundleProductIds)
+	rows, err := repo.db.QueryContext(
+		ctx,
+		"SELECT pbi.id, pbi.bundle_product_id, pbi.product_id, pbi.quantity, p.slug, p.name, p.price, p.sale_price, p.sale_starts_at, p.sale_ends_at, p.image_file_name, p.stock, p.is_deleted, p.type, p.digital_file_name FROM product_bundle_item pbi JOIN product p ON p.id = pbi.product_id "+qb.WhereQuery()+" ORDER BY p.name ASC, pbi.id ASC",
+		qb.Args()...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*entity.ProductBundleItem = make([]*entity.ProductBundleItem, 0)
+	for rows.Next() {
+		var item entity.ProductBundleItem
+		var product entity.Product
+		err = rows.Scan(
+			&item.Id,
+			&item.BundleProductId,
+			&item.ProductId,
+			&item.Quantity,
+			&product.Slug,
+			&product.Name,
+			&product.Price,
+			&product.SalePrice,
+			&product.SaleStartsAt,
+			&product.SaleEndsAt,
+			&product.ImageFileName,
+			&product.Stock,
+			&product.IsDeleted,
+			&product.Type,
+			&product.DigitalFileName,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		product.Id = item.ProductId
+		item.Product = &product
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+// ReplaceProductBundleItems makes items the only components of the bundle.
+func (repo *productRepository) ReplaceProductBundleItems(ctx context.Context, bundleProductId string, items []*entity.ProductBundleItem) error {
+	_, err := repo.db.ExecContext(
+		ctx,
+		"DELETE FROM product_bundle_item WHERE bundle_product_id = $1",
+		bundleProductId,
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		_, err = repo.db.ExecContext(
+			ctx,
+			"INSERT INTO product_bundle_item (id, bundle_product_id, product_id, quantity, created_at, created_by) VALUES ($1, $2, $3, $4, $5, $6)",
+			item.Id,
+			item.BundleProductId,
+			item.ProductId,
+			item.Quantity,
+			item.CreatedAt,
+			item.CreatedBy,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CountProductBundles returns how many bundles, deleted ones included, have
+// the product as a component.
+func (repo *productRepository) CountProductBundles(ctx context.Context, productId string) (int, error) {
+	row := repo.db.QueryRowContext(
+		ctx,
+		"SELECT COUNT(*) FROM product_bundle_item WHERE product_id = $1",
+		productId,
+	)
+	if row.Err() != nil {
+		return 0, row.Err()
+	}
+
+	var count int
+	err := row.Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
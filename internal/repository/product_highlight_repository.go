@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/xryar/golang-grpc-ecommerce/internal/entity"
+)
+
+// GetBestSellerProducts returns the most ordered published products, counting
+// only orders made after since when it is set. Like the other highlight
+// queries it leaves out products customers can not see.
+func (repo *productRepository) GetBestSellerProducts(ctx context.Context, since *time.Time, limit int32) ([]*entity.Product, error) {
+	rows, err := repo.db.QueryContext(
+		ctx,
+		fmt.Sprintf(`
+		SELECT
+			p.id,
+			p.slug,
+			p.name,
+			p.description,
+			p.price,
+			p.sale_price,
+			p.sale_starts_at,
+			p.sale_ends_at,
+			p.image_file_name
+		FROM
+			product p
+			JOIN order_item oi ON oi.product_id = p.id
+		WHERE
+			p.is_deleted = false AND %s AND oi.is_deleted = false AND ($1::timestamptz IS NULL OR oi.created_at >= $1)
+		GROUP BY p.id
+		ORDER BY COUNT(*) DESC
+		LIMIT $2
+		`, publishedProductCondition("p")),
+		since,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanHighlightProducts(rows)
+}
+
+func (repo *productRepository) GetNewestProducts(ctx context.Context, limit int32) ([]*entity.Product, error) {
+	rows, err := repo.db.QueryContext(
+		ctx,
+		fmt.Sprintf("SELECT id, slug, name, description, price, sale_price, sale_starts_at, sale_ends_at, image_file_name FROM product WHERE is_deleted = false AND %s ORDER BY created_at DESC LIMIT $1", publishedProductCondition("product")),
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanHighlightProducts(rows)
+}
+
+// GetCollectionProducts returns the hand-picked products of a collection in
+// their curated order, skipping deleted and unpublished ones.
+func (repo *productRepository) GetCollectionProducts(ctx context.Context, collectionId string, limit int32) ([]*entity.Product, error) {
+	rows, err := repo.db.QueryContext(
+		ctx,
+		fmt.Sprintf(`
+		SELECT p.id, p.slug, p.name, p.description, p.price, p.sale_price, p.sale_starts_at, p.sale_ends_at, p.image_file_name
+		FROM product_collection_item pci
+		JOIN product p ON p.id = pci.product_id
+		WHERE pci.collection_id = $1 AND p.is_deleted = false AND %s
+		ORDER BY pci.position ASC
+		LIMIT $2
+		`, publishedProductCondition("p")),
+		collectionId,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanHighlightProducts(rows)
+}
+
+func scanHighlightProducts(rows *sql.Rows) ([]*entity.Product, error) {
+	var products []*entity.Product = make([]*entity.Product, 0)
+	for rows.Next() {
+		var productEntity entity.Product
+		err := rows.Scan(
+			&productEntity.Id,
+			&productEntity.Slug,
+			&productEntity.Name,
+			&productEntity.Description,
+			&productEntity.Price,
+			&productEntity.SalePrice,
+			&productEntity.SaleStartsAt,
+			&productEntity.SaleEndsAt,
+			&productEntity.ImageFileName,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		products = append(products, &productEntity)
+	}
+
+	return products, nil
+}
@@ -0,0 +1,215 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/lib/pq"
+	"github.com/xryar/golang-grpc-ecommerce/internal/entity"
+)
+
+func (repo *productRepository) CreateProductImage(ctx context.Context, image *entity.ProductImage) error {
+	_, err := repo.db.ExecContext(
+		ctx,
+		"INSERT INTO product_image (id, product_id, image_file_name, position, is_primary, created_at, created_by, updated_at, updated_by) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)",
+		image.Id,
+		image.ProductId,
+		image.ImageFileName,
+		image.Position,
+		image.IsPrimary,
+		image.CreatedAt,
+		image.CreatedBy,
+		image.UpdatedAt,
+		image.UpdatedBy,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (repo *productRepository) GetProductImageById(ctx context.Context, id string) (*entity.ProductImage, error) {
+	row := repo.db.QueryRowContext(
+		ctx,
+		"SELECT id, product_id, image_file_name, position, is_primary FROM product_image WHERE id = $1",
+		id,
+	)
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+
+	var image entity.ProductImage
+	err := row.Scan(
+		&image.Id,
+		&image.ProductId,
+		&image.ImageFileName,
+		&image.Position,
+		&image.IsPrimary,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &image, nil
+}
+
+func (repo *productRepository) GetProductImagesByProductId(ctx context.Context, productId string) ([]*entity.ProductImage, error) {
+	rows, err := repo.db.QueryContext(
+		ctx,
+		"SELECT id, product_id, image_file_name, position, is_primary FROM product_image WHERE product_id = $1 ORDER BY position ASC",
+		productId,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	images := make([]*entity.ProductImage, 0)
+	for rows.Next() {
+		var image entity.ProductImage
+		err = rows.Scan(
+			&image.Id,
+			&image.ProductId,
+			&image.ImageFileName,
+			&image.Position,
+			&image.IsPrimary,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		images = append(images, &image)
+	}
+
+	return images, nil
+}
+
+func (repo *productRepository) UpdateProductImage(ctx context.Context, image *entity.ProductImage) error {
+	_, err := repo.db.ExecContext(
+		ctx,
+		"UPDATE product_image SET image_file_name = $1, position = $2, updated_at = $3, updated_by = $4 WHERE id = $5",
+		image.ImageFileName,
+		image.Position,
+		image.UpdatedAt,
+		image.UpdatedBy,
+		image.Id,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SetPrimaryProductImage marks the image as the product's primary one and
+// mirrors its file name onto the product row used by the listings.
+func (repo *productRepository) SetPrimaryProductImage(ctx context.Context, productId string, imageId string) error {
+	_, err := repo.db.ExecContext(
+		ctx,
+		"UPDATE product_image SET is_primary = false WHERE product_id = $1 AND is_primary = true",
+		productId,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = repo.db.ExecContext(
+		ctx,
+		"UPDATE product_image SET is_primary = true WHERE id = $1 AND product_id = $2",
+		imageId,
+		productId,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = repo.db.ExecContext(
+		ctx,
+		"UPDATE product SET image_file_name = pi.image_file_name FROM product_image pi WHERE pi.id = $1 AND product.id = pi.product_id",
+		imageId,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (repo *productRepository) DeleteProductImage(ctx context.Context, id string) error {
+	_, err := repo.db.ExecContext(
+		ctx,
+		"DELETE FROM product_image WHERE id = $1",
+		id,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CountImageFileNameReferences counts the products, gallery entries, variants
+// and order items that still point at the given file.
+func (repo *productRepository) CountImageFileNameReferences(ctx context.Context, imageFileName string) (int, error) {
+	row := repo.db.QueryRowContext(
+		ctx,
+		`
+		SELECT
+			(SELECT COUNT(*) FROM product WHERE image_file_name = $1) +
+			(SELECT COUNT(*) FROM product_image WHERE image_file_name = $1) +
+			(SELECT COUNT(*) FROM product_variant WHERE image_file_name = $1 AND is_deleted = false) +
+			(SELECT COUNT(*) FROM order_item WHERE product_image_file_name = $1)
+		`,
+		imageFileName,
+	)
+	if row.Err() != nil {
+		return 0, row.Err()
+	}
+
+	var count int
+	err := row.Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// GetReferencedImageFileNames returns those of imageFileNames that a product,
+// gallery entry, variant or order item still points at.
+func (repo *productRepository) GetReferencedImageFileNames(ctx context.Context, imageFileNames []string) ([]string, error) {
+	rows, err := repo.db.QueryContext(
+		ctx,
+		`
+		SELECT name
+		FROM unnest($1::text[]) AS name
+		WHERE
+			EXISTS (SELECT 1 FROM product WHERE image_file_name = name)
+			OR EXISTS (SELECT 1 FROM product_image WHERE image_file_name = name)
+			OR EXISTS (SELECT 1 FROM product_variant WHERE image_file_name = name AND is_deleted = false)
+			OR EXISTS (SELECT 1 FROM order_item WHERE product_image_file_name = name)
+		`,
+		pq.Array(imageFileNames),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0)
+	for rows.Next() {
+		var name string
+		err = rows.Scan(&name)
+		if err != nil {
+			return nil, err
+		}
+
+		names = append(names, name)
+	}
+
+	return names, nil
+}
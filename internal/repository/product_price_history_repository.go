@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/xryar/golang-grpc-ecommerce/internal/entity"
+	"github.com/xryar/golang-grpc-ecommerce/pb/common"
+)
+
+func (repo *productRepository) CreateProductPriceHistory(ctx context.Context, history *entity.ProductPriceHistory) error {
+	_, err := repo.db.ExecContext(
+		ctx,
+		"INSERT INTO product_price_history (id, product_id, price, sale_price, sale_starts_at, sale_ends_at, created_at, created_by) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
+		history.Id,
+		history.ProductId,
+		history.Price,
+		history.SalePrice,
+		history.SaleStartsAt,
+		history.SaleEndsAt,
+		history.CreatedAt,
+		history.CreatedBy,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (repo *productRepository) GetProductPriceHistoryPagination(ctx context.Context, productId string, pagination *common.PaginationRequest) ([]*entity.ProductPriceHistory, *common.PaginationResponse, error) {
+	row := repo.db.QueryRowContext(
+		ctx,
+		"SELECT COUNT(*) FROM product_price_history WHERE product_id = $1",
+		productId,
+	)
+	if row.Err() != nil {
+		return nil, nil, row.Err()
+	}
+
+	var totalCount int
+	err := row.Scan(&totalCount)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	offset := (pagination.CurrentPage - 1) * pagination.ItemPerPage
+	totalPages := (totalCount + int(pagination.ItemPerPage) - 1) / int(pagination.ItemPerPage)
+
+	rows, err := repo.db.QueryContext(
+		ctx,
+		"SELECT id, product_id, price, sale_price, sale_starts_at, sale_ends_at, created_at, created_by FROM product_price_history WHERE product_id = $1 ORDER BY created_at DESC LIMIT $2 OFFSET $3",
+		productId,
+		pagination.ItemPerPage,
+		offset,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	histories := make([]*entity.ProductPriceHistory, 0)
+	for rows.Next() {
+		var history entity.ProductPriceHistory
+		err = rows.Scan(
+			&history.Id,
+			&history.ProductId,
+			&history.Price,
+			&history.SalePrice,
+			&history.SaleStartsAt,
+			&history.SaleEndsAt,
+			&history.CreatedAt,
+			&history.CreatedBy,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		histories = append(histories, &history)
+	}
+
+	paginationResponse := &common.PaginationResponse{
+		CurrentPage:    pagination.CurrentPage,
+		ItemPerPage:    pagination.ItemPerPage,
+		TotalItemCount: int32(totalCount),
+		TotalPageCount: int32(totalPages),
+	}
+	return histories, paginationResponse, nil
+}
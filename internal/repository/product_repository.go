@@ -7,7 +7,10 @@ import (
 	"fmt"
 	"strings"
 	"time"
+	"unicode"
 
+	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/xryar/golang-grpc-ecommerce/internal/entity"
 	"github.com/xryar/golang-grpc-ecommerce/pb/common"
 	"github.com/xryar/golang-grpc-ecommerce/pkg/database"
@@ -17,14 +20,104 @@ type IProductRepository interface {
 	WithTransaction(tx *sql.Tx) IProductRepository
 	CreateNewProduct(ctx context.Context, product *entity.Product) error
 	GetProductById(ctx context.Context, id string) (*entity.Product, error)
+	GetProductBySlug(ctx context.Context, slug string) (*entity.Product, error)
+	GetSlugProductId(ctx context.Context, slug string) (*string, error)
+	CreateProductSlugHistory(ctx context.Context, history *entity.ProductSlugHistory) error
+	DeleteProductSlugHistory(ctx context.Context, productId string, slug string) error
 	GetProductsByIds(ctx context.Context, ids []string) ([]*entity.Product, error)
-	UpdateProduct(ctx context.Context, product *entity.Product) error
+	GetProductBySku(ctx context.Context, sku string) (*entity.Product, error)
+	GetProductsForExport(ctx context.Context, afterId string, limit int) ([]*entity.Product, error)
+	UpdateProduct(ctx context.Context, product *entity.Product, previousStock int64) (bool, error)
 	DeleteProduct(ctx context.Context, id string, deletedAt time.Time, deleteBy string) error
-	GetProductsPagination(ctx context.Context, pagination *common.PaginationRequest) ([]*entity.Product, *common.PaginationResponse, error)
-	GetProductsPaginationAdmin(ctx context.Context, pagination *common.PaginationRequest) ([]*entity.Product, *common.PaginationResponse, error)
-	GetProductHighlight(ctx context.Context) ([]*entity.Product, error)
+	DecreaseProductStock(ctx context.Context, id string, quantity int64) (bool, error)
+	IncreaseProductStock(ctx context.Context, id string, quantity int64) error
+	CreateProductVariant(ctx context.Context, variant *entity.ProductVariant) error
+	GetProductVariantById(ctx context.Context, id string) (*entity.ProductVariant, error)
+	GetProductVariantBySku(ctx context.Context, sku string) (*entity.ProductVariant, error)
+	GetProductVariantsByProductId(ctx context.Context, productId string) ([]*entity.ProductVariant, error)
+	GetProductVariantsByIds(ctx context.Context, ids []string) ([]*entity.ProductVariant, error)
+	UpdateProductVariant(ctx context.Context, variant *entity.ProductVariant) error
+	DeleteProductVariant(ctx context.Context, id string, deletedAt time.Time, deletedBy string) error
+	DecreaseProductVariantStock(ctx context.Context, id string, quantity int64) (bool, error)
+	IncreaseProductVariantStock(ctx context.Context, id string, quantity int64) error
+	GetProductBundleItems(ctx context.Context, bundleProductIds []string) ([]*entity.ProductBundleItem, error)
+	GetProductAttributeValues(ctx context.Context, productId string) ([]*entity.ProductAttributeValue, error)
+	ReplaceProductAttributeValues(ctx context.Context, productId string, values []*entity.ProductAttributeValue) error
+	GetProductFacets(ctx context.Context, pagination *common.PaginationRequest, filter *ProductFilter) (*ProductFacets, error)
+	ReplaceProductBundleItems(ctx context.Context, bundleProductId string, items []*entity.ProductBundleItem) error
+	CountProductBundles(ctx context.Context, productId string) (int, error)
+	CreateProductImage(ctx context.Context, image *entity.ProductImage) error
+	GetProductImageById(ctx context.Context, id string) (*entity.ProductImage, error)
+	GetProductImagesByProductId(ctx context.Context, productId string) ([]*entity.ProductImage, error)
+	UpdateProductImage(ctx context.Context, image *entity.ProductImage) error
+	SetPrimaryProductImage(ctx context.Context, productId string, imageId string) error
+	DeleteProductImage(ctx context.Context, id string) error
+	CountImageFileNameReferences(ctx context.Context, imageFileName string) (int, error)
+	GetReferencedImageFileNames(ctx context.Context, imageFileNames []string) ([]string, error)
+	GetProductsPagination(ctx context.Context, pagination *common.PaginationRequest, filter *ProductFilter) ([]*entity.Product, *common.PaginationResponse, error)
+	GetProductsPaginationAdmin(ctx context.Context, pagination *common.PaginationRequest, filter *ProductFilter) ([]*entity.Product, *common.PaginationResponse, error)
+	GetBestSellerProducts(ctx context.Context, since *time.Time, limit int32) ([]*entity.Product, error)
+	GetNewestProducts(ctx context.Context, limit int32) ([]*entity.Product, error)
+	GetCollectionProducts(ctx context.Context, collectionId string, limit int32) ([]*entity.Product, error)
+	GetDeletedProductById(ctx context.Context, id string) (*entity.Product, error)
+	GetDeletedProductsPagination(ctx context.Context, pagination *common.PaginationRequest) ([]*entity.Product, *common.PaginationResponse, error)
+	RestoreProduct(ctx context.Context, id string, updatedAt time.Time, updatedBy string) error
+	PurgeProduct(ctx context.Context, id string) error
+	CreateProductPriceHistory(ctx context.Context, history *entity.ProductPriceHistory) error
+	GetProductPriceHistoryPagination(ctx context.Context, productId string, pagination *common.PaginationRequest) ([]*entity.ProductPriceHistory, *common.PaginationResponse, error)
 }
 
+// ProductFilter narrows down product listings. A nil CategoryIds means no
+// category filter, while an empty one matches no product. Search is matched
+// against the full-text index on name and description. PublishedOnly leaves
+// out products customers can not see yet, Status matches the stored status.
+// Every attribute filter must match, MinPrice and MaxPrice bound the price
+// customers pay right now, sales included.
+type ProductFilter struct {
+	CategoryIds      []string
+	Search           string
+	PublishedOnly    bool
+	Status           string
+	AttributeFilters []*ProductAttributeFilter
+	MinPrice         *float64
+	MaxPrice         *float64
+}
+
+// ProductAttributeFilter matches the products with a value for an attribute
+// named Code that is one of Values, when given, and lies between Min and
+// Max, when given, which only number attributes can.
+type ProductAttributeFilter struct {
+	Code   string
+	Values []string
+	Min    *float64
+	Max    *float64
+}
+
+// productRatingColumns selects the average rating and the number of approved
+// reviews of the product in the outer query.
+const productRatingColumns = `
+	COALESCE((SELECT AVG(pr.rating) FROM product_review pr WHERE pr.product_id = product.id AND pr.status_code = 'approved' AND pr.is_deleted = false), 0),
+	(SELECT COUNT(*) FROM product_review pr WHERE pr.product_id = product.id AND pr.status_code = 'approved' AND pr.is_deleted = false)
+`
+
+// productStockColumn selects the stock of the product in the outer query. A
+// bundle has as much stock as its components allow, none when one of them is
+// deleted.
+const productStockColumn = `
+	CASE WHEN product.type = 'bundle' THEN (
+		SELECT CASE WHEN bool_and(c.is_deleted = false) THEN MIN(c.stock / pbi.quantity) ELSE 0 END
+		FROM product_bundle_item pbi JOIN product c ON c.id = pbi.product_id
+		WHERE pbi.bundle_product_id = product.id
+	) ELSE product.stock END
+`
+
+// productCurrentPriceColumn selects the price customers pay for the product in
+// the outer query, the sale price while its sale runs.
+const productCurrentPriceColumn = `
+	CASE WHEN product.sale_price IS NOT NULL AND (product.sale_starts_at IS NULL OR product.sale_starts_at <= NOW()) AND (product.sale_ends_at IS NULL OR product.sale_ends_at > NOW())
+	THEN product.sale_price ELSE product.price END
+`
+
 type productRepository struct {
 	db database.DatabaseQuery
 }
@@ -38,12 +131,18 @@ func (repo *productRepository) WithTransaction(tx *sql.Tx) IProductRepository {
 func (repo *productRepository) CreateNewProduct(ctx context.Context, product *entity.Product) error {
 	_, err := repo.db.ExecContext(
 		ctx,
-		"INSERT INTO product (id, name, description, price, image_file_name, created_at, created_by, updated_at, updated_by, deleted_at, deleted_by, is_deleted) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)",
+		"INSERT INTO product (id, sku, name, description, price, sale_price, sale_starts_at, sale_ends_at, image_file_name, stock, category_id, created_at, created_by, updated_at, updated_by, deleted_at, deleted_by, is_deleted, slug, seo_title, seo_description, status, publish_at, type, digital_file_name, min_order_quantity, max_order_quantity) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27)",
 		product.Id,
+		product.Sku,
 		product.Name,
 		product.Description,
 		product.Price,
+		product.SalePrice,
+		product.SaleStartsAt,
+		product.SaleEndsAt,
 		product.ImageFileName,
+		product.Stock,
+		product.CategoryId,
 		product.CreatedAt,
 		product.CreatedBy,
 		product.UpdatedAt,
@@ -51,6 +150,15 @@ func (repo *productRepository) CreateNewProduct(ctx context.Context, product *en
 		product.DeletedAt,
 		product.DeletedBy,
 		product.IsDeleted,
+		product.Slug,
+		product.SeoTitle,
+		product.SeoDescription,
+		product.Status,
+		product.PublishAt,
+		product.Type,
+		product.DigitalFileName,
+		product.MinOrderQuantity,
+		product.MaxOrderQuantity,
 	)
 	if err != nil {
 		return err
@@ -60,22 +168,49 @@ func (repo *productRepository) CreateNewProduct(ctx context.Context, product *en
 }
 
 func (repo *productRepository) GetProductById(ctx context.Context, id string) (*entity.Product, error) {
-	var productEntity entity.Product
 	row := repo.db.QueryRowContext(
 		ctx,
-		"SELECT id, name, description, price, image_file_name FROM product WHERE id = $1 AND is_deleted = false",
+		fmt.Sprintf("SELECT %s FROM product WHERE id = $1 AND is_deleted = false", productDetailColumns),
 		id,
 	)
+
+	return scanProductDetail(row)
+}
+
+// productDetailColumns selects everything scanProductDetail reads from the
+// product table.
+var productDetailColumns = fmt.Sprintf("id, sku, slug, name, description, seo_title, seo_description, status, publish_at, price, sale_price, sale_starts_at, sale_ends_at, image_file_name, %s, category_id, EXISTS (SELECT 1 FROM product_variant pv WHERE pv.product_id = product.id AND pv.is_deleted = false), %s, type, digital_file_name, min_order_quantity, max_order_quantity", productStockColumn, productRatingColumns)
+
+func scanProductDetail(row *sql.Row) (*entity.Product, error) {
 	if row.Err() != nil {
 		return nil, row.Err()
 	}
 
+	var productEntity entity.Product
 	err := row.Scan(
 		&productEntity.Id,
+		&productEntity.Sku,
+		&productEntity.Slug,
 		&productEntity.Name,
 		&productEntity.Description,
+		&productEntity.SeoTitle,
+		&productEntity.SeoDescription,
+		&productEntity.Status,
+		&productEntity.PublishAt,
 		&productEntity.Price,
+		&productEntity.SalePrice,
+		&productEntity.SaleStartsAt,
+		&productEntity.SaleEndsAt,
 		&productEntity.ImageFileName,
+		&productEntity.Stock,
+		&productEntity.CategoryId,
+		&productEntity.HasVariants,
+		&productEntity.RatingAverage,
+		&productEntity.ReviewCount,
+		&productEntity.Type,
+		&productEntity.DigitalFileName,
+		&productEntity.MinOrderQuantity,
+		&productEntity.MaxOrderQuantity,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -89,13 +224,12 @@ func (repo *productRepository) GetProductById(ctx context.Context, id string) (*
 }
 
 func (repo *productRepository) GetProductsByIds(ctx context.Context, ids []string) ([]*entity.Product, error) {
-	queryIds := make([]string, len(ids))
-	for i, id := range ids {
-		queryIds[i] = fmt.Sprintf("'%s'", id)
-	}
+	qb := newQueryBuilder("is_deleted = false")
+	qb.WhereIn("id", ids)
 	rows, err := repo.db.QueryContext(
 		ctx,
-		fmt.Sprintf("SELECT id, name, price, image_file_name FROM product WHERE id IN (%s) AND is_deleted = false", strings.Join(queryIds, ", ")),
+		fmt.Sprintf("SELECT id, name, status, publish_at, price, sale_price, sale_starts_at, sale_ends_at, image_file_name, stock, EXISTS (SELECT 1 FROM product_variant pv WHERE pv.product_id = product.id AND pv.is_deleted = false), type, digital_file_name, min_order_quantity, max_order_quantity FROM product %s", qb.WhereQuery()),
+		qb.Args()...,
 	)
 	if err != nil {
 		return nil, err
@@ -107,8 +241,19 @@ func (repo *productRepository) GetProductsByIds(ctx context.Context, ids []strin
 		err = rows.Scan(
 			&productEntity.Id,
 			&productEntity.Name,
+			&productEntity.Status,
+			&productEntity.PublishAt,
 			&productEntity.Price,
+			&productEntity.SalePrice,
+			&productEntity.SaleStartsAt,
+			&productEntity.SaleEndsAt,
 			&productEntity.ImageFileName,
+			&productEntity.Stock,
+			&productEntity.HasVariants,
+			&productEntity.Type,
+			&productEntity.DigitalFileName,
+			&productEntity.MinOrderQuantity,
+			&productEntity.MaxOrderQuantity,
 		)
 		if err != nil {
 			return nil, err
@@ -120,23 +265,115 @@ func (repo *productRepository) GetProductsByIds(ctx context.Context, ids []strin
 	return products, nil
 }
 
-func (repo *productRepository) UpdateProduct(ctx context.Context, product *entity.Product) error {
-	_, err := repo.db.ExecContext(
+func (repo *productRepository) GetProductBySku(ctx context.Context, sku string) (*entity.Product, error) {
+	row := repo.db.QueryRowContext(
+		ctx,
+		"SELECT id, sku, name FROM product WHERE sku = $1 AND is_deleted = false",
+		sku,
+	)
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+
+	var productEntity entity.Product
+	err := row.Scan(
+		&productEntity.Id,
+		&productEntity.Sku,
+		&productEntity.Name,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &productEntity, nil
+}
+
+// GetProductsForExport returns up to limit products ordered by id, starting
+// after afterId, so the whole catalogue can be walked in batches. An empty
+// afterId starts from the first product.
+func (repo *productRepository) GetProductsForExport(ctx context.Context, afterId string, limit int) ([]*entity.Product, error) {
+	if afterId == "" {
+		afterId = uuid.Nil.String()
+	}
+
+	rows, err := repo.db.QueryContext(
+		ctx,
+		"SELECT id, sku, name, description, price, stock, image_file_name, category_id, status FROM product WHERE is_deleted = false AND id > $1 ORDER BY id ASC LIMIT $2",
+		afterId,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var products []*entity.Product = make([]*entity.Product, 0)
+	for rows.Next() {
+		var product entity.Product
+		err = rows.Scan(
+			&product.Id,
+			&product.Sku,
+			&product.Name,
+			&product.Description,
+			&product.Price,
+			&product.Stock,
+			&product.ImageFileName,
+			&product.CategoryId,
+			&product.Status,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		products = append(products, &product)
+	}
+
+	return products, nil
+}
+
+// UpdateProduct only updates the product while its stock is still
+// previousStock, so an edit can not undo stock reserved or released since it
+// was read. It reports false when the stock changed.
+func (repo *productRepository) UpdateProduct(ctx context.Context, product *entity.Product, previousStock int64) (bool, error) {
+	result, err := repo.db.ExecContext(
 		ctx,
-		"UPDATE product SET name = $1, description= $2, price= $3, image_file_name= $4, updated_at= $5, updated_by= $6 WHERE id= $7",
+		"UPDATE product SET name = $1, description= $2, price= $3, image_file_name= $4, stock = $5, category_id = $6, updated_at= $7, updated_by= $8, sku = $9, sale_price = $10, sale_starts_at = $11, sale_ends_at = $12, slug = $13, seo_title = $14, seo_description = $15, status = $16, publish_at = $17, digital_file_name = $18, min_order_quantity = $19, max_order_quantity = $20 WHERE id= $21 AND stock = $22",
 		product.Name,
 		product.Description,
 		product.Price,
 		product.ImageFileName,
+		product.Stock,
+		product.CategoryId,
 		product.UpdatedAt,
 		product.UpdatedBy,
+		product.Sku,
+		product.SalePrice,
+		product.SaleStartsAt,
+		product.SaleEndsAt,
+		product.Slug,
+		product.SeoTitle,
+		product.SeoDescription,
+		product.Status,
+		product.PublishAt,
+		product.DigitalFileName,
+		product.MinOrderQuantity,
+		product.MaxOrderQuantity,
 		product.Id,
+		previousStock,
 	)
 	if err != nil {
-		return err
+		return false, err
 	}
 
-	return nil
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
 }
 
 func (repo *productRepository) DeleteProduct(ctx context.Context, id string, deletedAt time.Time, deleteBy string) error {
@@ -154,8 +391,66 @@ func (repo *productRepository) DeleteProduct(ctx context.Context, id string, del
 	return nil
 }
 
-func (repo *productRepository) GetProductsPagination(ctx context.Context, pagination *common.PaginationRequest) ([]*entity.Product, *common.PaginationResponse, error) {
-	row := repo.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM product WHERE is_deleted = false")
+func (repo *productRepository) DecreaseProductStock(ctx context.Context, id string, quantity int64) (bool, error) {
+	result, err := repo.db.ExecContext(
+		ctx,
+		"UPDATE product SET stock = stock - $1 WHERE id = $2 AND stock >= $1 AND is_deleted = false",
+		quantity,
+		id,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func (repo *productRepository) IncreaseProductStock(ctx context.Context, id string, quantity int64) error {
+	_, err := repo.db.ExecContext(
+		ctx,
+		"UPDATE product SET stock = stock + $1 WHERE id = $2",
+		quantity,
+		id,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// productSortFields and productFilterFields are what customers may sort and
+// filter the catalogue by. The price is the one they pay, the sale price
+// while a sale runs.
+var (
+	productSortFields = queryFields{
+		"name":       {Column: "name", Type: queryValueText},
+		"price":      {Column: productCurrentPriceColumn, Type: queryValueNumber},
+		"created_at": {Column: "created_at", Type: queryValueTime},
+	}
+	productFilterFields = queryFields{
+		"price":      {Column: productCurrentPriceColumn, Type: queryValueNumber},
+		"created_at": {Column: "created_at", Type: queryValueTime},
+	}
+)
+
+func (repo *productRepository) GetProductsPagination(ctx context.Context, pagination *common.PaginationRequest, filter *ProductFilter) ([]*entity.Product, *common.PaginationResponse, error) {
+	qb, rankQuery := buildProductFilterQuery(filter)
+	qb.Filter(productFilterFields, pagination.Filters)
+	sort := qb.Sort(productSortFields, pagination.Sort)
+	if isCursorPagination(pagination) {
+		return repo.getProductsKeyset(ctx, pagination, qb, sort, rankQuery)
+	}
+	if err := qb.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	row := repo.db.QueryRowContext(ctx, fmt.Sprintf("SELECT COUNT(*) FROM product %s", qb.WhereQuery()), qb.Args()...)
 	if row.Err() != nil {
 		return nil, nil, row.Err()
 	}
@@ -169,11 +464,18 @@ func (repo *productRepository) GetProductsPagination(ctx context.Context, pagina
 	offset := (pagination.CurrentPage - 1) * pagination.ItemPerPage
 	totalPages := (totalCount + int(pagination.ItemPerPage) - 1) / int(pagination.ItemPerPage)
 
+	orderQuery := "ORDER BY created_at DESC"
+	if sort != nil {
+		orderQuery = sort.OrderQuery() + ", id ASC"
+	} else if rankQuery != "" {
+		orderQuery = fmt.Sprintf("ORDER BY %s DESC, created_at DESC", rankQuery)
+	}
+
+	baseQuery := fmt.Sprintf("SELECT %s FROM product %s %s LIMIT %s OFFSET %s", productListColumns, qb.WhereQuery(), orderQuery, qb.Bind(pagination.ItemPerPage), qb.Bind(offset))
 	rows, err := repo.db.QueryContext(
 		ctx,
-		"SELECT id, name, description, price, image_file_name FROM product WHERE is_deleted = false ORDER BY created_at DESC LIMIT $1 OFFSET $2",
-		pagination.ItemPerPage,
-		offset,
+		baseQuery,
+		qb.Args()...,
 	)
 	if err != nil {
 		return nil, nil, err
@@ -181,20 +483,12 @@ func (repo *productRepository) GetProductsPagination(ctx context.Context, pagina
 
 	var products []*entity.Product = make([]*entity.Product, 0)
 	for rows.Next() {
-		var product entity.Product
-
-		err = rows.Scan(
-			&product.Id,
-			&product.Name,
-			&product.Description,
-			&product.Price,
-			&product.ImageFileName,
-		)
+		product, err := scanProductListRow(rows)
 		if err != nil {
 			return nil, nil, err
 		}
 
-		products = append(products, &product)
+		products = append(products, product)
 	}
 
 	paginationResponse := &common.PaginationResponse{
@@ -206,8 +500,107 @@ func (repo *productRepository) GetProductsPagination(ctx context.Context, pagina
 	return products, paginationResponse, nil
 }
 
-func (repo *productRepository) GetProductsPaginationAdmin(ctx context.Context, pagination *common.PaginationRequest) ([]*entity.Product, *common.PaginationResponse, error) {
-	row := repo.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM product WHERE is_deleted = false")
+// getProductsKeyset pages by the requested sort, or else the newest products
+// first or the most relevant ones when searching, without counting them.
+func (repo *productRepository) getProductsKeyset(ctx context.Context, pagination *common.PaginationRequest, qb *queryBuilder, sort *querySort, rankQuery string) ([]*entity.Product, *common.PaginationResponse, error) {
+	sortExpr, sortType, descending := "created_at", "timestamptz", true
+	if sort != nil {
+		sortExpr, sortType, descending = sort.Field.Column, sort.Field.sqlType(), sort.Descending
+	} else if rankQuery != "" {
+		sortExpr, sortType = rankQuery, "real"
+	}
+
+	keyset := buildKeysetQuery(qb, pagination, sortExpr, sortType, "id", descending)
+	if err := qb.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	baseQuery := fmt.Sprintf("SELECT %s, (%s)::text FROM product %s %s LIMIT %s", productListColumns, sortExpr, qb.WhereQuery(), keyset.OrderQuery, qb.Bind(keyset.Limit))
+	rows, err := repo.db.QueryContext(
+		ctx,
+		baseQuery,
+		qb.Args()...,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var products []*entity.Product = make([]*entity.Product, 0)
+	cursorValues := make(map[string]string)
+	for rows.Next() {
+		var cursorValue string
+		product, err := scanProductListRow(rows, &cursorValue)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		products = append(products, product)
+		cursorValues[product.Id] = cursorValue
+	}
+
+	products, paginationResponse := keysetPage(keyset, products, func(product *entity.Product) (string, string) {
+		return cursorValues[product.Id], product.Id
+	})
+	return products, paginationResponse, nil
+}
+
+// productListColumns selects everything scanProductListRow reads.
+var productListColumns = fmt.Sprintf("id, slug, name, description, price, sale_price, sale_starts_at, sale_ends_at, image_file_name, %s, %s, type", productStockColumn, productRatingColumns)
+
+// scanProductListRow reads a row of productListColumns followed by extra.
+func scanProductListRow(rows *sql.Rows, extra ...any) (*entity.Product, error) {
+	var product entity.Product
+	err := rows.Scan(append([]any{
+		&product.Id,
+		&product.Slug,
+		&product.Name,
+		&product.Description,
+		&product.Price,
+		&product.SalePrice,
+		&product.SaleStartsAt,
+		&product.SaleEndsAt,
+		&product.ImageFileName,
+		&product.Stock,
+		&product.RatingAverage,
+		&product.ReviewCount,
+		&product.Type,
+	}, extra...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product, nil
+}
+
+// productAdminSortFields and productAdminFilterFields are what admins may
+// sort and filter the product table by.
+var (
+	productAdminSortFields = queryFields{
+		"name":        {Column: "name", Type: queryValueText},
+		"description": {Column: "description", Type: queryValueText},
+		"price":       {Column: "price", Type: queryValueNumber},
+		"stock":       {Column: "stock", Type: queryValueNumber},
+		"sku":         {Column: "sku", Type: queryValueText},
+		"created_at":  {Column: "created_at", Type: queryValueTime},
+	}
+	productAdminFilterFields = queryFields{
+		"name":       {Column: "name", Type: queryValueText},
+		"sku":        {Column: "sku", Type: queryValueText},
+		"price":      {Column: "price", Type: queryValueNumber},
+		"stock":      {Column: "stock", Type: queryValueNumber},
+		"created_at": {Column: "created_at", Type: queryValueTime},
+	}
+)
+
+func (repo *productRepository) GetProductsPaginationAdmin(ctx context.Context, pagination *common.PaginationRequest, filter *ProductFilter) ([]*entity.Product, *common.PaginationResponse, error) {
+	qb, _ := buildProductFilterQuery(filter)
+	qb.Filter(productAdminFilterFields, pagination.Filters)
+	sort := qb.Sort(productAdminSortFields, pagination.Sort)
+	if err := qb.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	row := repo.db.QueryRowContext(ctx, fmt.Sprintf("SELECT COUNT(*) FROM product %s", qb.WhereQuery()), qb.Args()...)
 	if row.Err() != nil {
 		return nil, nil, row.Err()
 	}
@@ -221,28 +614,16 @@ func (repo *productRepository) GetProductsPaginationAdmin(ctx context.Context, p
 	offset := (pagination.CurrentPage - 1) * pagination.ItemPerPage
 	totalPages := (totalCount + int(pagination.ItemPerPage) - 1) / int(pagination.ItemPerPage)
 
-	allowedSorts := map[string]bool{
-		"name":        true,
-		"description": true,
-		"price":       true,
-	}
-
 	orderQuery := "ORDER BY created_at DESC"
-	if pagination.Sort != nil && allowedSorts[pagination.Sort.Field] {
-		direction := "asc"
-		if pagination.Sort.Direction == "desc" {
-			direction = "desc"
-		}
-
-		orderQuery = fmt.Sprintf("ORDER BY %s %s", pagination.Sort.Field, direction)
+	if sort != nil {
+		orderQuery = sort.OrderQuery() + ", id ASC"
 	}
 
-	baseQuery := fmt.Sprintf("SELECT id, name, description, price, image_file_name FROM product WHERE is_deleted = false %s LIMIT $1 OFFSET $2", orderQuery)
+	baseQuery := fmt.Sprintf("SELECT id, slug, name, description, price, sale_price, sale_starts_at, sale_ends_at, image_file_name, %s, sku, status, publish_at, type FROM product %s %s LIMIT %s OFFSET %s", productStockColumn, qb.WhereQuery(), orderQuery, qb.Bind(pagination.ItemPerPage), qb.Bind(offset))
 	rows, err := repo.db.QueryContext(
 		ctx,
 		baseQuery,
-		pagination.ItemPerPage,
-		offset,
+		qb.Args()...,
 	)
 	if err != nil {
 		return nil, nil, err
@@ -254,10 +635,19 @@ func (repo *productRepository) GetProductsPaginationAdmin(ctx context.Context, p
 
 		err = rows.Scan(
 			&product.Id,
+			&product.Slug,
 			&product.Name,
 			&product.Description,
 			&product.Price,
+			&product.SalePrice,
+			&product.SaleStartsAt,
+			&product.SaleEndsAt,
 			&product.ImageFileName,
+			&product.Stock,
+			&product.Sku,
+			&product.Status,
+			&product.PublishAt,
+			&product.Type,
 		)
 		if err != nil {
 			return nil, nil, err
@@ -275,55 +665,84 @@ func (repo *productRepository) GetProductsPaginationAdmin(ctx context.Context, p
 	return products, paginationResponse, nil
 }
 
-func (repo *productRepository) GetProductHighlight(ctx context.Context) ([]*entity.Product, error) {
-	rows, err := repo.db.QueryContext(
-		ctx,
-		`
-		SELECT 
-			id,
-			name, 
-			description, 
-			price, 
-			image_file_name
-		FROM 
-			product
-		WHERE 
-			id IN (
-				SELECT p.id
-				FROM product p
-				JOIN order_item oi ON oi.product_id = p.id
-				WHERE
-					p.is_deleted = false AND oi.is_deleted = false
-				GROUP BY p.id 
-				ORDER BY COUNT(*) DESC
-				LIMIT 3
-			);
-		`,
-	)
-	if err != nil {
-		return nil, err
+// buildProductFilterQuery starts a query builder with the conditions of
+// filter and returns it with the relevance expression, which is empty when
+// there is no search.
+func buildProductFilterQuery(filter *ProductFilter) (*queryBuilder, string) {
+	qb := newQueryBuilder("is_deleted = false")
+	rankQuery := ""
+	if filter == nil {
+		return qb, rankQuery
 	}
 
-	var products []*entity.Product = make([]*entity.Product, 0)
-	for rows.Next() {
-		var productEntity entity.Product
+	if filter.PublishedOnly {
+		qb.Where(publishedProductCondition("product"))
+	}
 
-		err = rows.Scan(
-			&productEntity.Id,
-			&productEntity.Name,
-			&productEntity.Description,
-			&productEntity.Price,
-			&productEntity.ImageFileName,
-		)
+	if filter.Status != "" {
+		qb.Where("status = " + qb.Bind(filter.Status))
+	}
 
-		if err != nil {
-			return nil, err
+	if filter.CategoryIds != nil {
+		qb.WhereIn("category_id", filter.CategoryIds)
+	}
+
+	if tsQuery := buildSearchTsQuery(filter.Search); tsQuery != "" {
+		placeholder := qb.Bind(tsQuery)
+		searchQuery := fmt.Sprintf("(to_tsquery('indonesian', %[1]s) || to_tsquery('english', %[1]s))", placeholder)
+		qb.Where("search_vector @@ " + searchQuery)
+		rankQuery = fmt.Sprintf("ts_rank(search_vector, %s)", searchQuery)
+	}
+
+	for _, attributeFilter := range filter.AttributeFilters {
+		conditions := []string{
+			"pav.product_id = product.id",
+			"ca.is_deleted = false",
+			"ca.code = " + qb.Bind(attributeFilter.Code),
+		}
+		if len(attributeFilter.Values) > 0 {
+			conditions = append(conditions, fmt.Sprintf("pav.value_text = ANY(%s)", qb.Bind(pq.Array(attributeFilter.Values))))
+		}
+		if attributeFilter.Min != nil {
+			conditions = append(conditions, "pav.value_number >= "+qb.Bind(*attributeFilter.Min))
+		}
+		if attributeFilter.Max != nil {
+			conditions = append(conditions, "pav.value_number <= "+qb.Bind(*attributeFilter.Max))
 		}
 
-		products = append(products, &productEntity)
+		qb.Where(fmt.Sprintf("EXISTS (SELECT 1 FROM product_attribute_value pav JOIN category_attribute ca ON ca.id = pav.attribute_id WHERE %s)", strings.Join(conditions, " AND ")))
 	}
 
-	return products, nil
+	if filter.MinPrice != nil {
+		qb.Where(fmt.Sprintf("%s >= %s", productCurrentPriceColumn, qb.Bind(*filter.MinPrice)))
+	}
+	if filter.MaxPrice != nil {
+		qb.Where(fmt.Sprintf("%s <= %s", productCurrentPriceColumn, qb.Bind(*filter.MaxPrice)))
+	}
+
+	return qb, rankQuery
+}
+
+// publishedProductCondition matches the products of table, or its alias, that
+// are published and whose publish time, if any, has passed.
+func publishedProductCondition(table string) string {
+	return fmt.Sprintf("%[1]s.status = '%[2]s' AND (%[1]s.publish_at IS NULL OR %[1]s.publish_at <= NOW())", table, entity.ProductStatusPublished)
+}
+
+// buildSearchTsQuery turns free text into a tsquery where every word must
+// match as a prefix, e.g. "kaos hitam" becomes "kaos:* & hitam:*". Anything
+// other than letters and digits is dropped so the result is always valid.
+func buildSearchTsQuery(search string) string {
+	words := strings.FieldsFunc(strings.ToLower(search), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	terms := make([]string, len(words))
+	for i, word := range words {
+		terms[i] = word + ":*"
+	}
+
+	return strings.Join(terms, " & ")
 }
 
 func NewProductRepository(db database.DatabaseQuery) IProductRepository {
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/xryar/golang-grpc-ecommerce/internal/entity"
+)
+
+// GetProductBySlug returns the product currently using slug, or the product
+// that used it before when it was renamed since.
+func (repo *productRepository) GetProductBySlug(ctx context.Context, slug string) (*entity.Product, error) {
+	row := repo.db.QueryRowContext(
+		ctx,
+		fmt.Sprintf("SELECT %s FROM product WHERE (slug = $1 OR id = (SELECT product_id FROM product_slug_history WHERE slug = $1)) AND is_deleted = false", productDetailColumns),
+		slug,
+	)
+
+	return scanProductDetail(row)
+}
+
+// GetSlugProductId returns the id of the product owning slug, either as its
+// current or as a previous slug, and nil when the slug is free. Deleted
+// products keep their slugs.
+func (repo *productRepository) GetSlugProductId(ctx context.Context, slug string) (*string, error) {
+	row := repo.db.QueryRowContext(
+		ctx,
+		"SELECT id FROM product WHERE slug = $1 UNION ALL SELECT product_id FROM product_slug_history WHERE slug = $1 LIMIT 1",
+		slug,
+	)
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+
+	var productId string
+	err := row.Scan(&productId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &productId, nil
+}
+
+func (repo *productRepository) CreateProductSlugHistory(ctx context.Context, history *entity.ProductSlugHistory) error {
+	_, err := repo.db.ExecContext(
+		ctx,
+		"INSERT INTO product_slug_history (id, product_id, slug, created_at, created_by) VALUES ($1, $2, $3, $4, $5)",
+		history.Id,
+		history.ProductId,
+		history.Slug,
+		history.CreatedAt,
+		history.CreatedBy,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (repo *productRepository) DeleteProductSlugHistory(ctx context.Context, productId string, slug string) error {
+	_, err := repo.db.ExecContext(
+		ctx,
+		"DELETE FROM product_slug_history WHERE product_id = $1 AND slug = $2",
+		productId,
+		slug,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/xryar/golang-grpc-ecommerce/internal/entity"
+	"github.com/xryar/golang-grpc-ecommerce/pb/common"
+)
+
+func (repo *productRepository) GetDeletedProductById(ctx context.Context, id string) (*entity.Product, error) {
+	row := repo.db.QueryRowContext(
+		ctx,
+		"SELECT id, sku, name, image_file_name, deleted_at, deleted_by FROM product WHERE id = $1 AND is_deleted = true",
+		id,
+	)
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+
+	var productEntity entity.Product
+	err := row.Scan(
+		&productEntity.Id,
+		&productEntity.Sku,
+		&productEntity.Name,
+		&productEntity.ImageFileName,
+		&productEntity.DeletedAt,
+		&productEntity.DeletedBy,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &productEntity, nil
+}
+
+func (repo *productRepository) GetDeletedProductsPagination(ctx context.Context, pagination *common.PaginationRequest) ([]*entity.Product, *common.PaginationResponse, error) {
+	row := repo.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM product WHERE is_deleted = true")
+	if row.Err() != nil {
+		return nil, nil, row.Err()
+	}
+
+	var totalCount int
+	err := row.Scan(&totalCount)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	offset := (pagination.CurrentPage - 1) * pagination.ItemPerPage
+	totalPages := (totalCount + int(pagination.ItemPerPage) - 1) / int(pagination.ItemPerPage)
+
+	rows, err := repo.db.QueryContext(
+		ctx,
+		"SELECT id, sku, name, description, price, image_file_name, stock, deleted_at, deleted_by FROM product WHERE is_deleted = true ORDER BY deleted_at DESC LIMIT $1 OFFSET $2",
+		pagination.ItemPerPage,
+		offset,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var products []*entity.Product = make([]*entity.Product, 0)
+	for rows.Next() {
+		var product entity.Product
+		err = rows.Scan(
+			&product.Id,
+			&product.Sku,
+			&product.Name,
+			&product.Description,
+			&product.Price,
+			&product.ImageFileName,
+			&product.Stock,
+			&product.DeletedAt,
+			&product.DeletedBy,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		products = append(products, &product)
+	}
+
+	paginationResponse := &common.PaginationResponse{
+		CurrentPage:    pagination.CurrentPage,
+		ItemPerPage:    pagination.ItemPerPage,
+		TotalItemCount: int32(totalCount),
+		TotalPageCount: int32(totalPages),
+	}
+	return products, paginationResponse, nil
+}
+
+func (repo *productRepository) RestoreProduct(ctx context.Context, id string, updatedAt time.Time, updatedBy string) error {
+	_, err := repo.db.ExecContext(
+		ctx,
+		"UPDATE product SET deleted_at = NULL, deleted_by = NULL, is_deleted = false, updated_at = $1, updated_by = $2 WHERE id = $3 AND is_deleted = true",
+		updatedAt,
+		updatedBy,
+		id,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// PurgeProduct permanently deletes a soft-deleted product and every row that
+// belongs to it. Order items only keep the product id and are left untouched.
+func (repo *productRepository) PurgeProduct(ctx context.Context, id string) error {
+	queries := []string{
+		"DELETE FROM user_cart WHERE product_id = $1",
+		"DELETE FROM user_wishlist WHERE product_id = $1",
+		"DELETE FROM product_image WHERE product_id = $1",
+		"DELETE FROM product_variant WHERE product_id = $1",
+		"DELETE FROM product_bundle_item WHERE bundle_product_id = $1",
+		"DELETE FROM product_attribute_value WHERE product_id = $1",
+		"DELETE FROM product_review WHERE product_id = $1",
+		"DELETE FROM product_price_history WHERE product_id = $1",
+		"DELETE FROM product_slug_history WHERE product_id = $1",
+		"DELETE FROM product_collection_item WHERE product_id = $1",
+		"DELETE FROM product WHERE id = $1 AND is_deleted = true",
+	}
+	for _, query := range queries {
+		_, err := repo.db.ExecContext(ctx, query, id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
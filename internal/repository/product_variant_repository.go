@@ -0,0 +1,212 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/xryar/golang-grpc-ecommerce/internal/entity"
+)
+
+func (repo *productRepository) CreateProductVariant(ctx context.Context, variant *entity.ProductVariant) error {
+	_, err := repo.db.ExecContext(
+		ctx,
+		"INSERT INTO product_variant (id, product_id, sku, attributes, price, image_file_name, stock, created_at, created_by, updated_at, updated_by, deleted_at, deleted_by, is_deleted) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)",
+		variant.Id,
+		variant.ProductId,
+		variant.Sku,
+		variant.Attributes,
+		variant.Price,
+		variant.ImageFileName,
+		variant.Stock,
+		variant.CreatedAt,
+		variant.CreatedBy,
+		variant.UpdatedAt,
+		variant.UpdatedBy,
+		variant.DeletedAt,
+		variant.DeletedBy,
+		variant.IsDeleted,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (repo *productRepository) GetProductVariantById(ctx context.Context, id string) (*entity.ProductVariant, error) {
+	row := repo.db.QueryRowContext(
+		ctx,
+		"SELECT id, product_id, sku, attributes, price, image_file_name, stock FROM product_variant WHERE id = $1 AND is_deleted = false",
+		id,
+	)
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+
+	var variant entity.ProductVariant
+	err := row.Scan(
+		&variant.Id,
+		&variant.ProductId,
+		&variant.Sku,
+		&variant.Attributes,
+		&variant.Price,
+		&variant.ImageFileName,
+		&variant.Stock,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &variant, nil
+}
+
+func (repo *productRepository) GetProductVariantBySku(ctx context.Context, sku string) (*entity.ProductVariant, error) {
+	row := repo.db.QueryRowContext(
+		ctx,
+		"SELECT id, product_id, sku FROM product_variant WHERE sku = $1 AND is_deleted = false",
+		sku,
+	)
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+
+	var variant entity.ProductVariant
+	err := row.Scan(
+		&variant.Id,
+		&variant.ProductId,
+		&variant.Sku,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &variant, nil
+}
+
+func (repo *productRepository) GetProductVariantsByProductId(ctx context.Context, productId string) ([]*entity.ProductVariant, error) {
+	rows, err := repo.db.QueryContext(
+		ctx,
+		"SELECT id, product_id, sku, attributes, price, image_file_name, stock FROM product_variant WHERE product_id = $1 AND is_deleted = false ORDER BY sku ASC",
+		productId,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanProductVariants(rows)
+}
+
+func (repo *productRepository) GetProductVariantsByIds(ctx context.Context, ids []string) ([]*entity.ProductVariant, error) {
+	rows, err := repo.db.QueryContext(
+		ctx,
+		"SELECT id, product_id, sku, attributes, price, image_file_name, stock FROM product_variant WHERE id = ANY($1) AND is_deleted = false",
+		pq.Array(ids),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanProductVariants(rows)
+}
+
+func (repo *productRepository) UpdateProductVariant(ctx context.Context, variant *entity.ProductVariant) error {
+	_, err := repo.db.ExecContext(
+		ctx,
+		"UPDATE product_variant SET sku = $1, attributes = $2, price = $3, image_file_name = $4, stock = $5, updated_at = $6, updated_by = $7 WHERE id = $8",
+		variant.Sku,
+		variant.Attributes,
+		variant.Price,
+		variant.ImageFileName,
+		variant.Stock,
+		variant.UpdatedAt,
+		variant.UpdatedBy,
+		variant.Id,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (repo *productRepository) DeleteProductVariant(ctx context.Context, id string, deletedAt time.Time, deletedBy string) error {
+	_, err := repo.db.ExecContext(
+		ctx,
+		"UPDATE product_variant SET deleted_at = $1, deleted_by = $2, is_deleted = true WHERE id = $3",
+		deletedAt,
+		deletedBy,
+		id,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (repo *productRepository) DecreaseProductVariantStock(ctx context.Context, id string, quantity int64) (bool, error) {
+	result, err := repo.db.ExecContext(
+		ctx,
+		"UPDATE product_variant SET stock = stock - $1 WHERE id = $2 AND stock >= $1 AND is_deleted = false",
+		quantity,
+		id,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func (repo *productRepository) IncreaseProductVariantStock(ctx context.Context, id string, quantity int64) error {
+	_, err := repo.db.ExecContext(
+		ctx,
+		"UPDATE product_variant SET stock = stock + $1 WHERE id = $2",
+		quantity,
+		id,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func scanProductVariants(rows *sql.Rows) ([]*entity.ProductVariant, error) {
+	variants := make([]*entity.ProductVariant, 0)
+	for rows.Next() {
+		var variant entity.ProductVariant
+		err := rows.Scan(
+			&variant.Id,
+			&variant.ProductId,
+			&variant.Sku,
+			&variant.Attributes,
+			&variant.Price,
+			&variant.ImageFileName,
+			&variant.Stock,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		variants = append(variants, &variant)
+	}
+
+	return variants, nil
+}
@@ -0,0 +1,239 @@
+package repository
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/xryar/golang-grpc-ecommerce/pb/common"
+)
+
+// QueryValidationError reports sort and filter fields or values a listing
+// does not accept. Services return them in BaseResponse.ValidationErrors.
+type QueryValidationError struct {
+	ValidationErrors []*common.ValidationError
+}
+
+func (e *QueryValidationError) Error() string {
+	messages := make([]string, len(e.ValidationErrors))
+	for i, validationError := range e.ValidationErrors {
+		messages[i] = fmt.Sprintf("%s: %s", validationError.Field, validationError.Message)
+	}
+
+	return "invalid query: " + strings.Join(messages, ", ")
+}
+
+type queryValueType int
+
+const (
+	queryValueText queryValueType = iota
+	queryValueNumber
+	queryValueTime
+)
+
+// queryField is a column a listing lets clients sort or filter by under a
+// public name. Column is trusted sql, it never comes from a request.
+type queryField struct {
+	Column string
+	Type   queryValueType
+}
+
+// sqlType is what a value of the field is cast to when it comes back as text,
+// e.g. from a pagination cursor.
+func (f queryField) sqlType() string {
+	switch f.Type {
+	case queryValueNumber:
+		return "numeric"
+	case queryValueTime:
+		return "timestamptz"
+	default:
+		return "text"
+	}
+}
+
+// queryFields is the whitelist of a listing, keyed by public field name.
+type queryFields map[string]queryField
+
+// querySort is a validated sort request.
+type querySort struct {
+	Field      queryField
+	Descending bool
+}
+
+func (s *querySort) OrderQuery() string {
+	if s.Descending {
+		return fmt.Sprintf("ORDER BY %s DESC", s.Field.Column)
+	}
+
+	return fmt.Sprintf("ORDER BY %s ASC", s.Field.Column)
+}
+
+var queryFilterOperators = map[string]string{
+	"":    "=",
+	"eq":  "=",
+	"ne":  "<>",
+	"gt":  ">",
+	"gte": ">=",
+	"lt":  "<",
+	"lte": "<=",
+}
+
+// queryBuilder collects the conditions and bound arguments of a query. Every
+// value goes in as a parameter, only whitelisted columns and fixed sql are
+// written into the query itself. Invalid sort and filter requests are
+// collected and reported together by Err.
+type queryBuilder struct {
+	conditions       []string
+	args             []any
+	validationErrors []*common.ValidationError
+}
+
+func newQueryBuilder(conditions ...string) *queryBuilder {
+	return &queryBuilder{
+		conditions: conditions,
+		args:       make([]any, 0),
+	}
+}
+
+// Bind adds value as an argument and returns its placeholder.
+func (qb *queryBuilder) Bind(value any) string {
+	qb.args = append(qb.args, value)
+	return fmt.Sprintf("$%d", len(qb.args))
+}
+
+// Where adds a condition, which must only contain trusted sql and
+// placeholders returned by Bind.
+func (qb *queryBuilder) Where(condition string) {
+	qb.conditions = append(qb.conditions, condition)
+}
+
+// WhereIn adds a condition matching the rows whose column is one of values,
+// bound as a single array argument.
+func (qb *queryBuilder) WhereIn(column string, values []string) {
+	qb.Where(fmt.Sprintf("%s = ANY(%s)", column, qb.Bind(pq.Array(values))))
+}
+
+// WhereQuery returns the WHERE clause, or an empty string without conditions.
+func (qb *queryBuilder) WhereQuery() string {
+	if len(qb.conditions) == 0 {
+		return ""
+	}
+
+	return "WHERE " + strings.Join(qb.conditions, " AND ")
+}
+
+func (qb *queryBuilder) Args() []any {
+	return qb.args
+}
+
+// Sort validates sort against fields. It returns nil when no sort is asked
+// for, so the listing can keep its own default order.
+func (qb *queryBuilder) Sort(fields queryFields, sort *common.PaginationSortRequest) *querySort {
+	if sort == nil || sort.Field == "" {
+		return nil
+	}
+
+	field, ok := fields[sort.Field]
+	if !ok {
+		qb.invalid("sort.field", fmt.Sprintf("unknown sort field %q, allowed are %s", sort.Field, fieldNames(fields)))
+		return nil
+	}
+
+	switch sort.Direction {
+	case "", "asc":
+		return &querySort{Field: field}
+	case "desc":
+		return &querySort{Field: field, Descending: true}
+	default:
+		qb.invalid("sort.direction", "value must be asc or desc")
+		return nil
+	}
+}
+
+// Filter adds a condition for every filter, checking its field against fields
+// and parsing its value by the field's type.
+func (qb *queryBuilder) Filter(fields queryFields, filters []*common.PaginationFilterRequest) {
+	for i, filter := range filters {
+		name := fmt.Sprintf("filters[%d]", i)
+		field, ok := fields[filter.Field]
+		if !ok {
+			qb.invalid(name+".field", fmt.Sprintf("unknown filter field %q, allowed are %s", filter.Field, fieldNames(fields)))
+			continue
+		}
+
+		if filter.Operator == "contains" {
+			if field.Type != queryValueText {
+				qb.invalid(name+".operator", "contains only works on text fields")
+				continue
+			}
+
+			qb.Where(fmt.Sprintf("%s ILIKE %s", field.Column, qb.Bind("%"+escapeLikePattern(filter.Value)+"%")))
+			continue
+		}
+
+		operator, ok := queryFilterOperators[filter.Operator]
+		if !ok {
+			qb.invalid(name+".operator", "value must be one of eq, ne, gt, gte, lt, lte, contains")
+			continue
+		}
+
+		value, err := parseQueryValue(field.Type, filter.Value)
+		if err != nil {
+			qb.invalid(name+".value", err.Error())
+			continue
+		}
+
+		qb.Where(fmt.Sprintf("%s %s %s", field.Column, operator, qb.Bind(value)))
+	}
+}
+
+// Err returns a *QueryValidationError when a sort, filter or cursor was
+// invalid.
+func (qb *queryBuilder) Err() error {
+	if len(qb.validationErrors) == 0 {
+		return nil
+	}
+
+	return &QueryValidationError{ValidationErrors: qb.validationErrors}
+}
+
+func (qb *queryBuilder) invalid(field string, message string) {
+	qb.validationErrors = append(qb.validationErrors, &common.ValidationError{
+		Field:   "pagination." + field,
+		Message: message,
+	})
+}
+
+func parseQueryValue(valueType queryValueType, value string) (any, error) {
+	switch valueType {
+	case queryValueNumber:
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("value must be a number")
+		}
+
+		return number, nil
+	case queryValueTime:
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, fmt.Errorf("value must be an RFC 3339 time")
+		}
+
+		return t, nil
+	default:
+		return value, nil
+	}
+}
+
+// escapeLikePattern makes the wildcards of s match literally in LIKE.
+func escapeLikePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
+func fieldNames(fields queryFields) string {
+	return strings.Join(slices.Sorted(maps.Keys(fields)), ", ")
+}
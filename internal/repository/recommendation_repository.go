@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/xryar/golang-grpc-ecommerce/internal/entity"
+	"github.com/xryar/golang-grpc-ecommerce/pkg/database"
+)
+
+// recommendationRefreshLockId is the advisory lock that keeps replicas from
+// rebuilding the co-purchase table at the same time.
+const recommendationRefreshLockId = 7140001
+
+type IRecommendationRepository interface {
+	WithTransaction(tx *sql.Tx) IRecommendationRepository
+	RefreshCoPurchases(ctx context.Context, orderStatusCodes []string, updatedAt time.Time) (bool, error)
+	GetCoPurchasedProducts(ctx context.Context, productIds []string, limit int32) ([]*entity.ProductRecommendation, error)
+}
+
+type recommendationRepository struct {
+	db database.DatabaseQuery
+}
+
+func (rr *recommendationRepository) WithTransaction(tx *sql.Tx) IRecommendationRepository {
+	return &recommendationRepository{
+		db: tx,
+	}
+}
+
+// RefreshCoPurchases rebuilds the co-purchase counts from the orders with the
+// given statuses. It must run in a transaction and reports false without
+// doing anything when another refresh holds the lock.
+func (rr *recommendationRepository) RefreshCoPurchases(ctx context.Context, orderStatusCodes []string, updatedAt time.Time) (bool, error) {
+	var locked bool
+	err := rr.db.QueryRowContext(ctx, "SELECT pg_try_advisory_xact_lock($1)", recommendationRefreshLockId).Scan(&locked)
+	if err != nil || !locked {
+		return false, err
+	}
+
+	_, err = rr.db.ExecContext(ctx, "DELETE FROM product_co_purchase")
+	if err != nil {
+		return false, err
+	}
+
+	_, err = rr.db.ExecContext(
+		ctx,
+		`
+		INSERT INTO product_co_purchase (product_id, related_product_id, order_count, updated_at)
+		SELECT a.product_id, b.product_id, COUNT(DISTINCT a.order_id), $2
+		FROM order_item a
+		JOIN order_item b ON b.order_id = a.order_id AND b.product_id <> a.product_id AND b.is_deleted = false
+		JOIN "order" o ON o.id = a.order_id
+		WHERE a.is_deleted = false AND o.is_deleted = false AND o.order_status_code = ANY($1)
+		GROUP BY a.product_id, b.product_id
+		`,
+		pq.Array(orderStatusCodes),
+		updatedAt,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// GetCoPurchasedProducts ranks the products bought together with any of
+// productIds by how many orders they shared. The given products themselves,
+// deleted or unpublished products and products without stock are left out,
+// digital products are always in stock.
+func (rr *recommendationRepository) GetCoPurchasedProducts(ctx context.Context, productIds []string, limit int32) ([]*entity.ProductRecommendation, error) {
+	rows, err := rr.db.QueryContext(
+		ctx,
+		fmt.Sprintf(`
+		SELECT product.id, product.name, product.price, product.sale_price, product.sale_starts_at, product.sale_ends_at, product.image_file_name, SUM(pcp.order_count) AS order_count
+		FROM product_co_purchase pcp
+		JOIN product ON product.id = pcp.related_product_id
+		WHERE
+			pcp.product_id = ANY($1)
+			AND NOT (pcp.related_product_id = ANY($1))
+			AND product.is_deleted = false
+			AND %s
+			AND (product.type = 'digital' OR (%s) > 0 OR EXISTS (SELECT 1 FROM product_variant pv WHERE pv.product_id = product.id AND pv.is_deleted = false AND pv.stock > 0))
+		GROUP BY product.id
+		ORDER BY order_count DESC, product.name ASC
+		LIMIT $2
+		`, publishedProductCondition("product"), productStockColumn),
+		pq.Array(productIds),
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	recommendations := make([]*entity.ProductRecommendation, 0)
+	for rows.Next() {
+		recommendation := entity.ProductRecommendation{
+			Product: &entity.Product{},
+		}
+		err = rows.Scan(
+			&recommendation.Product.Id,
+			&recommendation.Product.Name,
+			&recommendation.Product.Price,
+			&recommendation.Product.SalePrice,
+			&recommendation.Product.SaleStartsAt,
+			&recommendation.Product.SaleEndsAt,
+			&recommendation.Product.ImageFileName,
+			&recommendation.OrderCount,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		recommendations = append(recommendations, &recommendation)
+	}
+
+	return recommendations, nil
+}
+
+func NewRecommendationRepository(db database.DatabaseQuery) IRecommendationRepository {
+	return &recommendationRepository{
+		db: db,
+	}
+}
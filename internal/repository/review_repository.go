@@ -0,0 +1,314 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/xryar/golang-grpc-ecommerce/internal/entity"
+	"github.com/xryar/golang-grpc-ecommerce/pb/common"
+	"github.com/xryar/golang-grpc-ecommerce/pkg/database"
+)
+
+type IReviewRepository interface {
+	CreateNewReview(ctx context.Context, review *entity.Review) error
+	GetReviewById(ctx context.Context, id string) (*entity.Review, error)
+	GetReviewByProductAndUserId(ctx context.Context, productId string, userId string) (*entity.Review, error)
+	HasDoneOrderWithProduct(ctx context.Context, userId string, productId string) (bool, error)
+	GetReviewsPagination(ctx context.Context, productId string, pagination *common.PaginationRequest) ([]*entity.Review, *common.PaginationResponse, error)
+	GetReviewsPaginationAdmin(ctx context.Context, pagination *common.PaginationRequest, filter *ReviewFilter) ([]*entity.Review, *common.PaginationResponse, error)
+	UpdateReviewStatus(ctx context.Context, review *entity.Review) error
+}
+
+// ReviewFilter narrows down the admin review listing, empty fields are not
+// filtered on.
+type ReviewFilter struct {
+	ProductId  string
+	StatusCode string
+}
+
+type reviewRepository struct {
+	db database.DatabaseQuery
+}
+
+func (rr *reviewRepository) CreateNewReview(ctx context.Context, review *entity.Review) error {
+	_, err := rr.db.ExecContext(
+		ctx,
+		"INSERT INTO product_review (id, product_id, user_id, user_full_name, rating, comment, status_code, created_at, created_by, updated_at, updated_by, deleted_at, deleted_by, is_deleted) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)",
+		review.Id,
+		review.ProductId,
+		review.UserId,
+		review.UserFullName,
+		review.Rating,
+		review.Comment,
+		review.StatusCode,
+		review.CreatedAt,
+		review.CreatedBy,
+		review.UpdatedAt,
+		review.UpdatedBy,
+		review.DeletedAt,
+		review.DeletedBy,
+		review.IsDeleted,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (rr *reviewRepository) GetReviewById(ctx context.Context, id string) (*entity.Review, error) {
+	row := rr.db.QueryRowContext(
+		ctx,
+		"SELECT id, product_id, user_id, user_full_name, rating, comment, status_code FROM product_review WHERE id = $1 AND is_deleted = false",
+		id,
+	)
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+
+	var review entity.Review
+	err := row.Scan(
+		&review.Id,
+		&review.ProductId,
+		&review.UserId,
+		&review.UserFullName,
+		&review.Rating,
+		&review.Comment,
+		&review.StatusCode,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &review, nil
+}
+
+func (rr *reviewRepository) GetReviewByProductAndUserId(ctx context.Context, productId string, userId string) (*entity.Review, error) {
+	row := rr.db.QueryRowContext(
+		ctx,
+		"SELECT id, product_id, user_id, user_full_name, rating, comment, status_code FROM product_review WHERE product_id = $1 AND user_id = $2 AND is_deleted = false",
+		productId,
+		userId,
+	)
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+
+	var review entity.Review
+	err := row.Scan(
+		&review.Id,
+		&review.ProductId,
+		&review.UserId,
+		&review.UserFullName,
+		&review.Rating,
+		&review.Comment,
+		&review.StatusCode,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &review, nil
+}
+
+func (rr *reviewRepository) HasDoneOrderWithProduct(ctx context.Context, userId string, productId string) (bool, error) {
+	row := rr.db.QueryRowContext(
+		ctx,
+		"SELECT EXISTS (SELECT 1 FROM \"order\" o JOIN order_item oi ON oi.order_id = o.id WHERE o.user_id = $1 AND oi.product_id = $2 AND o.order_status_code = $3 AND o.is_deleted = false AND oi.is_deleted = false)",
+		userId,
+		productId,
+		entity.OrderStatusCodeDone,
+	)
+	if row.Err() != nil {
+		return false, row.Err()
+	}
+
+	var exists bool
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (rr *reviewRepository) GetReviewsPagination(ctx context.Context, productId string, pagination *common.PaginationRequest) ([]*entity.Review, *common.PaginationResponse, error) {
+	row := rr.db.QueryRowContext(
+		ctx,
+		"SELECT COUNT(*) FROM product_review WHERE product_id = $1 AND status_code = $2 AND is_deleted = false",
+		productId,
+		entity.ReviewStatusCodeApproved,
+	)
+	if row.Err() != nil {
+		return nil, nil, row.Err()
+	}
+
+	var totalCount int
+	err := row.Scan(&totalCount)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	offset := (pagination.CurrentPage - 1) * pagination.ItemPerPage
+	totalPages := (totalCount + int(pagination.ItemPerPage) - 1) / int(pagination.ItemPerPage)
+
+	rows, err := rr.db.QueryContext(
+		ctx,
+		"SELECT id, product_id, user_id, user_full_name, rating, comment, status_code, created_at FROM product_review WHERE product_id = $1 AND status_code = $2 AND is_deleted = false ORDER BY created_at DESC LIMIT $3 OFFSET $4",
+		productId,
+		entity.ReviewStatusCodeApproved,
+		pagination.ItemPerPage,
+		offset,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var reviews []*entity.Review = make([]*entity.Review, 0)
+	for rows.Next() {
+		var review entity.Review
+		err = rows.Scan(
+			&review.Id,
+			&review.ProductId,
+			&review.UserId,
+			&review.UserFullName,
+			&review.Rating,
+			&review.Comment,
+			&review.StatusCode,
+			&review.CreatedAt,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		reviews = append(reviews, &review)
+	}
+
+	paginationResponse := &common.PaginationResponse{
+		CurrentPage:    pagination.CurrentPage,
+		ItemPerPage:    pagination.ItemPerPage,
+		TotalItemCount: int32(totalCount),
+		TotalPageCount: int32(totalPages),
+	}
+	return reviews, paginationResponse, nil
+}
+
+// reviewAdminSortFields and reviewAdminFilterFields are what the admin review
+// listing may be sorted and filtered by.
+var (
+	reviewAdminSortFields = queryFields{
+		"rating":     {Column: "pr.rating", Type: queryValueNumber},
+		"created_at": {Column: "pr.created_at", Type: queryValueTime},
+	}
+	reviewAdminFilterFields = queryFields{
+		"rating":     {Column: "pr.rating", Type: queryValueNumber},
+		"comment":    {Column: "pr.comment", Type: queryValueText},
+		"customer":   {Column: "pr.user_full_name", Type: queryValueText},
+		"created_at": {Column: "pr.created_at", Type: queryValueTime},
+	}
+)
+
+func (rr *reviewRepository) GetReviewsPaginationAdmin(ctx context.Context, pagination *common.PaginationRequest, filter *ReviewFilter) ([]*entity.Review, *common.PaginationResponse, error) {
+	qb := newQueryBuilder("pr.is_deleted = false")
+	if filter.ProductId != "" {
+		qb.Where("pr.product_id = " + qb.Bind(filter.ProductId))
+	}
+	if filter.StatusCode != "" {
+		qb.Where("pr.status_code = " + qb.Bind(filter.StatusCode))
+	}
+	qb.Filter(reviewAdminFilterFields, pagination.Filters)
+	sort := qb.Sort(reviewAdminSortFields, pagination.Sort)
+	if err := qb.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	row := rr.db.QueryRowContext(ctx, fmt.Sprintf("SELECT COUNT(*) FROM product_review pr %s", qb.WhereQuery()), qb.Args()...)
+	if row.Err() != nil {
+		return nil, nil, row.Err()
+	}
+
+	var totalCount int
+	err := row.Scan(&totalCount)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	offset := (pagination.CurrentPage - 1) * pagination.ItemPerPage
+	totalPages := (totalCount + int(pagination.ItemPerPage) - 1) / int(pagination.ItemPerPage)
+
+	orderQuery := "ORDER BY pr.created_at DESC"
+	if sort != nil {
+		orderQuery = sort.OrderQuery() + ", pr.id ASC"
+	}
+
+	baseQuery := fmt.Sprintf("SELECT pr.id, pr.product_id, p.name, pr.user_id, pr.user_full_name, pr.rating, pr.comment, pr.status_code, pr.created_at FROM product_review pr JOIN product p ON p.id = pr.product_id %s %s LIMIT %s OFFSET %s", qb.WhereQuery(), orderQuery, qb.Bind(pagination.ItemPerPage), qb.Bind(offset))
+	rows, err := rr.db.QueryContext(
+		ctx,
+		baseQuery,
+		qb.Args()...,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var reviews []*entity.Review = make([]*entity.Review, 0)
+	for rows.Next() {
+		var review entity.Review
+		err = rows.Scan(
+			&review.Id,
+			&review.ProductId,
+			&review.ProductName,
+			&review.UserId,
+			&review.UserFullName,
+			&review.Rating,
+			&review.Comment,
+			&review.StatusCode,
+			&review.CreatedAt,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		reviews = append(reviews, &review)
+	}
+
+	paginationResponse := &common.PaginationResponse{
+		CurrentPage:    pagination.CurrentPage,
+		ItemPerPage:    pagination.ItemPerPage,
+		TotalItemCount: int32(totalCount),
+		TotalPageCount: int32(totalPages),
+	}
+	return reviews, paginationResponse, nil
+}
+
+func (rr *reviewRepository) UpdateReviewStatus(ctx context.Context, review *entity.Review) error {
+	_, err := rr.db.ExecContext(
+		ctx,
+		"UPDATE product_review SET status_code = $1, updated_at = $2, updated_by = $3 WHERE id = $4",
+		review.StatusCode,
+		review.UpdatedAt,
+		review.UpdatedBy,
+		review.Id,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewReviewRepository(db database.DatabaseQuery) IReviewRepository {
+	return &reviewRepository{
+		db: db,
+	}
+}
@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/xryar/golang-grpc-ecommerce/internal/entity"
+)
+
+type IWishlistRepository interface {
+	GetWishlistByProductAndUserId(ctx context.Context, productId string, productVariantId *string, userId string) (*entity.UserWishlist, error)
+	CreateNewWishlist(ctx context.Context, wishlist *entity.UserWishlist) error
+	GetListWishlist(ctx context.Context, userId string) ([]*entity.UserWishlist, error)
+	GetWishlistById(ctx context.Context, wishlistId string) (*entity.UserWishlist, error)
+	DeleteWishlist(ctx context.Context, wishlistId string) error
+}
+
+type wishlistRepository struct {
+	db *sql.DB
+}
+
+func (wr *wishlistRepository) GetWishlistByProductAndUserId(ctx context.Context, productId string, productVariantId *string, userId string) (*entity.UserWishlist, error) {
+	row := wr.db.QueryRowContext(
+		ctx,
+		"SELECT id, product_id, product_variant_id, user_id, price_when_added, created_at, created_by FROM user_wishlist WHERE product_id = $1 AND product_variant_id IS NOT DISTINCT FROM $2 AND user_id = $3",
+		productId,
+		productVariantId,
+		userId,
+	)
+
+	return scanWishlist(row)
+}
+
+func (wr *wishlistRepository) CreateNewWishlist(ctx context.Context, wishlist *entity.UserWishlist) error {
+	_, err := wr.db.ExecContext(
+		ctx,
+		"INSERT INTO user_wishlist (id, product_id, product_variant_id, user_id, price_when_added, created_at, created_by) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+		wishlist.Id,
+		wishlist.ProductId,
+		wishlist.ProductVariantId,
+		wishlist.UserId,
+		wishlist.PriceWhenAdded,
+		wishlist.CreatedAt,
+		wishlist.CreatedBy,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (wr *wishlistRepository) GetListWishlist(ctx context.Context, userId string) ([]*entity.UserWishlist, error) {
+	rows, err := wr.db.QueryContext(
+		ctx,
+		fmt.Sprintf(`
+		SELECT
+			uw.id, uw.product_id, uw.product_variant_id, uw.user_id, uw.price_when_added, uw.created_at, uw.created_by,
+			product.id, product.name, product.image_file_name, product.price, product.sale_price, product.sale_starts_at, product.sale_ends_at, product.type, %s,
+			pv.id, pv.sku, pv.attributes, pv.price, pv.image_file_name, pv.stock
+		FROM user_wishlist uw
+		JOIN product ON uw.product_id = product.id
+		LEFT JOIN product_variant pv ON uw.product_variant_id = pv.id AND pv.is_deleted = false
+		WHERE uw.user_id = $1 AND product.is_deleted = false AND %s AND (uw.product_variant_id IS NULL OR pv.id IS NOT NULL)
+		ORDER BY uw.created_at DESC
+		`, productStockColumn, publishedProductCondition("product")),
+		userId,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var wishlists []*entity.UserWishlist = make([]*entity.UserWishlist, 0)
+	for rows.Next() {
+		var wishlist entity.UserWishlist
+		var variantId, variantSku *string
+		var variantStock *int64
+		var variant entity.ProductVariant
+		wishlist.Product = &entity.Product{}
+
+		err = rows.Scan(
+			&wishlist.Id,
+			&wishlist.ProductId,
+			&wishlist.ProductVariantId,
+			&wishlist.UserId,
+			&wishlist.PriceWhenAdded,
+			&wishlist.CreatedAt,
+			&wishlist.CreatedBy,
+			&wishlist.Product.Id,
+			&wishlist.Product.Name,
+			&wishlist.Product.ImageFileName,
+			&wishlist.Product.Price,
+			&wishlist.Product.SalePrice,
+			&wishlist.Product.SaleStartsAt,
+			&wishlist.Product.SaleEndsAt,
+			&wishlist.Product.Type,
+			&wishlist.Product.Stock,
+			&variantId,
+			&variantSku,
+			&variant.Attributes,
+			&variant.Price,
+			&variant.ImageFileName,
+			&variantStock,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if variantId != nil {
+			variant.Id = *variantId
+			variant.ProductId = wishlist.ProductId
+			variant.Sku = *variantSku
+			variant.Stock = *variantStock
+			wishlist.ProductVariant = &variant
+		}
+
+		wishlists = append(wishlists, &wishlist)
+	}
+
+	return wishlists, nil
+}
+
+func (wr *wishlistRepository) GetWishlistById(ctx context.Context, wishlistId string) (*entity.UserWishlist, error) {
+	row := wr.db.QueryRowContext(
+		ctx,
+		"SELECT id, product_id, product_variant_id, user_id, price_when_added, created_at, created_by FROM user_wishlist WHERE id = $1",
+		wishlistId,
+	)
+
+	return scanWishlist(row)
+}
+
+func scanWishlist(row *sql.Row) (*entity.UserWishlist, error) {
+	if row.Err() != nil {
+		return nil, row.Err()
+	}
+
+	var wishlist entity.UserWishlist
+	err := row.Scan(
+		&wishlist.Id,
+		&wishlist.ProductId,
+		&wishlist.ProductVariantId,
+		&wishlist.UserId,
+		&wishlist.PriceWhenAdded,
+		&wishlist.CreatedAt,
+		&wishlist.CreatedBy,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &wishlist, nil
+}
+
+func (wr *wishlistRepository) DeleteWishlist(ctx context.Context, wishlistId string) error {
+	_, err := wr.db.ExecContext(
+		ctx,
+		"DELETE FROM user_wishlist WHERE id = $1",
+		wishlistId,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewWishlistRepository(db *sql.DB) IWishlistRepository {
+	return &wishlistRepository{
+		db: db,
+	}
+}
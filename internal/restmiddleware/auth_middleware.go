@@ -0,0 +1,39 @@
+package restmiddleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/xryar/golang-grpc-ecommerce/internal/entity"
+	jwtentity "github.com/xryar/golang-grpc-ecommerce/internal/entity/jwt"
+)
+
+// AdminMiddleware lets through requests with an admin bearer token and puts
+// its claims on the user context, the same way the grpc auth middleware does.
+// Logged out tokens are only known to the grpc server and are not rejected here.
+func AdminMiddleware(c *fiber.Ctx) error {
+	tokenSplit := strings.Split(c.Get("Authorization"), " ")
+	if len(tokenSplit) != 2 || tokenSplit[0] != "Bearer" {
+		return unauthenticated(c)
+	}
+
+	claims, err := jwtentity.GetClaimsFromToken(tokenSplit[1])
+	if err != nil {
+		return unauthenticated(c)
+	}
+	if claims.Role != entity.UserRoleAdmin {
+		return unauthenticated(c)
+	}
+
+	c.SetUserContext(claims.SetToContext(c.UserContext()))
+
+	return c.Next()
+}
+
+func unauthenticated(c *fiber.Ctx) error {
+	return c.Status(http.StatusUnauthorized).JSON(fiber.Map{
+		"success": false,
+		"message": "Unauthenticated",
+	})
+}
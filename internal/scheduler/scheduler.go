@@ -0,0 +1,40 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"runtime/debug"
+	"time"
+)
+
+// Start runs job once immediately and then every interval until ctx is done.
+// Errors and panics are logged so a failing run never stops the schedule.
+func Start(ctx context.Context, name string, interval time.Duration, job func(ctx context.Context) error) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			run(ctx, name, job)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func run(ctx context.Context, name string, job func(ctx context.Context) error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("Job %s panic: %v", name, r)
+			debug.PrintStack()
+		}
+	}()
+
+	if err := job(ctx); err != nil {
+		log.Printf("Job %s error: %v", name, err)
+	}
+}
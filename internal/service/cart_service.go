@@ -2,14 +2,16 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"os"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/xryar/golang-grpc-ecommerce/internal/entity"
 	jwtentity "github.com/xryar/golang-grpc-ecommerce/internal/entity/jwt"
+	"github.com/xryar/golang-grpc-ecommerce/internal/imageprocessor"
 	"github.com/xryar/golang-grpc-ecommerce/internal/repository"
+	"github.com/xryar/golang-grpc-ecommerce/internal/storage"
 	"github.com/xryar/golang-grpc-ecommerce/internal/utils"
 	"github.com/xryar/golang-grpc-ecommerce/pb/cart"
 )
@@ -19,11 +21,14 @@ type ICartService interface {
 	ListCart(ctx context.Context, request *cart.ListCartRequest) (*cart.ListCartResponse, error)
 	DeleteCart(ctx context.Context, request *cart.DeleteCartRequest) (*cart.DeleteCartResponse, error)
 	UpdateCartQuantity(ctx context.Context, request *cart.UpdateCartQuantityRequest) (*cart.UpdateCartQuantityResponse, error)
+	AcceptCartPrice(ctx context.Context, request *cart.AcceptCartPriceRequest) (*cart.AcceptCartPriceResponse, error)
 }
 
 type cartService struct {
-	productRepository repository.IProductRepository
-	cartRepository    repository.ICartRepository
+	productRepository      repository.IProductRepository
+	cartRepository         repository.ICartRepository
+	exchangeRateRepository repository.IExchangeRateRepository
+	storage                storage.IStorage
 }
 
 func (cs *cartService) AddProductToCart(ctx context.Context, request *cart.AddProductToCartRequest) (*cart.AddProductToCartResponse, error) {
@@ -37,21 +42,71 @@ func (cs *cartService) AddProductToCart(ctx context.Context, request *cart.AddPr
 	if err != nil {
 		return nil, err
 	}
-	if productEntity == nil {
+	if productEntity == nil || !productIsPublished(productEntity, time.Now()) {
 		return &cart.AddProductToCartResponse{
 			Base: utils.NotFoundResponse("Product not found"),
 		}, nil
 	}
 
+	var productVariantId *string
+	var variantEntity *entity.ProductVariant
+	if request.VariantId != "" {
+		variantEntity, err = cs.productRepository.GetProductVariantById(ctx, request.VariantId)
+		if err != nil {
+			return nil, err
+		}
+		if variantEntity == nil || variantEntity.ProductId != productEntity.Id {
+			return &cart.AddProductToCartResponse{
+				Base: utils.NotFoundResponse("Product variant not found"),
+			}, nil
+		}
+
+		productVariantId = &variantEntity.Id
+	} else if productEntity.HasVariants {
+		return &cart.AddProductToCartResponse{
+			Base: utils.BadRequestResponse("Product variant is required"),
+		}, nil
+	}
+
 	// cek apakah product sudah ada di cart user
-	cartEntity, err := cs.cartRepository.GetCartByProductAndUserId(ctx, request.ProductId, claims.Subject)
+	cartEntity, err := cs.cartRepository.GetCartByProductAndUserId(ctx, request.ProductId, productVariantId, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	var cartId *string
+	var cartQuantity int64
+	if cartEntity != nil {
+		cartId = &cartEntity.Id
+		cartQuantity = int64(cartEntity.Quantity)
+	}
+
+	// other variants of the product count towards its order quantity limits
+	otherQuantity, err := cs.cartRepository.GetCartProductQuantity(ctx, claims.Subject, productEntity.Id, cartId)
 	if err != nil {
 		return nil, err
 	}
 
+	quantity := request.Quantity
+	if quantity == 0 {
+		quantity = 1
+		if productEntity.MinOrderQuantity != nil && otherQuantity+cartQuantity+quantity < *productEntity.MinOrderQuantity {
+			quantity = *productEntity.MinOrderQuantity - otherQuantity - cartQuantity
+		}
+	}
+
+	newQuantity := cartQuantity + quantity
+	if message := cartQuantityMessage(productEntity, variantEntity, newQuantity, otherQuantity+newQuantity); message != "" {
+		return &cart.AddProductToCartResponse{
+			Base: utils.BadRequestResponse(message),
+		}, nil
+	}
+
+	now := time.Now()
 	if cartEntity != nil {
-		now := time.Now()
-		cartEntity.Quantity += 1
+		// the price stays the one the line was added at, the user accepts
+		// a changed price with AcceptCartPrice
+		cartEntity.Quantity = int(newQuantity)
 		cartEntity.UpdateAt = &now
 		cartEntity.UpdatedBy = &claims.Subject
 
@@ -66,13 +121,16 @@ func (cs *cartService) AddProductToCart(ctx context.Context, request *cart.AddPr
 		}, nil
 	}
 
+	price := productVariantPrice(productEntity, variantEntity, now)
 	newCartEntity := entity.UserCart{
-		Id:        uuid.NewString(),
-		UserId:    claims.Subject,
-		ProductId: request.ProductId,
-		Quantity:  1,
-		CreatedAt: time.Now(),
-		CreatedBy: claims.Fullname,
+		Id:               uuid.NewString(),
+		UserId:           claims.Subject,
+		ProductId:        request.ProductId,
+		ProductVariantId: productVariantId,
+		Quantity:         int(newQuantity),
+		CreatedAt:        now,
+		CreatedBy:        claims.Fullname,
+		Price:            &price,
 	}
 	err = cs.cartRepository.CreateNewCart(ctx, &newCartEntity)
 	if err != nil {
@@ -92,28 +150,72 @@ func (cs *cartService) ListCart(ctx context.Context, request *cart.ListCartReque
 		return nil, err
 	}
 
+	now := time.Now()
+	converter, err := newPriceConverter(ctx, cs.exchangeRateRepository, displayCurrency(ctx, request.Currency), now)
+	if errors.Is(err, errCurrencyNotAvailable) {
+		return &cart.ListCartResponse{
+			Base: utils.BadRequestResponse("Currency is not available"),
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
 	carts, err := cs.cartRepository.GetListCart(ctx, claims.Subject)
 	if err != nil {
 		return nil, err
 	}
 
+	// order quantity limits apply to all variants of a product together
+	productQuantities := make(map[string]int64)
+	for _, cartEntity := range carts {
+		productQuantities[cartEntity.ProductId] += int64(cartEntity.Quantity)
+	}
+
+	canCheckout := len(carts) > 0
 	var items []*cart.ListCartResponseItem = make([]*cart.ListCartResponseItem, 0)
 	for _, cartEntity := range carts {
+		imageFileName := cartEntity.Product.ImageFileName
+		warnings := cartItemWarnings(cartEntity, productQuantities[cartEntity.ProductId], converter, now)
+		for _, warning := range warnings {
+			if warning.Code != entity.CartWarningPriceChanged {
+				canCheckout = false
+			}
+		}
+
 		item := cart.ListCartResponseItem{
-			CartId:          cartEntity.Id,
-			ProductId:       cartEntity.Product.Id,
-			ProductName:     cartEntity.Product.Name,
-			ProductImageUrl: fmt.Sprintf("%s/product/%s", os.Getenv("STORAGE_SERVICE_URL"), cartEntity.Product.ImageFileName),
-			ProductPrice:    cartEntity.Product.Price,
-			Quantity:        int64(cartEntity.Quantity),
+			CartId:               cartEntity.Id,
+			ProductId:            cartEntity.Product.Id,
+			ProductName:          cartEntity.Product.Name,
+			ProductPrice:         converter.Convert(productVariantPrice(cartEntity.Product, cartEntity.ProductVariant, now)),
+			Quantity:             int64(cartEntity.Quantity),
+			ProductOriginalPrice: converter.Convert(productVariantOriginalPrice(cartEntity.Product, cartEntity.ProductVariant)),
+			AvailableStock:       cartItemStock(cartEntity.Product, cartEntity.ProductVariant),
+			MinOrderQuantity:     cartEntity.Product.MinOrderQuantity,
+			MaxOrderQuantity:     cartEntity.Product.MaxOrderQuantity,
+			Warnings:             warnings,
+		}
+		if cartEntity.ProductVariant != nil {
+			item.VariantId = cartEntity.ProductVariant.Id
+			item.VariantSku = cartEntity.ProductVariant.Sku
+			item.VariantAttributes = cartEntity.ProductVariant.Attributes
+			if cartEntity.ProductVariant.ImageFileName != nil {
+				imageFileName = *cartEntity.ProductVariant.ImageFileName
+			}
+		}
+		item.ProductImageUrl, err = cs.storage.URL(ctx, imageprocessor.RenditionFileName(imageFileName, imageprocessor.RenditionThumbnail))
+		if err != nil {
+			return nil, err
 		}
 
 		items = append(items, &item)
 	}
 
 	return &cart.ListCartResponse{
-		Base:  utils.SuccessResponse("Get List Cart Success"),
-		Items: items,
+		Base:        utils.SuccessResponse("Get List Cart Success"),
+		Items:       items,
+		Currency:    converter.currency,
+		CanCheckout: canCheckout,
 	}, nil
 }
 
@@ -183,6 +285,39 @@ func (cs *cartService) UpdateCartQuantity(ctx context.Context, request *cart.Upd
 	}
 
 	now := time.Now()
+	productEntity, err := cs.productRepository.GetProductById(ctx, cartEntity.ProductId)
+	if err != nil {
+		return nil, err
+	}
+	if productEntity == nil || !productIsPublished(productEntity, now) {
+		return &cart.UpdateCartQuantityResponse{
+			Base: utils.NotFoundResponse("Product not found"),
+		}, nil
+	}
+
+	var variantEntity *entity.ProductVariant
+	if cartEntity.ProductVariantId != nil {
+		variantEntity, err = cs.productRepository.GetProductVariantById(ctx, *cartEntity.ProductVariantId)
+		if err != nil {
+			return nil, err
+		}
+		if variantEntity == nil {
+			return &cart.UpdateCartQuantityResponse{
+				Base: utils.NotFoundResponse("Product variant not found"),
+			}, nil
+		}
+	}
+
+	otherQuantity, err := cs.cartRepository.GetCartProductQuantity(ctx, claims.Subject, productEntity.Id, &cartEntity.Id)
+	if err != nil {
+		return nil, err
+	}
+	if message := cartQuantityMessage(productEntity, variantEntity, request.NewQuantity, otherQuantity+request.NewQuantity); message != "" {
+		return &cart.UpdateCartQuantityResponse{
+			Base: utils.BadRequestResponse(message),
+		}, nil
+	}
+
 	cartEntity.Quantity = int(request.NewQuantity)
 	cartEntity.UpdateAt = &now
 	cartEntity.UpdatedBy = &claims.Fullname
@@ -197,9 +332,152 @@ func (cs *cartService) UpdateCartQuantity(ctx context.Context, request *cart.Upd
 	}, nil
 }
 
-func NewCartService(productRespository repository.IProductRepository, cartRepository repository.ICartRepository) ICartService {
+func (cs *cartService) AcceptCartPrice(ctx context.Context, request *cart.AcceptCartPriceRequest) (*cart.AcceptCartPriceResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cartEntity, err := cs.cartRepository.GetCartById(ctx, request.CartId)
+	if err != nil {
+		return nil, err
+	}
+	if cartEntity == nil {
+		return &cart.AcceptCartPriceResponse{
+			Base: utils.NotFoundResponse("Cart Not Found"),
+		}, nil
+	}
+
+	if cartEntity.UserId != claims.Subject {
+		return &cart.AcceptCartPriceResponse{
+			Base: utils.BadRequestResponse("Cart user is not matched"),
+		}, nil
+	}
+
+	now := time.Now()
+	productEntity, err := cs.productRepository.GetProductById(ctx, cartEntity.ProductId)
+	if err != nil {
+		return nil, err
+	}
+	if productEntity == nil || !productIsPublished(productEntity, now) {
+		return &cart.AcceptCartPriceResponse{
+			Base: utils.NotFoundResponse("Product not found"),
+		}, nil
+	}
+
+	var variantEntity *entity.ProductVariant
+	if cartEntity.ProductVariantId != nil {
+		variantEntity, err = cs.productRepository.GetProductVariantById(ctx, *cartEntity.ProductVariantId)
+		if err != nil {
+			return nil, err
+		}
+		if variantEntity == nil {
+			return &cart.AcceptCartPriceResponse{
+				Base: utils.NotFoundResponse("Product variant not found"),
+			}, nil
+		}
+	}
+
+	price := productVariantPrice(productEntity, variantEntity, now)
+	cartEntity.Price = &price
+	cartEntity.UpdateAt = &now
+	cartEntity.UpdatedBy = &claims.Fullname
+
+	err = cs.cartRepository.UpdateCart(ctx, cartEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cart.AcceptCartPriceResponse{
+		Base: utils.SuccessResponse("Accept Cart Price Success"),
+	}, nil
+}
+
+// cartItemStock returns how many units of the product, or of the variant
+// when it is not nil, are in stock. It returns nil for digital products,
+// which are never out of stock.
+func cartItemStock(prod *entity.Product, variant *entity.ProductVariant) *int64 {
+	if prod.Type == entity.ProductTypeDigital {
+		return nil
+	}
+
+	stock := prod.Stock
+	if variant != nil {
+		stock = variant.Stock
+	}
+
+	return &stock
+}
+
+// cartQuantityMessage returns why quantity units of a cart item can not be in
+// the cart, or an empty string when they can. productQuantity counts the
+// units of all variants of the product in the cart.
+func cartQuantityMessage(prod *entity.Product, variant *entity.ProductVariant, quantity int64, productQuantity int64) string {
+	stock := cartItemStock(prod, variant)
+	if stock != nil && *stock <= 0 {
+		return fmt.Sprintf("Product %s is out of stock", prod.Name)
+	}
+	if stock != nil && quantity > *stock {
+		return fmt.Sprintf("Insufficient stock for product %s, only %d left", prod.Name, *stock)
+	}
+
+	return productOrderQuantityMessage(prod, productQuantity)
+}
+
+// cartItemWarnings tells why the cart item can not be ordered as it is and
+// whether its price changed since it was added.
+func cartItemWarnings(cartEntity *entity.UserCart, productQuantity int64, converter *priceConverter, now time.Time) []*cart.ListCartResponseItemWarning {
+	warnings := make([]*cart.ListCartResponseItemWarning, 0)
+	prod := cartEntity.Product
+	stock := cartItemStock(prod, cartEntity.ProductVariant)
+	quantity := int64(cartEntity.Quantity)
+	if !productIsPublished(prod, now) {
+		warnings = append(warnings, &cart.ListCartResponseItemWarning{
+			Code:    entity.CartWarningUnavailable,
+			Message: "Product is no longer available",
+		})
+	} else if stock != nil && *stock <= 0 {
+		warnings = append(warnings, &cart.ListCartResponseItemWarning{
+			Code:    entity.CartWarningOutOfStock,
+			Message: "Product is out of stock",
+		})
+	} else if stock != nil && quantity > *stock {
+		warnings = append(warnings, &cart.ListCartResponseItemWarning{
+			Code:    entity.CartWarningReducedAvailability,
+			Message: fmt.Sprintf("Only %d left in stock", *stock),
+		})
+	}
+
+	if prod.MinOrderQuantity != nil && productQuantity < *prod.MinOrderQuantity {
+		warnings = append(warnings, &cart.ListCartResponseItemWarning{
+			Code:    entity.CartWarningBelowMinQuantity,
+			Message: fmt.Sprintf("Must be ordered at least %d at a time", *prod.MinOrderQuantity),
+		})
+	}
+	if prod.MaxOrderQuantity != nil && productQuantity > *prod.MaxOrderQuantity {
+		warnings = append(warnings, &cart.ListCartResponseItemWarning{
+			Code:    entity.CartWarningAboveMaxQuantity,
+			Message: fmt.Sprintf("Can be ordered at most %d at a time", *prod.MaxOrderQuantity),
+		})
+	}
+
+	if cartEntity.Price != nil && *cartEntity.Price != productVariantPrice(prod, cartEntity.ProductVariant, now) {
+		previousPrice := converter.Convert(*cartEntity.Price)
+		warnings = append(warnings, &cart.ListCartResponseItemWarning{
+			Code:          entity.CartWarningPriceChanged,
+			Message:       "Price changed since the product was added to the cart",
+			PreviousPrice: &previousPrice,
+		})
+	}
+
+	return warnings
+}
+
+func NewCartService(productRespository repository.IProductRepository, cartRepository repository.ICartRepository, exchangeRateRepository repository.IExchangeRateRepository, storage storage.IStorage) ICartService {
 	return &cartService{
-		productRepository: productRespository,
-		cartRepository:    cartRepository,
+		productRepository:      productRespository,
+		cartRepository:         cartRepository,
+		exchangeRateRepository: exchangeRateRepository,
+		storage:                storage,
 	}
 }
@@ -0,0 +1,464 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/xryar/golang-grpc-ecommerce/internal/entity"
+	jwtentity "github.com/xryar/golang-grpc-ecommerce/internal/entity/jwt"
+	"github.com/xryar/golang-grpc-ecommerce/internal/repository"
+	"github.com/xryar/golang-grpc-ecommerce/internal/utils"
+	"github.com/xryar/golang-grpc-ecommerce/pb/category"
+)
+
+type ICategoryService interface {
+	CreateCategory(ctx context.Context, request *category.CreateCategoryRequest) (*category.CreateCategoryResponse, error)
+	EditCategory(ctx context.Context, request *category.EditCategoryRequest) (*category.EditCategoryResponse, error)
+	DeleteCategory(ctx context.Context, request *category.DeleteCategoryRequest) (*category.DeleteCategoryResponse, error)
+	ListCategory(ctx context.Context, request *category.ListCategoryRequest) (*category.ListCategoryResponse, error)
+	CreateCategoryAttribute(ctx context.Context, request *category.CreateCategoryAttributeRequest) (*category.CreateCategoryAttributeResponse, error)
+	EditCategoryAttribute(ctx context.Context, request *category.EditCategoryAttributeRequest) (*category.EditCategoryAttributeResponse, error)
+	DeleteCategoryAttribute(ctx context.Context, request *category.DeleteCategoryAttributeRequest) (*category.DeleteCategoryAttributeResponse, error)
+	ListCategoryAttribute(ctx context.Context, request *category.ListCategoryAttributeRequest) (*category.ListCategoryAttributeResponse, error)
+}
+
+type categoryService struct {
+	categoryRepository repository.ICategoryRepository
+}
+
+func (cs *categoryService) CreateCategory(ctx context.Context, request *category.CreateCategoryRequest) (*category.CreateCategoryResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	var parentId *string
+	if request.ParentId != "" {
+		parentEntity, err := cs.categoryRepository.GetCategoryById(ctx, request.ParentId)
+		if err != nil {
+			return nil, err
+		}
+		if parentEntity == nil {
+			return &category.CreateCategoryResponse{
+				Base: utils.BadRequestResponse("Parent category not found"),
+			}, nil
+		}
+
+		parentId = &parentEntity.Id
+	}
+
+	categoryEntity := entity.Category{
+		Id:        uuid.NewString(),
+		Name:      request.Name,
+		ParentId:  parentId,
+		CreatedAt: time.Now(),
+		CreatedBy: claims.Fullname,
+	}
+	err = cs.categoryRepository.CreateNewCategory(ctx, &categoryEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &category.CreateCategoryResponse{
+		Base: utils.SuccessResponse("Category is created"),
+		Id:   categoryEntity.Id,
+	}, nil
+}
+
+func (cs *categoryService) EditCategory(ctx context.Context, request *category.EditCategoryRequest) (*category.EditCategoryResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	categoryEntity, err := cs.categoryRepository.GetCategoryById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if categoryEntity == nil {
+		return &category.EditCategoryResponse{
+			Base: utils.NotFoundResponse("Category not found"),
+		}, nil
+	}
+
+	var parentId *string
+	if request.ParentId != "" {
+		// the new parent must not be the category itself or one of its descendants
+		descendantIds, err := cs.categoryRepository.GetCategoryDescendantIds(ctx, request.Id)
+		if err != nil {
+			return nil, err
+		}
+		if slices.Contains(descendantIds, request.ParentId) {
+			return &category.EditCategoryResponse{
+				Base: utils.BadRequestResponse("Category cannot be moved under itself"),
+			}, nil
+		}
+
+		parentEntity, err := cs.categoryRepository.GetCategoryById(ctx, request.ParentId)
+		if err != nil {
+			return nil, err
+		}
+		if parentEntity == nil {
+			return &category.EditCategoryResponse{
+				Base: utils.BadRequestResponse("Parent category not found"),
+			}, nil
+		}
+
+		// products of the moved categories get the attributes of the new
+		// parents, a code may only be used once along the way
+		parentAncestors, err := cs.categoryRepository.GetCategoryAncestors(ctx, parentEntity.Id)
+		if err != nil {
+			return nil, err
+		}
+		parentAncestorIds := make([]string, 0)
+		for _, ancestor := range parentAncestors {
+			parentAncestorIds = append(parentAncestorIds, ancestor.Id)
+		}
+
+		conflictCode, err := cs.attributeCodeConflict(ctx, parentAncestorIds, descendantIds)
+		if err != nil {
+			return nil, err
+		}
+		if conflictCode != "" {
+			return &category.EditCategoryResponse{
+				Base: utils.BadRequestResponse(fmt.Sprintf("Parent category already has an attribute %s", conflictCode)),
+			}, nil
+		}
+
+		parentId = &parentEntity.Id
+	}
+
+	now := time.Now()
+	categoryEntity.Name = request.Name
+	categoryEntity.ParentId = parentId
+	categoryEntity.UpdatedAt = &now
+	categoryEntity.UpdatedBy = &claims.Fullname
+
+	err = cs.categoryRepository.UpdateCategory(ctx, categoryEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &category.EditCategoryResponse{
+		Base: utils.SuccessResponse("Edit Category Success"),
+		Id:   categoryEntity.Id,
+	}, nil
+}
+
+func (cs *categoryService) DeleteCategory(ctx context.Context, request *category.DeleteCategoryRequest) (*category.DeleteCategoryResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	categoryEntity, err := cs.categoryRepository.GetCategoryById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if categoryEntity == nil {
+		return &category.DeleteCategoryResponse{
+			Base: utils.NotFoundResponse("Category not found"),
+		}, nil
+	}
+
+	childCount, err := cs.categoryRepository.CountCategoryChildren(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if childCount > 0 {
+		return &category.DeleteCategoryResponse{
+			Base: utils.BadRequestResponse("Category still has sub categories"),
+		}, nil
+	}
+
+	// trashed products count too, restoring one must not bring back a
+	// product in a deleted category
+	productCount, err := cs.categoryRepository.CountCategoryProducts(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if productCount > 0 {
+		return &category.DeleteCategoryResponse{
+			Base: utils.BadRequestResponse("Category still has products, including products in the trash"),
+		}, nil
+	}
+
+	err = cs.categoryRepository.DeleteCategory(ctx, request.Id, time.Now(), claims.Fullname)
+	if err != nil {
+		return nil, err
+	}
+
+	return &category.DeleteCategoryResponse{
+		Base: utils.SuccessResponse("Delete Category Success"),
+	}, nil
+}
+
+func (cs *categoryService) ListCategory(ctx context.Context, request *category.ListCategoryRequest) (*category.ListCategoryResponse, error) {
+	categories, err := cs.categoryRepository.GetCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	itemMap := make(map[string]*category.ListCategoryResponseItem)
+	for _, categoryEntity := range categories {
+		parentId := ""
+		if categoryEntity.ParentId != nil {
+			parentId = *categoryEntity.ParentId
+		}
+
+		itemMap[categoryEntity.Id] = &category.ListCategoryResponseItem{
+			Id:       categoryEntity.Id,
+			Name:     categoryEntity.Name,
+			ParentId: parentId,
+			Children: make([]*category.ListCategoryResponseItem, 0),
+		}
+	}
+
+	data := make([]*category.ListCategoryResponseItem, 0)
+	for _, categoryEntity := range categories {
+		item := itemMap[categoryEntity.Id]
+		parent, ok := itemMap[item.ParentId]
+		if !ok {
+			data = append(data, item)
+			continue
+		}
+
+		parent.Children = append(parent.Children, item)
+	}
+
+	return &category.ListCategoryResponse{
+		Base: utils.SuccessResponse("Get List Category Success"),
+		Data: data,
+	}, nil
+}
+
+func (cs *categoryService) CreateCategoryAttribute(ctx context.Context, request *category.CreateCategoryAttributeRequest) (*category.CreateCategoryAttributeResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	categoryEntity, err := cs.categoryRepository.GetCategoryById(ctx, request.CategoryId)
+	if err != nil {
+		return nil, err
+	}
+	if categoryEntity == nil {
+		return &category.CreateCategoryAttributeResponse{
+			Base: utils.NotFoundResponse("Category not found"),
+		}, nil
+	}
+
+	// products are filtered by code across categories, so a code always
+	// holds the same type of value
+	sameCodeAttributes, err := cs.categoryRepository.GetCategoryAttributesByCode(ctx, request.Code)
+	if err != nil {
+		return nil, err
+	}
+	for _, attribute := range sameCodeAttributes {
+		if attribute.Type != request.Type {
+			return &category.CreateCategoryAttributeResponse{
+				Base: utils.BadRequestResponse(fmt.Sprintf("Attribute %s is already used for %s values", request.Code, attribute.Type)),
+			}, nil
+		}
+	}
+
+	ancestors, err := cs.categoryRepository.GetCategoryAncestors(ctx, categoryEntity.Id)
+	if err != nil {
+		return nil, err
+	}
+	descendantIds, err := cs.categoryRepository.GetCategoryDescendantIds(ctx, categoryEntity.Id)
+	if err != nil {
+		return nil, err
+	}
+	for _, attribute := range sameCodeAttributes {
+		isAncestor := slices.ContainsFunc(ancestors, func(ancestor *entity.Category) bool {
+			return ancestor.Id == attribute.CategoryId
+		})
+		if isAncestor || slices.Contains(descendantIds, attribute.CategoryId) {
+			return &category.CreateCategoryAttributeResponse{
+				Base: utils.BadRequestResponse(fmt.Sprintf("Attribute %s already exists in this category, its parents or its sub categories", request.Code)),
+			}, nil
+		}
+	}
+
+	attributeEntity := entity.CategoryAttribute{
+		Id:         uuid.NewString(),
+		CategoryId: categoryEntity.Id,
+		Code:       request.Code,
+		Name:       request.Name,
+		Type:       request.Type,
+		Unit:       optionalString(request.Unit),
+		CreatedAt:  time.Now(),
+		CreatedBy:  claims.Fullname,
+	}
+	err = cs.categoryRepository.CreateCategoryAttribute(ctx, &attributeEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &category.CreateCategoryAttributeResponse{
+		Base: utils.SuccessResponse("Category attribute is created"),
+		Id:   attributeEntity.Id,
+	}, nil
+}
+
+func (cs *categoryService) EditCategoryAttribute(ctx context.Context, request *category.EditCategoryAttributeRequest) (*category.EditCategoryAttributeResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	attributeEntity, err := cs.categoryRepository.GetCategoryAttributeById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if attributeEntity == nil {
+		return &category.EditCategoryAttributeResponse{
+			Base: utils.NotFoundResponse("Category attribute not found"),
+		}, nil
+	}
+
+	now := time.Now()
+	attributeEntity.Name = request.Name
+	attributeEntity.Unit = optionalString(request.Unit)
+	attributeEntity.UpdatedAt = &now
+	attributeEntity.UpdatedBy = &claims.Fullname
+
+	err = cs.categoryRepository.UpdateCategoryAttribute(ctx, attributeEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &category.EditCategoryAttributeResponse{
+		Base: utils.SuccessResponse("Edit Category Attribute Success"),
+		Id:   attributeEntity.Id,
+	}, nil
+}
+
+func (cs *categoryService) DeleteCategoryAttribute(ctx context.Context, request *category.DeleteCategoryAttributeRequest) (*category.DeleteCategoryAttributeResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	attributeEntity, err := cs.categoryRepository.GetCategoryAttributeById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if attributeEntity == nil {
+		return &category.DeleteCategoryAttributeResponse{
+			Base: utils.NotFoundResponse("Category attribute not found"),
+		}, nil
+	}
+
+	err = cs.categoryRepository.DeleteCategoryAttribute(ctx, request.Id, time.Now(), claims.Fullname)
+	if err != nil {
+		return nil, err
+	}
+
+	return &category.DeleteCategoryAttributeResponse{
+		Base: utils.SuccessResponse("Delete Category Attribute Success"),
+	}, nil
+}
+
+// ListCategoryAttribute lists the attributes products of the category can
+// have, its own and those of its parents.
+func (cs *categoryService) ListCategoryAttribute(ctx context.Context, request *category.ListCategoryAttributeRequest) (*category.ListCategoryAttributeResponse, error) {
+	ancestors, err := cs.categoryRepository.GetCategoryAncestors(ctx, request.CategoryId)
+	if err != nil {
+		return nil, err
+	}
+	if len(ancestors) == 0 {
+		return &category.ListCategoryAttributeResponse{
+			Base: utils.NotFoundResponse("Category not found"),
+		}, nil
+	}
+
+	ancestorIds := make([]string, 0)
+	for _, ancestor := range ancestors {
+		ancestorIds = append(ancestorIds, ancestor.Id)
+	}
+
+	attributes, err := cs.categoryRepository.GetCategoryAttributesByCategoryIds(ctx, ancestorIds)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]*category.ListCategoryAttributeResponseItem, 0)
+	for _, attribute := range attributes {
+		unit := ""
+		if attribute.Unit != nil {
+			unit = *attribute.Unit
+		}
+
+		data = append(data, &category.ListCategoryAttributeResponseItem{
+			Id:         attribute.Id,
+			CategoryId: attribute.CategoryId,
+			Code:       attribute.Code,
+			Name:       attribute.Name,
+			Type:       attribute.Type,
+			Unit:       unit,
+		})
+	}
+
+	return &category.ListCategoryAttributeResponse{
+		Base: utils.SuccessResponse("Get List Category Attribute Success"),
+		Data: data,
+	}, nil
+}
+
+// attributeCodeConflict returns a code used by an attribute of one of
+// upperIds as well as one of lowerIds, or an empty string when there is none.
+func (cs *categoryService) attributeCodeConflict(ctx context.Context, upperIds []string, lowerIds []string) (string, error) {
+	upperAttributes, err := cs.categoryRepository.GetCategoryAttributesByCategoryIds(ctx, upperIds)
+	if err != nil {
+		return "", err
+	}
+	lowerAttributes, err := cs.categoryRepository.GetCategoryAttributesByCategoryIds(ctx, lowerIds)
+	if err != nil {
+		return "", err
+	}
+
+	for _, upperAttribute := range upperAttributes {
+		for _, lowerAttribute := range lowerAttributes {
+			if upperAttribute.Code == lowerAttribute.Code {
+				return upperAttribute.Code, nil
+			}
+		}
+	}
+
+	return "", nil
+}
+
+func NewCategoryService(categoryRepository repository.ICategoryRepository) ICategoryService {
+	return &categoryService{
+		categoryRepository: categoryRepository,
+	}
+}
@@ -0,0 +1,280 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/xryar/golang-grpc-ecommerce/internal/entity"
+	jwtentity "github.com/xryar/golang-grpc-ecommerce/internal/entity/jwt"
+	"github.com/xryar/golang-grpc-ecommerce/internal/repository"
+	"github.com/xryar/golang-grpc-ecommerce/internal/utils"
+	"github.com/xryar/golang-grpc-ecommerce/pb/collection"
+)
+
+type ICollectionService interface {
+	CreateCollection(ctx context.Context, request *collection.CreateCollectionRequest) (*collection.CreateCollectionResponse, error)
+	EditCollection(ctx context.Context, request *collection.EditCollectionRequest) (*collection.EditCollectionResponse, error)
+	DeleteCollection(ctx context.Context, request *collection.DeleteCollectionRequest) (*collection.DeleteCollectionResponse, error)
+	ListCollection(ctx context.Context, request *collection.ListCollectionRequest) (*collection.ListCollectionResponse, error)
+	SetCollectionProducts(ctx context.Context, request *collection.SetCollectionProductsRequest) (*collection.SetCollectionProductsResponse, error)
+}
+
+type collectionService struct {
+	db                   *sql.DB
+	collectionRepository repository.ICollectionRepository
+	productRepository    repository.IProductRepository
+}
+
+func (cs *collectionService) CreateCollection(ctx context.Context, request *collection.CreateCollectionRequest) (*collection.CreateCollectionResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	startsAt := optionalTime(request.StartsAt)
+	endsAt := optionalTime(request.EndsAt)
+	if startsAt != nil && endsAt != nil && !endsAt.After(*startsAt) {
+		return &collection.CreateCollectionResponse{
+			Base: utils.BadRequestResponse("Collection end must be after its start"),
+		}, nil
+	}
+
+	keyCollection, err := cs.collectionRepository.GetCollectionByKey(ctx, request.Key)
+	if err != nil {
+		return nil, err
+	}
+	if keyCollection != nil {
+		return &collection.CreateCollectionResponse{
+			Base: utils.BadRequestResponse("Collection key already exists"),
+		}, nil
+	}
+
+	collectionEntity := entity.Collection{
+		Id:           uuid.NewString(),
+		Key:          request.Key,
+		Name:         request.Name,
+		RuleType:     request.RuleType,
+		RuleDays:     request.RuleDays,
+		ProductLimit: request.ProductLimit,
+		StartsAt:     startsAt,
+		EndsAt:       endsAt,
+		CreatedAt:    time.Now(),
+		CreatedBy:    claims.Fullname,
+	}
+	err = cs.collectionRepository.CreateNewCollection(ctx, &collectionEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &collection.CreateCollectionResponse{
+		Base: utils.SuccessResponse("Collection is created"),
+		Id:   collectionEntity.Id,
+	}, nil
+}
+
+func (cs *collectionService) EditCollection(ctx context.Context, request *collection.EditCollectionRequest) (*collection.EditCollectionResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	collectionEntity, err := cs.collectionRepository.GetCollectionById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if collectionEntity == nil {
+		return &collection.EditCollectionResponse{
+			Base: utils.NotFoundResponse("Collection not found"),
+		}, nil
+	}
+
+	startsAt := optionalTime(request.StartsAt)
+	endsAt := optionalTime(request.EndsAt)
+	if startsAt != nil && endsAt != nil && !endsAt.After(*startsAt) {
+		return &collection.EditCollectionResponse{
+			Base: utils.BadRequestResponse("Collection end must be after its start"),
+		}, nil
+	}
+
+	keyCollection, err := cs.collectionRepository.GetCollectionByKey(ctx, request.Key)
+	if err != nil {
+		return nil, err
+	}
+	if keyCollection != nil && keyCollection.Id != collectionEntity.Id {
+		return &collection.EditCollectionResponse{
+			Base: utils.BadRequestResponse("Collection key already exists"),
+		}, nil
+	}
+
+	now := time.Now()
+	collectionEntity.Key = request.Key
+	collectionEntity.Name = request.Name
+	collectionEntity.RuleType = request.RuleType
+	collectionEntity.RuleDays = request.RuleDays
+	collectionEntity.ProductLimit = request.ProductLimit
+	collectionEntity.StartsAt = startsAt
+	collectionEntity.EndsAt = endsAt
+	collectionEntity.UpdatedAt = &now
+	collectionEntity.UpdatedBy = &claims.Fullname
+	err = cs.collectionRepository.UpdateCollection(ctx, collectionEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &collection.EditCollectionResponse{
+		Base: utils.SuccessResponse("Edit Collection Success"),
+		Id:   collectionEntity.Id,
+	}, nil
+}
+
+func (cs *collectionService) DeleteCollection(ctx context.Context, request *collection.DeleteCollectionRequest) (*collection.DeleteCollectionResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	collectionEntity, err := cs.collectionRepository.GetCollectionById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if collectionEntity == nil {
+		return &collection.DeleteCollectionResponse{
+			Base: utils.NotFoundResponse("Collection not found"),
+		}, nil
+	}
+
+	err = cs.collectionRepository.DeleteCollection(ctx, collectionEntity.Id, time.Now(), claims.Fullname)
+	if err != nil {
+		return nil, err
+	}
+
+	return &collection.DeleteCollectionResponse{
+		Base: utils.SuccessResponse("Delete Collection Success"),
+	}, nil
+}
+
+func (cs *collectionService) ListCollection(ctx context.Context, request *collection.ListCollectionRequest) (*collection.ListCollectionResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	collections, err := cs.collectionRepository.GetCollections(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	data := make([]*collection.ListCollectionResponseItem, 0)
+	for _, collectionEntity := range collections {
+		data = append(data, &collection.ListCollectionResponseItem{
+			Id:           collectionEntity.Id,
+			Key:          collectionEntity.Key,
+			Name:         collectionEntity.Name,
+			RuleType:     collectionEntity.RuleType,
+			RuleDays:     collectionEntity.RuleDays,
+			ProductLimit: collectionEntity.ProductLimit,
+			StartsAt:     optionalTimestamp(collectionEntity.StartsAt),
+			EndsAt:       optionalTimestamp(collectionEntity.EndsAt),
+			ProductIds:   collectionEntity.ProductIds,
+			IsActive:     collectionIsActive(collectionEntity, now),
+		})
+	}
+
+	return &collection.ListCollectionResponse{
+		Base: utils.SuccessResponse("Get List Collection Success"),
+		Data: data,
+	}, nil
+}
+
+func (cs *collectionService) SetCollectionProducts(ctx context.Context, request *collection.SetCollectionProductsRequest) (*collection.SetCollectionProductsResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	collectionEntity, err := cs.collectionRepository.GetCollectionById(ctx, request.CollectionId)
+	if err != nil {
+		return nil, err
+	}
+	if collectionEntity == nil {
+		return &collection.SetCollectionProductsResponse{
+			Base: utils.NotFoundResponse("Collection not found"),
+		}, nil
+	}
+
+	if len(request.ProductIds) > 0 {
+		products, err := cs.productRepository.GetProductsByIds(ctx, request.ProductIds)
+		if err != nil {
+			return nil, err
+		}
+		if len(products) != len(request.ProductIds) {
+			return &collection.SetCollectionProductsResponse{
+				Base: utils.BadRequestResponse("Some products are not found"),
+			}, nil
+		}
+	}
+
+	tx, err := cs.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	err = cs.collectionRepository.WithTransaction(tx).SetCollectionProducts(ctx, collectionEntity.Id, request.ProductIds)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return &collection.SetCollectionProductsResponse{
+		Base: utils.SuccessResponse("Set Collection Products Success"),
+	}, nil
+}
+
+// collectionIsActive reports whether the scheduling window of a collection
+// contains now. The start is inclusive and the end exclusive.
+func collectionIsActive(collectionEntity *entity.Collection, now time.Time) bool {
+	if collectionEntity.StartsAt != nil && now.Before(*collectionEntity.StartsAt) {
+		return false
+	}
+	if collectionEntity.EndsAt != nil && !now.Before(*collectionEntity.EndsAt) {
+		return false
+	}
+
+	return true
+}
+
+func NewCollectionService(db *sql.DB, collectionRepository repository.ICollectionRepository, productRepository repository.IProductRepository) ICollectionService {
+	return &collectionService{
+		db:                   db,
+		collectionRepository: collectionRepository,
+		productRepository:    productRepository,
+	}
+}
@@ -0,0 +1,216 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/xryar/golang-grpc-ecommerce/internal/entity"
+	jwtentity "github.com/xryar/golang-grpc-ecommerce/internal/entity/jwt"
+	"github.com/xryar/golang-grpc-ecommerce/internal/repository"
+	"github.com/xryar/golang-grpc-ecommerce/internal/utils"
+	"github.com/xryar/golang-grpc-ecommerce/pb/currency"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// currencyMetadataKey is the metadata clients can pick the display currency
+// with when the request has no currency field set.
+const currencyMetadataKey = "x-currency"
+
+var errCurrencyNotAvailable = errors.New("currency is not available")
+
+type ICurrencyService interface {
+	CreateExchangeRate(ctx context.Context, request *currency.CreateExchangeRateRequest) (*currency.CreateExchangeRateResponse, error)
+	ListExchangeRate(ctx context.Context, request *currency.ListExchangeRateRequest) (*currency.ListExchangeRateResponse, error)
+	DeleteExchangeRate(ctx context.Context, request *currency.DeleteExchangeRateRequest) (*currency.DeleteExchangeRateResponse, error)
+}
+
+type currencyService struct {
+	exchangeRateRepository repository.IExchangeRateRepository
+}
+
+func (cs *currencyService) CreateExchangeRate(ctx context.Context, request *currency.CreateExchangeRateRequest) (*currency.CreateExchangeRateResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	now := time.Now()
+	effectiveAt := now
+	if request.EffectiveAt != nil {
+		effectiveAt = request.EffectiveAt.AsTime()
+	}
+
+	exchangeRateEntity := entity.ExchangeRate{
+		Id:           uuid.NewString(),
+		CurrencyCode: request.CurrencyCode,
+		Rate:         request.Rate,
+		EffectiveAt:  effectiveAt,
+		CreatedAt:    now,
+		CreatedBy:    claims.Fullname,
+	}
+	err = cs.exchangeRateRepository.CreateExchangeRate(ctx, &exchangeRateEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &currency.CreateExchangeRateResponse{
+		Base: utils.SuccessResponse("Exchange rate is created"),
+		Id:   exchangeRateEntity.Id,
+	}, nil
+}
+
+func (cs *currencyService) ListExchangeRate(ctx context.Context, request *currency.ListExchangeRateRequest) (*currency.ListExchangeRateResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	exchangeRates, err := cs.exchangeRateRepository.GetExchangeRates(ctx, request.CurrencyCode)
+	if err != nil {
+		return nil, err
+	}
+
+	// rates come latest effective first, so the first one already in effect
+	// is the current one of its currency
+	now := time.Now()
+	hasCurrent := make(map[string]bool)
+	data := make([]*currency.ListExchangeRateResponseItem, 0)
+	for _, exchangeRate := range exchangeRates {
+		isCurrent := !hasCurrent[exchangeRate.CurrencyCode] && !exchangeRate.EffectiveAt.After(now)
+		if isCurrent {
+			hasCurrent[exchangeRate.CurrencyCode] = true
+		}
+
+		data = append(data, &currency.ListExchangeRateResponseItem{
+			Id:           exchangeRate.Id,
+			CurrencyCode: exchangeRate.CurrencyCode,
+			Rate:         exchangeRate.Rate,
+			EffectiveAt:  timestamppb.New(exchangeRate.EffectiveAt),
+			IsCurrent:    isCurrent,
+			CreatedAt:    timestamppb.New(exchangeRate.CreatedAt),
+			CreatedBy:    exchangeRate.CreatedBy,
+		})
+	}
+
+	return &currency.ListExchangeRateResponse{
+		Base: utils.SuccessResponse("Get List Exchange Rate Success"),
+		Data: data,
+	}, nil
+}
+
+func (cs *currencyService) DeleteExchangeRate(ctx context.Context, request *currency.DeleteExchangeRateRequest) (*currency.DeleteExchangeRateResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	exchangeRateEntity, err := cs.exchangeRateRepository.GetExchangeRateById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if exchangeRateEntity == nil {
+		return &currency.DeleteExchangeRateResponse{
+			Base: utils.NotFoundResponse("Exchange rate not found"),
+		}, nil
+	}
+
+	err = cs.exchangeRateRepository.DeleteExchangeRate(ctx, request.Id, time.Now(), claims.Fullname)
+	if err != nil {
+		return nil, err
+	}
+
+	return &currency.DeleteExchangeRateResponse{
+		Base: utils.SuccessResponse("Delete Exchange Rate Success"),
+	}, nil
+}
+
+// priceConverter converts IDR prices into the currency a customer sees them
+// in.
+type priceConverter struct {
+	currency string
+	// how many IDR one unit of currency is worth
+	rate     float64
+	decimals int
+}
+
+// ToIDR converts a price in the currency back into IDR, unrounded.
+func (pc *priceConverter) ToIDR(price float64) float64 {
+	return price * pc.rate
+}
+
+func (pc *priceConverter) Convert(price float64) float64 {
+	if pc.currency == entity.CurrencyIDR {
+		return price
+	}
+
+	// the quotient is cut to a few extra decimals first so float noise like
+	// 12.4949999 does not round down a price that really is 12.495
+	scale := math.Pow10(pc.decimals)
+	extraScale := math.Pow10(pc.decimals + 6)
+	converted := math.Round(price/pc.rate*extraScale) / extraScale
+	return math.Round(converted*scale) / scale
+}
+
+// displayCurrency is the currency a request wants prices in, its currency
+// field first, then the x-currency metadata and IDR otherwise.
+func displayCurrency(ctx context.Context, requested string) string {
+	if requested != "" {
+		return requested
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok {
+		values := md.Get(currencyMetadataKey)
+		if len(values) > 0 && strings.TrimSpace(values[0]) != "" {
+			return strings.ToUpper(strings.TrimSpace(values[0]))
+		}
+	}
+
+	return entity.CurrencyIDR
+}
+
+// newPriceConverter converts with the rate of currencyCode effective at at.
+// It returns errCurrencyNotAvailable for currencies that are not supported or
+// have no rate yet.
+func newPriceConverter(ctx context.Context, exchangeRateRepository repository.IExchangeRateRepository, currencyCode string, at time.Time) (*priceConverter, error) {
+	decimals, ok := entity.CurrencyDecimals[currencyCode]
+	if !ok {
+		return nil, errCurrencyNotAvailable
+	}
+	if currencyCode == entity.CurrencyIDR {
+		return &priceConverter{currency: currencyCode, rate: 1, decimals: decimals}, nil
+	}
+
+	exchangeRate, err := exchangeRateRepository.GetEffectiveExchangeRate(ctx, currencyCode, at)
+	if err != nil {
+		return nil, err
+	}
+	if exchangeRate == nil {
+		return nil, errCurrencyNotAvailable
+	}
+
+	return &priceConverter{currency: currencyCode, rate: exchangeRate.Rate, decimals: decimals}, nil
+}
+
+func NewCurrencyService(exchangeRateRepository repository.IExchangeRateRepository) ICurrencyService {
+	return &currencyService{
+		exchangeRateRepository: exchangeRateRepository,
+	}
+}
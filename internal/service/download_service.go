@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/xryar/golang-grpc-ecommerce/internal/downloadlink"
+	"github.com/xryar/golang-grpc-ecommerce/internal/repository"
+	"github.com/xryar/golang-grpc-ecommerce/internal/storage"
+)
+
+var (
+	ErrDownloadNotFound     = errors.New("download not found")
+	ErrDownloadLimitReached = errors.New("download limit reached")
+)
+
+// DownloadFile is an opened digital product file, Name is what the buyer
+// saves it as.
+type DownloadFile struct {
+	Name   string
+	Reader io.ReadCloser
+}
+
+type IDownloadService interface {
+	OpenDownload(ctx context.Context, entitlementId string, expires string, signature string) (*DownloadFile, error)
+}
+
+type downloadService struct {
+	downloadEntitlementRepository repository.IDownloadEntitlementRepository
+	digitalStorage                storage.IStorage
+	downloadSigner                *downloadlink.Signer
+}
+
+// OpenDownload checks a signed download link and uses one download of its
+// entitlement. Links that are not valid fail with the downloadlink errors.
+func (ds *downloadService) OpenDownload(ctx context.Context, entitlementId string, expires string, signature string) (*DownloadFile, error) {
+	now := time.Now()
+	err := ds.downloadSigner.Verify(entitlementId, expires, signature, now)
+	if err != nil {
+		return nil, err
+	}
+
+	entitlement, err := ds.downloadEntitlementRepository.GetDownloadEntitlementById(ctx, entitlementId)
+	if err != nil {
+		return nil, err
+	}
+	if entitlement == nil {
+		return nil, ErrDownloadNotFound
+	}
+
+	// the file is opened first so a missing file does not use a download
+	file, err := ds.digitalStorage.Open(ctx, entitlement.FileName)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, ErrDownloadNotFound
+		}
+
+		return nil, err
+	}
+
+	used, err := ds.downloadEntitlementRepository.UseDownloadEntitlement(ctx, entitlement.Id, now)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	if !used {
+		file.Close()
+		return nil, ErrDownloadLimitReached
+	}
+
+	return &DownloadFile{
+		Name:   downloadFileName(entitlement.ProductName, entitlement.FileName),
+		Reader: file,
+	}, nil
+}
+
+// downloadFileName names the download after the product, keeping the
+// extension of the stored file.
+func downloadFileName(productName string, fileName string) string {
+	name := strings.Map(func(r rune) rune {
+		if r == '/' || r == '\\' || r == '"' || unicode.IsControl(r) {
+			return '-'
+		}
+
+		return r
+	}, strings.TrimSpace(productName))
+	if name == "" {
+		name = "download"
+	}
+
+	return name + path.Ext(fileName)
+}
+
+func NewDownloadService(downloadEntitlementRepository repository.IDownloadEntitlementRepository, digitalStorage storage.IStorage, downloadSigner *downloadlink.Signer) IDownloadService {
+	return &downloadService{
+		downloadEntitlementRepository: downloadEntitlementRepository,
+		digitalStorage:                digitalStorage,
+		downloadSigner:                downloadSigner,
+	}
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"time"
+
+	"github.com/xendit/xendit-go"
+	"github.com/xendit/xendit-go/invoice"
+	"github.com/xryar/golang-grpc-ecommerce/internal/dto"
+	"github.com/xryar/golang-grpc-ecommerce/internal/entity"
+	"github.com/xryar/golang-grpc-ecommerce/internal/repository"
+)
+
+const orderExpiryBatchSize = 100
+
+type IOrderExpiryService interface {
+	ExpireOrders(ctx context.Context) error
+}
+
+type orderExpiryService struct {
+	db                *sql.DB
+	orderRepository   repository.IOrderRepository
+	productRepository repository.IProductRepository
+	webhookService    IWebhookService
+}
+
+func (es *orderExpiryService) ExpireOrders(ctx context.Context) error {
+	orderIds, err := es.orderRepository.GetExpiredUnpaidOrderIds(ctx, time.Now(), orderExpiryBatchSize)
+	if err != nil {
+		return err
+	}
+
+	expiredCount := 0
+	for _, orderId := range orderIds {
+		expired, err := es.expireOrder(ctx, orderId)
+		if err != nil {
+			log.Printf("Failed to expire order %s: %v", orderId, err)
+
+			err = es.orderRepository.DeferOrderExpiry(ctx, orderId, time.Now())
+			if err != nil {
+				log.Printf("Failed to defer expiring order %s: %v", orderId, err)
+			}
+			continue
+		}
+		if expired {
+			expiredCount++
+		}
+	}
+
+	if expiredCount > 0 {
+		log.Printf("Expired %d unpaid orders", expiredCount)
+	}
+
+	return nil
+}
+
+func (es *orderExpiryService) expireOrder(ctx context.Context, orderId string) (bool, error) {
+	orderEntity, err := es.orderRepository.GetOrderById(ctx, orderId)
+	if err != nil || orderEntity == nil || orderEntity.OrderStatusCode != entity.OrderStatusCodeUnpaid {
+		return false, err
+	}
+
+	// the invoice is expired before the order row is locked, so no lock is
+	// held while waiting on Xendit
+	if orderEntity.XenditInvoiceId != nil {
+		paidInvoice, err := expireXenditInvoice(ctx, *orderEntity.XenditInvoiceId)
+		if err != nil {
+			return false, err
+		}
+		if paidInvoice != nil {
+			// the paid callback was missed or has not arrived yet
+			return false, es.webhookService.ReceiveInvoice(ctx, &dto.XenditInvoiceRequest{
+				ExternalID:     orderEntity.Id,
+				Status:         paidInvoice.Status,
+				PaymentMethod:  paidInvoice.PaymentMethod,
+				PaymentChannel: paidInvoice.PaymentChannel,
+			})
+		}
+	}
+
+	tx, err := es.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	// another replica holds the row or the order is no longer unpaid
+	locked, err := es.orderRepository.WithTransaction(tx).LockOrderWithStatus(ctx, orderId, entity.OrderStatusCodeUnpaid)
+	if err != nil || !locked {
+		return false, err
+	}
+
+	now := time.Now()
+	updatedBy := "System"
+	orderEntity.OrderStatusCode = entity.OrderStatusCodeExpired
+	orderEntity.UpdatedAt = &now
+	orderEntity.UpdatedBy = &updatedBy
+
+	released, err := releaseOrderStockWithTransaction(ctx, tx, es.orderRepository, es.productRepository, orderEntity, entity.OrderStatusCodeUnpaid)
+	if err != nil || !released {
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// expireXenditInvoice expires the invoice on Xendit. It returns the invoice
+// when it has been paid in the meantime and can no longer be expired.
+func expireXenditInvoice(ctx context.Context, invoiceId string) (*xendit.Invoice, error) {
+	_, xenditErr := invoice.ExpireWithContext(ctx, &invoice.ExpireParams{
+		ID: invoiceId,
+	})
+	if xenditErr == nil {
+		return nil, nil
+	}
+
+	xenditInvoice, getErr := invoice.GetWithContext(ctx, &invoice.GetParams{
+		ID: invoiceId,
+	})
+	if getErr != nil {
+		return nil, xenditErr
+	}
+
+	switch xenditInvoice.Status {
+	case xenditInvoiceStatusExpired:
+		return nil, nil
+	case xenditInvoiceStatusPaid, xenditInvoiceStatusSettled:
+		return xenditInvoice, nil
+	}
+
+	return nil, xenditErr
+}
+
+func NewOrderExpiryService(db *sql.DB, orderRepository repository.IOrderRepository, productRepository repository.IProductRepository, webhookService IWebhookService) IOrderExpiryService {
+	return &orderExpiryService{
+		db:                db,
+		orderRepository:   orderRepository,
+		productRepository: productRepository,
+		webhookService:    webhookService,
+	}
+}
@@ -3,14 +3,19 @@ package service
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"maps"
+	"math"
 	operatingSystem "os"
 	"runtime/debug"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/xendit/xendit-go"
 	"github.com/xendit/xendit-go/invoice"
+	"github.com/xryar/golang-grpc-ecommerce/internal/downloadlink"
 	"github.com/xryar/golang-grpc-ecommerce/internal/entity"
 	jwtentity "github.com/xryar/golang-grpc-ecommerce/internal/entity/jwt"
 	"github.com/xryar/golang-grpc-ecommerce/internal/repository"
@@ -25,12 +30,16 @@ type IOrderService interface {
 	ListOrder(ctx context.Context, request *order.ListOrderRequest) (*order.ListOrderResponse, error)
 	DetailOrder(ctx context.Context, request *order.DetailOrderRequest) (*order.DetailOrderResponse, error)
 	UpdateOrderStatus(ctx context.Context, request *order.UpdateOrderStatusRequest) (*order.UpdateOrderStatusResponse, error)
+	ListOrderDownload(ctx context.Context, request *order.ListOrderDownloadRequest) (*order.ListOrderDownloadResponse, error)
 }
 
 type orderService struct {
-	db                *sql.DB
-	orderRepository   repository.IOrderRepository
-	productRepository repository.IProductRepository
+	db                            *sql.DB
+	orderRepository               repository.IOrderRepository
+	productRepository             repository.IProductRepository
+	exchangeRateRepository        repository.IExchangeRateRepository
+	downloadEntitlementRepository repository.IDownloadEntitlementRepository
+	downloadSigner                *downloadlink.Signer
 }
 
 func (os *orderService) CreateOrder(ctx context.Context, request *order.CreateOrderRequest) (*order.CreateOrderResponse, error) {
@@ -39,6 +48,18 @@ func (os *orderService) CreateOrder(ctx context.Context, request *order.CreateOr
 		return nil, err
 	}
 
+	// the order is paid in IDR, the rate the customer saw prices with is kept
+	// on the order
+	converter, err := newPriceConverter(ctx, os.exchangeRateRepository, displayCurrency(ctx, request.Currency), time.Now())
+	if errors.Is(err, errCurrencyNotAvailable) {
+		return &order.CreateOrderResponse{
+			Base: utils.BadRequestResponse("Currency is not available"),
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
 	tx, err := os.db.Begin()
 	if err != nil {
 		return nil, err
@@ -78,18 +99,149 @@ func (os *orderService) CreateOrder(ctx context.Context, request *order.CreateOr
 	}
 
 	productMap := make(map[string]*entity.Product)
+	bundleIds := make([]string, 0)
 	for i := range products {
 		productMap[products[i].Id] = products[i]
+		if products[i].Type == entity.ProductTypeBundle {
+			bundleIds = append(bundleIds, products[i].Id)
+		}
+	}
+
+	// a bundle is reserved and ordered as its components
+	bundleItemsMap := make(map[string][]*entity.ProductBundleItem)
+	if len(bundleIds) > 0 {
+		var bundleItems []*entity.ProductBundleItem
+		bundleItems, err = productRepo.GetProductBundleItems(ctx, bundleIds)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, bundleItem := range bundleItems {
+			bundleItemsMap[bundleItem.BundleProductId] = append(bundleItemsMap[bundleItem.BundleProductId], bundleItem)
+			if productMap[bundleItem.ProductId] == nil {
+				productMap[bundleItem.ProductId] = bundleItem.Product
+			}
+		}
+	}
+
+	variantIds := make([]string, 0)
+	for _, p := range request.Products {
+		if p.VariantId != "" {
+			variantIds = append(variantIds, p.VariantId)
+		}
+	}
+
+	variantMap := make(map[string]*entity.ProductVariant)
+	if len(variantIds) > 0 {
+		var variants []*entity.ProductVariant
+		variants, err = productRepo.GetProductVariantsByIds(ctx, variantIds)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range variants {
+			variantMap[variants[i].Id] = variants[i]
+		}
 	}
 
+	// every line is priced at the same moment so a sale ending mid checkout
+	// can not give different prices for the total and the items
+	pricedAt := time.Now()
 	var total float64 = 0
+	productQuantities := make(map[string]int64)
+	variantQuantities := make(map[string]int64)
+	orderQuantities := make(map[string]int64)
 	for _, p := range request.Products {
 		if productMap[p.Id] == nil {
+			tx.Rollback()
 			return &order.CreateOrderResponse{
 				Base: utils.NotFoundResponse(fmt.Sprintf("Product %s not found", p.Id)),
 			}, nil
 		}
-		total += productMap[p.Id].Price * float64(p.Quantity)
+		if !productIsPublished(productMap[p.Id], pricedAt) {
+			tx.Rollback()
+			return &order.CreateOrderResponse{
+				Base: utils.BadRequestResponse(fmt.Sprintf("Product %s is not available", productMap[p.Id].Name)),
+			}, nil
+		}
+
+		variant := variantMap[p.VariantId]
+		if p.VariantId != "" {
+			if variant == nil || variant.ProductId != p.Id {
+				tx.Rollback()
+				return &order.CreateOrderResponse{
+					Base: utils.NotFoundResponse(fmt.Sprintf("Product variant %s not found", p.VariantId)),
+				}, nil
+			}
+			variantQuantities[p.VariantId] += p.Quantity
+		} else if productMap[p.Id].Type == entity.ProductTypeBundle {
+			bundleItems := bundleItemsMap[p.Id]
+			incomplete := slices.ContainsFunc(bundleItems, func(bundleItem *entity.ProductBundleItem) bool {
+				return bundleItem.Product.IsDeleted
+			})
+			if len(bundleItems) == 0 || incomplete {
+				tx.Rollback()
+				return &order.CreateOrderResponse{
+					Base: utils.BadRequestResponse(fmt.Sprintf("Product %s is not available", productMap[p.Id].Name)),
+				}, nil
+			}
+
+			for _, bundleItem := range bundleItems {
+				productQuantities[bundleItem.ProductId] += bundleItem.Quantity * p.Quantity
+			}
+		} else if productMap[p.Id].HasVariants {
+			tx.Rollback()
+			return &order.CreateOrderResponse{
+				Base: utils.BadRequestResponse(fmt.Sprintf("Product %s requires a variant", productMap[p.Id].Name)),
+			}, nil
+		} else if productMap[p.Id].Type != entity.ProductTypeDigital {
+			// a digital product is never out of stock
+			productQuantities[p.Id] += p.Quantity
+		}
+
+		orderQuantities[p.Id] += p.Quantity
+		total += productVariantPrice(productMap[p.Id], variant, pricedAt) * float64(p.Quantity)
+	}
+
+	for _, productId := range slices.Sorted(maps.Keys(orderQuantities)) {
+		if message := productOrderQuantityMessage(productMap[productId], orderQuantities[productId]); message != "" {
+			tx.Rollback()
+			return &order.CreateOrderResponse{
+				Base: utils.BadRequestResponse(message),
+			}, nil
+		}
+	}
+
+	// reserve stock in a stable order so concurrent checkouts lock rows the same way
+	reservedIds := slices.Sorted(maps.Keys(productQuantities))
+	for _, productId := range reservedIds {
+		var reserved bool
+		reserved, err = productRepo.DecreaseProductStock(ctx, productId, productQuantities[productId])
+		if err != nil {
+			return nil, err
+		}
+		if !reserved {
+			tx.Rollback()
+			return &order.CreateOrderResponse{
+				Base: utils.BadRequestResponse(fmt.Sprintf("Insufficient stock for product %s", productMap[productId].Name)),
+			}, nil
+		}
+	}
+
+	reservedVariantIds := slices.Sorted(maps.Keys(variantQuantities))
+	for _, variantId := range reservedVariantIds {
+		var reserved bool
+		reserved, err = productRepo.DecreaseProductVariantStock(ctx, variantId, variantQuantities[variantId])
+		if err != nil {
+			return nil, err
+		}
+		if !reserved {
+			variant := variantMap[variantId]
+			tx.Rollback()
+			return &order.CreateOrderResponse{
+				Base: utils.BadRequestResponse(fmt.Sprintf("Insufficient stock for product %s (%s)", productMap[variant.ProductId].Name, variant.Sku)),
+			}, nil
+		}
 	}
 
 	now := time.Now()
@@ -107,15 +259,24 @@ func (os *orderService) CreateOrder(ctx context.Context, request *order.CreateOr
 		ExpiredAt:       &expiredAt,
 		CreatedAt:       now,
 		CreatedBy:       claims.Fullname,
+		Currency:        entity.CurrencyIDR,
+		DisplayCurrency: converter.currency,
+		ExchangeRate:    converter.rate,
 	}
 
 	invoiceItems := make([]xendit.InvoiceItem, 0)
 	for _, p := range request.Products {
 		prod := productMap[p.Id]
 		if prod != nil {
+			variant := variantMap[p.VariantId]
+			name := prod.Name
+			if variant != nil {
+				name = fmt.Sprintf("%s (%s)", prod.Name, formatVariantAttributes(variant.Attributes))
+			}
+
 			invoiceItems = append(invoiceItems, xendit.InvoiceItem{
-				Name:     prod.Name,
-				Price:    prod.Price,
+				Name:     name,
+				Price:    productVariantPrice(prod, variant, pricedAt),
 				Quantity: int(p.Quantity),
 			})
 		}
@@ -127,7 +288,7 @@ func (os *orderService) CreateOrder(ctx context.Context, request *order.CreateOr
 		Customer: xendit.InvoiceCustomer{
 			GivenNames: claims.Fullname,
 		},
-		Currency:           "IDR",
+		Currency:           orderEntity.Currency,
 		SuccessRedirectURL: fmt.Sprintf("%s/checkout/%s/success", operatingSystem.Getenv("FRONTEND_BASE_URL"), orderEntity.Id),
 		Items:              invoiceItems,
 	})
@@ -145,16 +306,56 @@ func (os *orderService) CreateOrder(ctx context.Context, request *order.CreateOr
 	}
 
 	for _, p := range request.Products {
+		prod := productMap[p.Id]
+		if prod.Type == entity.ProductTypeBundle {
+			bundleItems := bundleItemsMap[p.Id]
+			prices := bundleItemPrices(productVariantPrice(prod, nil, pricedAt), bundleItems, pricedAt)
+			for i, bundleItem := range bundleItems {
+				var orderItem = entity.OrderItem{
+					Id:                     uuid.NewString(),
+					ProductId:              bundleItem.ProductId,
+					ProductName:            bundleItem.Product.Name,
+					ProductImageFileName:   bundleItem.Product.ImageFileName,
+					ProductPrice:           prices[i],
+					ProductOriginalPrice:   productVariantOriginalPrice(bundleItem.Product, nil),
+					Quantity:               bundleItem.Quantity * p.Quantity,
+					OrderId:                orderEntity.Id,
+					BundleProductId:        &prod.Id,
+					BundleProductName:      &prod.Name,
+					ProductDigitalFileName: bundleItem.Product.DigitalFileName,
+					CreatedAt:              now,
+					CreatedBy:              claims.Fullname,
+				}
+				err = orderRepo.CreateOrderItem(ctx, &orderItem)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			continue
+		}
+
+		variant := variantMap[p.VariantId]
 		var orderItem = entity.OrderItem{
-			Id:                   uuid.NewString(),
-			ProductId:            p.Id,
-			ProductName:          productMap[p.Id].Name,
-			ProductImageFileName: productMap[p.Id].ImageFileName,
-			ProductPrice:         productMap[p.Id].Price,
-			Quantity:             p.Quantity,
-			OrderId:              orderEntity.Id,
-			CreatedAt:            now,
-			CreatedBy:            claims.Fullname,
+			Id:                     uuid.NewString(),
+			ProductId:              p.Id,
+			ProductName:            productMap[p.Id].Name,
+			ProductImageFileName:   productMap[p.Id].ImageFileName,
+			ProductPrice:           productVariantPrice(productMap[p.Id], variant, pricedAt),
+			ProductOriginalPrice:   productVariantOriginalPrice(productMap[p.Id], variant),
+			Quantity:               p.Quantity,
+			OrderId:                orderEntity.Id,
+			ProductDigitalFileName: productMap[p.Id].DigitalFileName,
+			CreatedAt:              now,
+			CreatedBy:              claims.Fullname,
+		}
+		if variant != nil {
+			orderItem.ProductVariantId = &variant.Id
+			orderItem.ProductVariantSku = &variant.Sku
+			orderItem.ProductVariantAttributes = variant.Attributes
+			if variant.ImageFileName != nil {
+				orderItem.ProductImageFileName = *variant.ImageFileName
+			}
 		}
 
 		err = orderRepo.CreateOrderItem(ctx, &orderItem)
@@ -191,6 +392,12 @@ func (os *orderService) ListOrderAdmin(ctx context.Context, request *order.ListO
 	}
 
 	orders, metadata, err := os.orderRepository.GetListOrderAdminPagination(ctx, request.Pagination)
+	var validationErr *repository.QueryValidationError
+	if errors.As(err, &validationErr) {
+		return &order.ListOrderAdminResponse{
+			Base: utils.ValidationErrorResponse(validationErr.ValidationErrors),
+		}, nil
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -200,10 +407,14 @@ func (os *orderService) ListOrderAdmin(ctx context.Context, request *order.ListO
 		products := make([]*order.ListOrderAdminResponseItemProducts, 0)
 		for _, io := range o.Items {
 			products = append(products, &order.ListOrderAdminResponseItemProducts{
-				Id:       io.ProductId,
-				Name:     io.ProductName,
-				Price:    io.ProductPrice,
-				Quantity: io.Quantity,
+				Id:                io.ProductId,
+				Name:              io.ProductName,
+				Price:             io.ProductPrice,
+				Quantity:          io.Quantity,
+				VariantId:         stringValue(io.ProductVariantId),
+				VariantSku:        stringValue(io.ProductVariantSku),
+				VariantAttributes: io.ProductVariantAttributes,
+				OriginalPrice:     io.ProductOriginalPrice,
 			})
 		}
 
@@ -237,6 +448,12 @@ func (os *orderService) ListOrder(ctx context.Context, request *order.ListOrderR
 	}
 
 	orders, metadata, err := os.orderRepository.GetListOrderPagination(ctx, request.Pagination, claims.Subject)
+	var validationErr *repository.QueryValidationError
+	if errors.As(err, &validationErr) {
+		return &order.ListOrderResponse{
+			Base: utils.ValidationErrorResponse(validationErr.ValidationErrors),
+		}, nil
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -246,10 +463,14 @@ func (os *orderService) ListOrder(ctx context.Context, request *order.ListOrderR
 		products := make([]*order.ListOrderResponseItemProducts, 0)
 		for _, io := range o.Items {
 			products = append(products, &order.ListOrderResponseItemProducts{
-				Id:       io.ProductId,
-				Name:     io.ProductName,
-				Price:    io.ProductPrice,
-				Quantity: io.Quantity,
+				Id:                io.ProductId,
+				Name:              io.ProductName,
+				Price:             io.ProductPrice,
+				Quantity:          io.Quantity,
+				VariantId:         stringValue(io.ProductVariantId),
+				VariantSku:        stringValue(io.ProductVariantSku),
+				VariantAttributes: io.ProductVariantAttributes,
+				OriginalPrice:     io.ProductOriginalPrice,
 			})
 		}
 
@@ -315,10 +536,17 @@ func (os *orderService) DetailOrder(ctx context.Context, request *order.DetailOr
 	items := make([]*order.DetailOrderResponseItem, 0)
 	for _, oi := range orderEntity.Items {
 		items = append(items, &order.DetailOrderResponseItem{
-			Id:       oi.ProductId,
-			Name:     oi.ProductName,
-			Price:    oi.ProductPrice,
-			Quantity: oi.Quantity,
+			Id:                oi.ProductId,
+			Name:              oi.ProductName,
+			Price:             oi.ProductPrice,
+			Quantity:          oi.Quantity,
+			VariantId:         stringValue(oi.ProductVariantId),
+			VariantSku:        stringValue(oi.ProductVariantSku),
+			VariantAttributes: oi.ProductVariantAttributes,
+			OriginalPrice:     oi.ProductOriginalPrice,
+			BundleId:          stringValue(oi.BundleProductId),
+			BundleName:        stringValue(oi.BundleProductName),
+			IsDigital:         oi.ProductDigitalFileName != nil,
 		})
 	}
 	return &order.DetailOrderResponse{
@@ -335,6 +563,9 @@ func (os *orderService) DetailOrder(ctx context.Context, request *order.DetailOr
 		Items:            items,
 		Total:            orderEntity.Total,
 		ExpiredAt:        timestamppb.New(*orderEntity.ExpiredAt),
+		Currency:         orderEntity.Currency,
+		DisplayCurrency:  orderEntity.DisplayCurrency,
+		ExchangeRate:     orderEntity.ExchangeRate,
 	}, nil
 }
 
@@ -391,13 +622,39 @@ func (os *orderService) UpdateOrderStatus(ctx context.Context, request *order.Up
 	}
 
 	now := time.Now()
+	currentStatusCode := orderEntity.OrderStatusCode
 	orderEntity.OrderStatusCode = request.NewStatusCode
 	orderEntity.UpdatedAt = &now
 	orderEntity.UpdatedBy = &claims.Subject
 
-	err = os.orderRepository.UpdateOrder(ctx, orderEntity)
-	if err != nil {
-		return nil, err
+	if request.NewStatusCode == entity.OrderStatusCodeCanceled {
+		// the invoice is expired first so a canceled order can not be paid
+		if orderEntity.XenditInvoiceId != nil {
+			paidInvoice, err := expireXenditInvoice(ctx, *orderEntity.XenditInvoiceId)
+			if err != nil {
+				return nil, err
+			}
+			if paidInvoice != nil {
+				return &order.UpdateOrderStatusResponse{
+					Base: utils.BadRequestResponse("Order has already been paid"),
+				}, nil
+			}
+		}
+
+		released, err := releaseOrderStock(ctx, os.db, os.orderRepository, os.productRepository, orderEntity, currentStatusCode)
+		if err != nil {
+			return nil, err
+		}
+		if !released {
+			return &order.UpdateOrderStatusResponse{
+				Base: utils.BadRequestResponse("Update status in not allowed"),
+			}, nil
+		}
+	} else {
+		err = os.orderRepository.UpdateOrder(ctx, orderEntity)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return &order.UpdateOrderStatusResponse{
@@ -405,10 +662,160 @@ func (os *orderService) UpdateOrderStatus(ctx context.Context, request *order.Up
 	}, nil
 }
 
-func NewOrderService(db *sql.DB, orderRepository repository.IOrderRepository, productRepository repository.IProductRepository) IOrderService {
+func (os *orderService) ListOrderDownload(ctx context.Context, request *order.ListOrderDownloadRequest) (*order.ListOrderDownloadResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	orderEntity, err := os.orderRepository.GetOrderById(ctx, request.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	if orderEntity == nil {
+		return &order.ListOrderDownloadResponse{
+			Base: utils.NotFoundResponse("Order Not Found"),
+		}, nil
+	}
+
+	// the links work for whoever holds them, so only the buyer gets them
+	if orderEntity.UserId != claims.Subject {
+		return &order.ListOrderDownloadResponse{
+			Base: utils.BadRequestResponse("User id is not matched"),
+		}, nil
+	}
+
+	entitlements, err := os.downloadEntitlementRepository.GetDownloadEntitlementsByOrderId(ctx, orderEntity.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	items := make([]*order.ListOrderDownloadResponseItem, 0)
+	for _, entitlement := range entitlements {
+		item := &order.ListOrderDownloadResponseItem{
+			Id:            entitlement.Id,
+			ProductId:     entitlement.ProductId,
+			ProductName:   entitlement.ProductName,
+			MaxDownloads:  entitlement.MaxDownloads,
+			DownloadCount: entitlement.DownloadCount,
+		}
+		if entitlement.DownloadCount < entitlement.MaxDownloads {
+			downloadUrl, expiredAt := os.downloadSigner.Sign(entitlement.Id, now)
+			item.DownloadUrl = downloadUrl
+			item.DownloadUrlExpiredAt = timestamppb.New(expiredAt)
+		}
+
+		items = append(items, item)
+	}
+
+	return &order.ListOrderDownloadResponse{
+		Base:  utils.SuccessResponse("Get List Order Download Success"),
+		Items: items,
+	}, nil
+}
+
+// bundleItemPrices splits the price of one bundle over its components by what
+// they cost on their own and returns the unit price of each. Line totals are
+// rounded to whole rupiah and the last component takes what rounding leaves,
+// so the items always add up to the bundle price.
+func bundleItemPrices(bundlePrice float64, bundleItems []*entity.ProductBundleItem, now time.Time) []float64 {
+	weights := make([]float64, len(bundleItems))
+	var totalWeight float64 = 0
+	for i, bundleItem := range bundleItems {
+		weights[i] = productVariantPrice(bundleItem.Product, nil, now) * float64(bundleItem.Quantity)
+		totalWeight += weights[i]
+	}
+	// components that cost nothing on their own share the price by quantity
+	if totalWeight == 0 {
+		for i, bundleItem := range bundleItems {
+			weights[i] = float64(bundleItem.Quantity)
+			totalWeight += weights[i]
+		}
+	}
+
+	prices := make([]float64, len(bundleItems))
+	remaining := bundlePrice
+	for i, bundleItem := range bundleItems {
+		lineTotal := remaining
+		if i < len(bundleItems)-1 {
+			lineTotal = math.Round(bundlePrice * weights[i] / totalWeight)
+			remaining -= lineTotal
+		}
+
+		prices[i] = lineTotal / float64(bundleItem.Quantity)
+	}
+
+	return prices
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+// releaseOrderStock moves the order out of fromStatusCode and gives the reserved
+// stock of its items back. It reports false when the order was no longer in
+// fromStatusCode, in which case nothing is changed.
+func releaseOrderStock(ctx context.Context, db *sql.DB, orderRepository repository.IOrderRepository, productRepository repository.IProductRepository, orderEntity *entity.Order, fromStatusCode string) (bool, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	released, err := releaseOrderStockWithTransaction(ctx, tx, orderRepository, productRepository, orderEntity, fromStatusCode)
+	if err != nil || !released {
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func releaseOrderStockWithTransaction(ctx context.Context, tx *sql.Tx, orderRepository repository.IOrderRepository, productRepository repository.IProductRepository, orderEntity *entity.Order, fromStatusCode string) (bool, error) {
+	updated, err := orderRepository.WithTransaction(tx).UpdateOrderFromStatus(ctx, orderEntity, fromStatusCode)
+	if err != nil {
+		return false, err
+	}
+	if !updated {
+		return false, nil
+	}
+
+	productRepo := productRepository.WithTransaction(tx)
+	for _, item := range orderEntity.Items {
+		// digital items reserve no stock
+		if item.ProductDigitalFileName != nil {
+			continue
+		}
+
+		if item.ProductVariantId != nil {
+			err = productRepo.IncreaseProductVariantStock(ctx, *item.ProductVariantId, item.Quantity)
+		} else {
+			err = productRepo.IncreaseProductStock(ctx, item.ProductId, item.Quantity)
+		}
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+func NewOrderService(db *sql.DB, orderRepository repository.IOrderRepository, productRepository repository.IProductRepository, exchangeRateRepository repository.IExchangeRateRepository, downloadEntitlementRepository repository.IDownloadEntitlementRepository, downloadSigner *downloadlink.Signer) IOrderService {
 	return &orderService{
-		db:                db,
-		orderRepository:   orderRepository,
-		productRepository: productRepository,
+		db:                            db,
+		orderRepository:               orderRepository,
+		productRepository:             productRepository,
+		exchangeRateRepository:        exchangeRateRepository,
+		downloadEntitlementRepository: downloadEntitlementRepository,
+		downloadSigner:                downloadSigner,
 	}
 }
@@ -0,0 +1,381 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/xryar/golang-grpc-ecommerce/internal/entity"
+	jwtentity "github.com/xryar/golang-grpc-ecommerce/internal/entity/jwt"
+	"github.com/xryar/golang-grpc-ecommerce/internal/repository"
+	"github.com/xryar/golang-grpc-ecommerce/internal/utils"
+	"github.com/xryar/golang-grpc-ecommerce/pb/common"
+	"github.com/xryar/golang-grpc-ecommerce/pb/product"
+)
+
+const (
+	productImportMaxRows    = 10000
+	productExportBatchSize  = 500
+	productCsvRequiredCount = 5
+)
+
+// productCsvHeader is the column order of exports. Imports match columns by
+// name, id, sku, category_id and status are optional there and an update
+// keeps the product's sku, category and status when their column is missing.
+var productCsvHeader = []string{"id", "sku", "name", "description", "price", "stock", "image_file_name", "category_id", "status"}
+
+type productImportRow struct {
+	productEntity *entity.Product
+	previous      *entity.Product
+}
+
+// ImportProducts upserts the products of a csv file, matching existing ones by
+// id and then by sku. Every row is validated first and nothing is written when
+// a row is invalid or dryRun is set.
+func (ps *productService) ImportProducts(ctx context.Context, reader io.Reader, dryRun bool) (*product.ImportProductResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = -1
+	header, err := csvReader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return &product.ImportProductResponse{
+				Base: utils.BadRequestResponse("CSV file is empty"),
+			}, nil
+		}
+
+		return &product.ImportProductResponse{
+			Base: utils.BadRequestResponse(fmt.Sprintf("Invalid CSV: %v", err)),
+		}, nil
+	}
+
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+	for _, name := range productCsvHeader[2 : 2+productCsvRequiredCount] {
+		if _, ok := columns[name]; !ok {
+			return &product.ImportProductResponse{
+				Base: utils.BadRequestResponse(fmt.Sprintf("Missing column %s", name)),
+			}, nil
+		}
+	}
+
+	now := time.Now()
+	importRows := make([]*productImportRow, 0)
+	rowErrors := make([]*product.ImportProductRowError, 0)
+	seenIds := make(map[string]bool)
+	seenSkus := make(map[string]bool)
+	for rowNumber := 2; ; rowNumber++ {
+		record, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return &product.ImportProductResponse{
+				Base: utils.BadRequestResponse(fmt.Sprintf("Invalid CSV: %v", err)),
+			}, nil
+		}
+		if len(importRows)+len(rowErrors) >= productImportMaxRows {
+			return &product.ImportProductResponse{
+				Base: utils.BadRequestResponse(fmt.Sprintf("CSV file can not have more than %d rows", productImportMaxRows)),
+			}, nil
+		}
+
+		value := func(column string) string {
+			i, ok := columns[column]
+			if !ok || i >= len(record) {
+				return ""
+			}
+
+			return strings.TrimSpace(record[i])
+		}
+
+		importRow, validationErrors, err := ps.validateProductImportRow(ctx, columns, value, seenIds, seenSkus)
+		if err != nil {
+			return nil, err
+		}
+		if len(validationErrors) > 0 {
+			rowErrors = append(rowErrors, &product.ImportProductRowError{
+				Row:    int32(rowNumber),
+				Errors: validationErrors,
+			})
+			continue
+		}
+
+		if importRow.previous != nil {
+			importRow.productEntity.UpdatedAt = now
+			importRow.productEntity.UpdatedBy = &claims.Fullname
+		} else {
+			importRow.productEntity.Id = uuid.NewString()
+			importRow.productEntity.CreatedAt = now
+			importRow.productEntity.CreatedBy = claims.Fullname
+		}
+		importRows = append(importRows, importRow)
+	}
+
+	var createdCount, updatedCount int32
+	for _, importRow := range importRows {
+		if importRow.previous != nil {
+			updatedCount++
+		} else {
+			createdCount++
+		}
+	}
+
+	response := &product.ImportProductResponse{
+		DryRun:       dryRun,
+		TotalRows:    int32(len(importRows) + len(rowErrors)),
+		CreatedCount: createdCount,
+		UpdatedCount: updatedCount,
+		RowErrors:    rowErrors,
+	}
+	if len(rowErrors) > 0 {
+		response.Base = utils.BadRequestResponse("CSV file has invalid rows")
+		return response, nil
+	}
+	if dryRun {
+		response.Base = utils.SuccessResponse("CSV file is valid")
+		return response, nil
+	}
+
+	err = ps.runInTransaction(func(productRepo repository.IProductRepository) error {
+		for _, importRow := range importRows {
+			var err error
+			if importRow.previous != nil {
+				err = updateProductWithImage(ctx, productRepo, importRow.productEntity, importRow.previous, storedProductStock(importRow.previous))
+			} else {
+				err = createProductWithImage(ctx, productRepo, importRow.productEntity)
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if errors.Is(err, errProductStockChanged) {
+		response.Base = utils.BadRequestResponse("Product stock changed during the import, try again")
+		return response, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, importRow := range importRows {
+		if importRow.previous != nil && importRow.previous.ImageFileName != importRow.productEntity.ImageFileName {
+			err = ps.removeUnusedImage(ctx, importRow.previous.ImageFileName)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	response.Base = utils.SuccessResponse("Import Product Success")
+	return response, nil
+}
+
+// validateProductImportRow checks a row with the CreateProduct rules and
+// resolves the product it updates, if any.
+func (ps *productService) validateProductImportRow(ctx context.Context, columns map[string]int, value func(column string) string, seenIds map[string]bool, seenSkus map[string]bool) (*productImportRow, []*common.ValidationError, error) {
+	validationErrors := make([]*common.ValidationError, 0)
+
+	price, err := strconv.ParseFloat(value("price"), 64)
+	if err != nil {
+		validationErrors = append(validationErrors, &common.ValidationError{Field: "price", Message: "value must be a number"})
+	}
+	stock, err := strconv.ParseInt(value("stock"), 10, 64)
+	if err != nil {
+		validationErrors = append(validationErrors, &common.ValidationError{Field: "stock", Message: "value must be a whole number"})
+	}
+
+	request := &product.CreateProductRequest{
+		Name:          value("name"),
+		Description:   value("description"),
+		Price:         price,
+		ImageFileName: value("image_file_name"),
+		Stock:         stock,
+		CategoryId:    value("category_id"),
+		Sku:           value("sku"),
+		Status:        value("status"),
+	}
+	requestErrors, err := utils.CheckValidation(request)
+	if err != nil {
+		return nil, nil, err
+	}
+	validationErrors = append(validationErrors, requestErrors...)
+
+	var existing *entity.Product
+	id := value("id")
+	if id != "" {
+		if seenIds[id] {
+			validationErrors = append(validationErrors, &common.ValidationError{Field: "id", Message: "id is used by another row"})
+		}
+		seenIds[id] = true
+
+		existing, err = ps.productRepository.GetProductById(ctx, id)
+		if err != nil {
+			return nil, nil, err
+		}
+		if existing == nil {
+			validationErrors = append(validationErrors, &common.ValidationError{Field: "id", Message: "product not found"})
+		}
+	}
+
+	if request.Sku != "" {
+		if seenSkus[request.Sku] {
+			validationErrors = append(validationErrors, &common.ValidationError{Field: "sku", Message: "sku is used by another row"})
+		}
+		seenSkus[request.Sku] = true
+
+		skuProduct, err := ps.productRepository.GetProductBySku(ctx, request.Sku)
+		if err != nil {
+			return nil, nil, err
+		}
+		if skuProduct != nil {
+			if id == "" {
+				existing, err = ps.productRepository.GetProductById(ctx, skuProduct.Id)
+				if err != nil {
+					return nil, nil, err
+				}
+			} else if skuProduct.Id != id {
+				validationErrors = append(validationErrors, &common.ValidationError{Field: "sku", Message: "sku already exists"})
+			}
+		}
+	}
+
+	categoryId, err := ps.resolveCategoryId(ctx, request.CategoryId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if request.CategoryId != "" && categoryId == nil {
+		validationErrors = append(validationErrors, &common.ValidationError{Field: "category_id", Message: "category not found"})
+	}
+
+	// the csv has no sale columns, a scheduled sale is kept as it is
+	if existing != nil && existing.SalePrice != nil && *existing.SalePrice >= request.Price {
+		validationErrors = append(validationErrors, &common.ValidationError{Field: "price", Message: "value must be higher than the sale price"})
+	}
+
+	if request.ImageFileName != "" && (existing == nil || existing.ImageFileName != request.ImageFileName) {
+		imageExists, err := ps.storage.Exists(ctx, request.ImageFileName)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !imageExists {
+			validationErrors = append(validationErrors, &common.ValidationError{Field: "image_file_name", Message: "image not found"})
+		}
+	}
+
+	if len(validationErrors) > 0 {
+		return nil, validationErrors, nil
+	}
+
+	importRow := &productImportRow{
+		productEntity: &entity.Product{
+			Sku:           optionalString(request.Sku),
+			Name:          request.Name,
+			Description:   request.Description,
+			Price:         request.Price,
+			ImageFileName: request.ImageFileName,
+			Stock:         request.Stock,
+			CategoryId:    categoryId,
+			Status:        productStatusOrDefault(request.Status, entity.ProductStatusDraft),
+			Type:          entity.ProductTypeSimple,
+		},
+	}
+	if existing != nil {
+		importRow.previous = existing
+		importRow.productEntity.Id = existing.Id
+		importRow.productEntity.SalePrice = existing.SalePrice
+		importRow.productEntity.SaleStartsAt = existing.SaleStartsAt
+		importRow.productEntity.SaleEndsAt = existing.SaleEndsAt
+		importRow.productEntity.Slug = existing.Slug
+		importRow.productEntity.SeoTitle = existing.SeoTitle
+		importRow.productEntity.SeoDescription = existing.SeoDescription
+		importRow.productEntity.Status = productStatusOrDefault(request.Status, existing.Status)
+		if _, ok := columns["sku"]; !ok {
+			importRow.productEntity.Sku = existing.Sku
+		}
+		if _, ok := columns["category_id"]; !ok {
+			importRow.productEntity.CategoryId = existing.CategoryId
+		}
+		importRow.productEntity.PublishAt = existing.PublishAt
+		importRow.productEntity.Type = existing.Type
+		importRow.productEntity.DigitalFileName = existing.DigitalFileName
+		importRow.productEntity.MinOrderQuantity = existing.MinOrderQuantity
+		importRow.productEntity.MaxOrderQuantity = existing.MaxOrderQuantity
+		if existing.Type == entity.ProductTypeBundle {
+			// a bundle's stock comes from its components
+			importRow.productEntity.Stock = 0
+		}
+	}
+
+	return importRow, nil, nil
+}
+
+// ExportProducts writes the whole catalogue to writer as csv, reading it in
+// batches so it never has to be held in memory.
+func (ps *productService) ExportProducts(ctx context.Context, writer io.Writer) error {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if claims.Role != entity.UserRoleAdmin {
+		return utils.UnauthenticatedResponse()
+	}
+
+	csvWriter := csv.NewWriter(writer)
+	err = csvWriter.Write(productCsvHeader)
+	if err != nil {
+		return err
+	}
+
+	afterId := ""
+	for {
+		products, err := ps.productRepository.GetProductsForExport(ctx, afterId, productExportBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, prod := range products {
+			err = csvWriter.Write([]string{
+				prod.Id,
+				stringValue(prod.Sku),
+				prod.Name,
+				prod.Description,
+				strconv.FormatFloat(prod.Price, 'f', -1, 64),
+				strconv.FormatInt(prod.Stock, 10),
+				prod.ImageFileName,
+				stringValue(prod.CategoryId),
+				prod.Status,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(products) < productExportBatchSize {
+			break
+		}
+		afterId = products[len(products)-1].Id
+	}
+
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
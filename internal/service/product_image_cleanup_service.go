@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"log"
+	"maps"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/xryar/golang-grpc-ecommerce/internal/imageprocessor"
+	"github.com/xryar/golang-grpc-ecommerce/internal/repository"
+	"github.com/xryar/golang-grpc-ecommerce/internal/storage"
+)
+
+const (
+	productImageCleanupBatchSize = 500
+	// only files named like /product/upload results are ever removed
+	uploadedProductImagePrefix = "product_"
+)
+
+type IProductImageCleanupService interface {
+	// CleanupOrphanedImages removes the uploaded images, together with their
+	// renditions, that no product refers to and that are older than the grace
+	// period. It returns the removed file names, or with dryRun the ones that
+	// would be removed.
+	CleanupOrphanedImages(ctx context.Context, dryRun bool) ([]string, error)
+	RemoveOrphanedImages(ctx context.Context) error
+}
+
+type productImageCleanupService struct {
+	productRepository repository.IProductRepository
+	storage           storage.IStorage
+	gracePeriod       time.Duration
+}
+
+// productImageGroup is an uploaded image and its renditions.
+type productImageGroup struct {
+	sourceFileNames []string
+	fileNames       []string
+	isRecent        bool
+}
+
+func (cs *productImageCleanupService) CleanupOrphanedImages(ctx context.Context, dryRun bool) ([]string, error) {
+	objects, err := cs.storage.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// an upload that is about to be attached to a product must not be lost,
+	// so a group is only looked at once all of its files are old enough
+	cutoff := time.Now().Add(-cs.gracePeriod)
+	groups := make(map[string]*productImageGroup)
+	for _, object := range objects {
+		if !strings.HasPrefix(object.Name, uploadedProductImagePrefix) {
+			continue
+		}
+
+		sourceFileNames := imageprocessor.SourceFileNames(object.Name)
+		key := strings.TrimSuffix(sourceFileNames[0], filepath.Ext(sourceFileNames[0]))
+		group, ok := groups[key]
+		if !ok {
+			group = &productImageGroup{}
+			groups[key] = group
+		}
+
+		for _, sourceFileName := range sourceFileNames {
+			if !slices.Contains(group.sourceFileNames, sourceFileName) {
+				group.sourceFileNames = append(group.sourceFileNames, sourceFileName)
+			}
+		}
+		group.fileNames = append(group.fileNames, object.Name)
+		if object.ModifiedAt.After(cutoff) {
+			group.isRecent = true
+		}
+	}
+
+	candidateKeys := make([]string, 0)
+	sourceKeys := make(map[string]string)
+	for key, group := range groups {
+		if group.isRecent {
+			continue
+		}
+
+		candidateKeys = append(candidateKeys, key)
+		for _, sourceFileName := range group.sourceFileNames {
+			sourceKeys[sourceFileName] = key
+		}
+	}
+
+	referencedKeys := make(map[string]bool)
+	for batch := range slices.Chunk(slices.Sorted(maps.Keys(sourceKeys)), productImageCleanupBatchSize) {
+		referenced, err := cs.productRepository.GetReferencedImageFileNames(ctx, batch)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, fileName := range referenced {
+			referencedKeys[sourceKeys[fileName]] = true
+		}
+	}
+
+	removed := make([]string, 0)
+	for _, key := range candidateKeys {
+		if referencedKeys[key] {
+			continue
+		}
+
+		removed = append(removed, groups[key].fileNames...)
+	}
+	// sorting puts an original before its renditions, so a partly cleaned up
+	// image is never served as complete
+	slices.Sort(removed)
+
+	if dryRun {
+		return removed, nil
+	}
+
+	for i, fileName := range removed {
+		err = cs.storage.Delete(ctx, fileName)
+		if err != nil {
+			return removed[:i], err
+		}
+	}
+
+	return removed, nil
+}
+
+func (cs *productImageCleanupService) RemoveOrphanedImages(ctx context.Context) error {
+	removed, err := cs.CleanupOrphanedImages(ctx, false)
+	if len(removed) > 0 {
+		log.Printf("Removed %d orphaned product image files", len(removed))
+	}
+
+	return err
+}
+
+func NewProductImageCleanupService(productRepository repository.IProductRepository, storage storage.IStorage, gracePeriod time.Duration) IProductImageCleanupService {
+	return &productImageCleanupService{
+		productRepository: productRepository,
+		storage:           storage,
+		gracePeriod:       gracePeriod,
+	}
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log"
+	"maps"
+	"slices"
+
+	"github.com/xryar/golang-grpc-ecommerce/internal/imageprocessor"
+	"github.com/xryar/golang-grpc-ecommerce/internal/repository"
+	"github.com/xryar/golang-grpc-ecommerce/internal/storage"
+)
+
+type IProductImageRenditionService interface {
+	// BackfillRenditions creates the missing renditions of the stored images
+	// products refer to, which images uploaded before renditions existed do
+	// not have. It returns the created file names, or with dryRun the ones
+	// that would be created.
+	BackfillRenditions(ctx context.Context, dryRun bool) ([]string, error)
+}
+
+type productImageRenditionService struct {
+	productRepository repository.IProductRepository
+	storage           storage.IStorage
+}
+
+func (rs *productImageRenditionService) BackfillRenditions(ctx context.Context, dryRun bool) ([]string, error) {
+	objects, err := rs.storage.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	storedFileNames := make(map[string]bool)
+	for _, object := range objects {
+		storedFileNames[object.Name] = true
+	}
+
+	missingFileNames := make(map[string][]string)
+	for _, object := range objects {
+		sourceFileNames := imageprocessor.SourceFileNames(object.Name)
+		if len(sourceFileNames) != 1 || sourceFileNames[0] != object.Name {
+			continue
+		}
+
+		for _, fileName := range imageprocessor.FileNames(object.Name)[1:] {
+			if !storedFileNames[fileName] {
+				missingFileNames[object.Name] = append(missingFileNames[object.Name], fileName)
+			}
+		}
+	}
+
+	// unreferenced uploads are left to the image cleanup
+	originals := make([]string, 0)
+	for batch := range slices.Chunk(slices.Sorted(maps.Keys(missingFileNames)), productImageCleanupBatchSize) {
+		referenced, err := rs.productRepository.GetReferencedImageFileNames(ctx, batch)
+		if err != nil {
+			return nil, err
+		}
+
+		originals = append(originals, referenced...)
+	}
+	slices.Sort(originals)
+
+	created := make([]string, 0)
+	for _, original := range originals {
+		if dryRun {
+			created = append(created, missingFileNames[original]...)
+			continue
+		}
+
+		renditions, err := rs.renditions(ctx, original)
+		if errors.Is(err, imageprocessor.ErrUnsupportedFormat) {
+			log.Printf("Skipping renditions of %s: %v", original, err)
+			continue
+		}
+		if err != nil {
+			return created, err
+		}
+
+		for _, rendition := range renditions {
+			if !slices.Contains(missingFileNames[original], rendition.FileName) {
+				continue
+			}
+
+			err = rs.storage.Save(ctx, rendition.FileName, bytes.NewReader(rendition.Data), int64(len(rendition.Data)), rendition.ContentType)
+			if err != nil {
+				return created, err
+			}
+
+			created = append(created, rendition.FileName)
+		}
+	}
+
+	return created, nil
+}
+
+func (rs *productImageRenditionService) renditions(ctx context.Context, fileName string) ([]*imageprocessor.ProcessedImage, error) {
+	file, err := rs.storage.Open(ctx, fileName)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, err
+	}
+
+	return imageprocessor.Renditions(data, fileName)
+}
+
+func NewProductImageRenditionService(productRepository repository.IProductRepository, storage storage.IStorage) IProductImageRenditionService {
+	return &productImageRenditionService{
+		productRepository: productRepository,
+		storage:           storage,
+	}
+}
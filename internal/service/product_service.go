@@ -2,17 +2,29 @@ package service
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
-	"os"
-	"path/filepath"
+	"io"
+	"maps"
+	"math"
+	"runtime/debug"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
+	"unicode"
 
 	"github.com/google/uuid"
 	"github.com/xryar/golang-grpc-ecommerce/internal/entity"
 	jwtentity "github.com/xryar/golang-grpc-ecommerce/internal/entity/jwt"
+	"github.com/xryar/golang-grpc-ecommerce/internal/imageprocessor"
 	"github.com/xryar/golang-grpc-ecommerce/internal/repository"
+	"github.com/xryar/golang-grpc-ecommerce/internal/storage"
 	"github.com/xryar/golang-grpc-ecommerce/internal/utils"
 	"github.com/xryar/golang-grpc-ecommerce/pb/product"
+	"golang.org/x/text/unicode/norm"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type IProductService interface {
@@ -23,10 +35,33 @@ type IProductService interface {
 	ListProduct(ctx context.Context, request *product.ListProductRequest) (*product.ListProductResponse, error)
 	ListProductAdmin(ctx context.Context, request *product.ListProductAdminRequest) (*product.ListProductAdminResponse, error)
 	HighlightProducts(ctx context.Context, request *product.HighlightProductRequest) (*product.HighlightProductResponse, error)
+	CreateProductVariant(ctx context.Context, request *product.CreateProductVariantRequest) (*product.CreateProductVariantResponse, error)
+	EditProductVariant(ctx context.Context, request *product.EditProductVariantRequest) (*product.EditProductVariantResponse, error)
+	DeleteProductVariant(ctx context.Context, request *product.DeleteProductVariantRequest) (*product.DeleteProductVariantResponse, error)
+	AddProductImage(ctx context.Context, request *product.AddProductImageRequest) (*product.AddProductImageResponse, error)
+	RemoveProductImage(ctx context.Context, request *product.RemoveProductImageRequest) (*product.RemoveProductImageResponse, error)
+	ReorderProductImages(ctx context.Context, request *product.ReorderProductImagesRequest) (*product.ReorderProductImagesResponse, error)
+	SetPrimaryProductImage(ctx context.Context, request *product.SetPrimaryProductImageRequest) (*product.SetPrimaryProductImageResponse, error)
+	ImportProducts(ctx context.Context, reader io.Reader, dryRun bool) (*product.ImportProductResponse, error)
+	ExportProducts(ctx context.Context, writer io.Writer) error
+	ListProductPriceHistory(ctx context.Context, request *product.ListProductPriceHistoryRequest) (*product.ListProductPriceHistoryResponse, error)
+	ListDeletedProduct(ctx context.Context, request *product.ListDeletedProductRequest) (*product.ListDeletedProductResponse, error)
+	RestoreProduct(ctx context.Context, request *product.RestoreProductRequest) (*product.RestoreProductResponse, error)
+	PurgeProduct(ctx context.Context, request *product.PurgeProductRequest) (*product.PurgeProductResponse, error)
 }
 
+// defaultHighlightProductLimit is how many best sellers HighlightProducts shows
+// when no collection is running.
+const defaultHighlightProductLimit = 3
+
 type productService struct {
-	productRepository repository.IProductRepository
+	db                     *sql.DB
+	productRepository      repository.IProductRepository
+	categoryRepository     repository.ICategoryRepository
+	collectionRepository   repository.ICollectionRepository
+	exchangeRateRepository repository.IExchangeRateRepository
+	storage                storage.IStorage
+	digitalStorage         storage.IStorage
 }
 
 func (ps *productService) CreateProduct(ctx context.Context, request *product.CreateProductRequest) (*product.CreateProductResponse, error) {
@@ -39,28 +74,164 @@ func (ps *productService) CreateProduct(ctx context.Context, request *product.Cr
 		return nil, utils.UnauthenticatedResponse()
 	}
 
-	imagePath := filepath.Join("storage", "product", request.ImageFileName)
-	_, err = os.Stat(imagePath)
+	imageExists, err := ps.storage.Exists(ctx, request.ImageFileName)
+	if err != nil {
+		return nil, err
+	}
+	if !imageExists {
+		return &product.CreateProductResponse{
+			Base: utils.BadRequestResponse("File not found"),
+		}, nil
+	}
+
+	categoryId, err := ps.resolveCategoryId(ctx, request.CategoryId)
 	if err != nil {
-		if os.IsNotExist(err) {
+		return nil, err
+	}
+	if request.CategoryId != "" && categoryId == nil {
+		return &product.CreateProductResponse{
+			Base: utils.BadRequestResponse("Category not found"),
+		}, nil
+	}
+
+	if request.Sku != "" {
+		skuProduct, err := ps.productRepository.GetProductBySku(ctx, request.Sku)
+		if err != nil {
+			return nil, err
+		}
+		if skuProduct != nil {
+			return &product.CreateProductResponse{
+				Base: utils.BadRequestResponse("SKU already exists"),
+			}, nil
+		}
+	}
+
+	if request.Slug != "" {
+		message, err := ps.checkProductSlug(ctx, request.Slug, "")
+		if err != nil {
+			return nil, err
+		}
+		if message != "" {
+			return &product.CreateProductResponse{
+				Base: utils.BadRequestResponse(message),
+			}, nil
+		}
+	}
+
+	saleStartsAt := optionalTime(request.SaleStartsAt)
+	saleEndsAt := optionalTime(request.SaleEndsAt)
+	if message := validateProductSale(request.Price, request.SalePrice, saleStartsAt, saleEndsAt); message != "" {
+		return &product.CreateProductResponse{
+			Base: utils.BadRequestResponse(message),
+		}, nil
+	}
+	if message := validateProductOrderQuantity(request.MinOrderQuantity, request.MaxOrderQuantity); message != "" {
+		return &product.CreateProductResponse{
+			Base: utils.BadRequestResponse(message),
+		}, nil
+	}
+
+	now := time.Now()
+	productId := uuid.NewString()
+	productType := entity.ProductTypeSimple
+	stock := request.Stock
+	var bundleItems []*entity.ProductBundleItem
+	if request.Type == entity.ProductTypeBundle {
+		var message string
+		bundleItems, message, err = ps.buildProductBundleItems(ctx, productId, request.BundleItems, now, claims.Fullname)
+		if err != nil {
+			return nil, err
+		}
+		if message != "" {
+			return &product.CreateProductResponse{
+				Base: utils.BadRequestResponse(message),
+			}, nil
+		}
+
+		productType = entity.ProductTypeBundle
+		stock = 0
+	} else if len(request.BundleItems) > 0 {
+		return &product.CreateProductResponse{
+			Base: utils.BadRequestResponse("Only bundles can contain products"),
+		}, nil
+	}
+
+	var digitalFileName *string
+	if request.Type == entity.ProductTypeDigital {
+		if request.DigitalFileName == "" {
+			return &product.CreateProductResponse{
+				Base: utils.BadRequestResponse("Digital product requires a file"),
+			}, nil
+		}
+
+		digitalFileExists, err := ps.digitalStorage.Exists(ctx, request.DigitalFileName)
+		if err != nil {
+			return nil, err
+		}
+		if !digitalFileExists {
 			return &product.CreateProductResponse{
-				Base: utils.BadRequestResponse("File not found"),
+				Base: utils.BadRequestResponse("Digital file not found"),
 			}, nil
 		}
 
+		productType = entity.ProductTypeDigital
+		digitalFileName = &request.DigitalFileName
+	} else if request.DigitalFileName != "" {
+		return &product.CreateProductResponse{
+			Base: utils.BadRequestResponse("Only digital products have a file"),
+		}, nil
+	}
+
+	attributeValues, message, err := ps.buildProductAttributeValues(ctx, productId, categoryId, request.Attributes)
+	if err != nil {
 		return nil, err
 	}
+	if message != "" {
+		return &product.CreateProductResponse{
+			Base: utils.BadRequestResponse(message),
+		}, nil
+	}
 
 	productEntity := entity.Product{
-		Id:            uuid.NewString(),
-		Name:          request.Name,
-		Description:   request.Description,
-		Price:         request.Price,
-		ImageFileName: request.ImageFileName,
-		CreatedAt:     time.Now(),
-		CreatedBy:     claims.Fullname,
+		Id:               productId,
+		Sku:              optionalString(request.Sku),
+		Slug:             request.Slug,
+		Name:             request.Name,
+		Description:      request.Description,
+		SeoTitle:         optionalString(request.SeoTitle),
+		SeoDescription:   optionalString(request.SeoDescription),
+		Price:            request.Price,
+		SalePrice:        request.SalePrice,
+		SaleStartsAt:     saleStartsAt,
+		SaleEndsAt:       saleEndsAt,
+		ImageFileName:    request.ImageFileName,
+		Stock:            stock,
+		CategoryId:       categoryId,
+		Status:           productStatusOrDefault(request.Status, entity.ProductStatusDraft),
+		PublishAt:        optionalTime(request.PublishAt),
+		Type:             productType,
+		CreatedAt:        now,
+		CreatedBy:        claims.Fullname,
+		DigitalFileName:  digitalFileName,
+		MinOrderQuantity: request.MinOrderQuantity,
+		MaxOrderQuantity: request.MaxOrderQuantity,
 	}
-	err = ps.productRepository.CreateNewProduct(ctx, &productEntity)
+	err = ps.runInTransaction(func(productRepo repository.IProductRepository) error {
+		err := createProductWithImage(ctx, productRepo, &productEntity)
+		if err != nil {
+			return err
+		}
+
+		err = productRepo.ReplaceProductAttributeValues(ctx, productEntity.Id, attributeValues)
+		if err != nil {
+			return err
+		}
+		if bundleItems == nil {
+			return nil
+		}
+
+		return productRepo.ReplaceProductBundleItems(ctx, productEntity.Id, bundleItems)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -72,24 +243,176 @@ func (ps *productService) CreateProduct(ctx context.Context, request *product.Cr
 }
 
 func (ps *productService) DetailProduct(ctx context.Context, request *product.DetailProductRequest) (*product.DetailProductResponse, error) {
-	productEntity, err := ps.productRepository.GetProductById(ctx, request.Id)
+	var productEntity *entity.Product
+	var err error
+	if uuid.Validate(request.Id) == nil {
+		productEntity, err = ps.productRepository.GetProductById(ctx, request.Id)
+	} else {
+		productEntity, err = ps.productRepository.GetProductBySlug(ctx, request.Id)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	if productEntity == nil {
+	now := time.Now()
+	if productEntity == nil || !productIsPublished(productEntity, now) {
 		return &product.DetailProductResponse{
 			Base: utils.NotFoundResponse("Product not found"),
 		}, nil
 	}
 
+	converter, err := newPriceConverter(ctx, ps.exchangeRateRepository, displayCurrency(ctx, request.Currency), now)
+	if errors.Is(err, errCurrencyNotAvailable) {
+		return &product.DetailProductResponse{
+			Base: utils.BadRequestResponse("Currency is not available"),
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	categoryBreadcrumb := make([]*product.DetailProductResponseCategory, 0)
+	if productEntity.CategoryId != nil {
+		categories, err := ps.categoryRepository.GetCategoryAncestors(ctx, *productEntity.CategoryId)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, category := range categories {
+			categoryBreadcrumb = append(categoryBreadcrumb, &product.DetailProductResponseCategory{
+				Id:   category.Id,
+				Name: category.Name,
+			})
+		}
+	}
+
+	variantEntities, err := ps.productRepository.GetProductVariantsByProductId(ctx, productEntity.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	variants := make([]*product.DetailProductResponseVariant, 0)
+	for _, variantEntity := range variantEntities {
+		imageFileName := productEntity.ImageFileName
+		if variantEntity.ImageFileName != nil {
+			imageFileName = *variantEntity.ImageFileName
+		}
+
+		imageUrl, err := ps.storage.URL(ctx, imageprocessor.RenditionFileName(imageFileName, imageprocessor.RenditionMedium))
+		if err != nil {
+			return nil, err
+		}
+
+		variants = append(variants, &product.DetailProductResponseVariant{
+			Id:            variantEntity.Id,
+			Sku:           variantEntity.Sku,
+			Attributes:    variantEntity.Attributes,
+			Price:         converter.Convert(productVariantPrice(productEntity, variantEntity, now)),
+			ImageUrl:      imageUrl,
+			Stock:         variantEntity.Stock,
+			OriginalPrice: converter.Convert(productVariantOriginalPrice(productEntity, variantEntity)),
+		})
+	}
+
+	bundleItems := make([]*product.DetailProductResponseBundleItem, 0)
+	if productEntity.Type == entity.ProductTypeBundle {
+		bundleItemEntities, err := ps.productRepository.GetProductBundleItems(ctx, []string{productEntity.Id})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, bundleItemEntity := range bundleItemEntities {
+			imageUrl, err := ps.storage.URL(ctx, imageprocessor.RenditionFileName(bundleItemEntity.Product.ImageFileName, imageprocessor.RenditionThumbnail))
+			if err != nil {
+				return nil, err
+			}
+
+			bundleItems = append(bundleItems, &product.DetailProductResponseBundleItem{
+				ProductId: bundleItemEntity.ProductId,
+				Slug:      bundleItemEntity.Product.Slug,
+				Name:      bundleItemEntity.Product.Name,
+				Quantity:  bundleItemEntity.Quantity,
+				Price:     converter.Convert(productVariantPrice(bundleItemEntity.Product, nil, now)),
+				ImageUrl:  imageUrl,
+			})
+		}
+	}
+
+	attributeValueEntities, err := ps.productRepository.GetProductAttributeValues(ctx, productEntity.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := make([]*product.DetailProductResponseAttribute, 0)
+	for _, attributeValueEntity := range attributeValueEntities {
+		attributes = append(attributes, &product.DetailProductResponseAttribute{
+			AttributeId: attributeValueEntity.AttributeId,
+			Code:        attributeValueEntity.Attribute.Code,
+			Name:        attributeValueEntity.Attribute.Name,
+			Type:        attributeValueEntity.Attribute.Type,
+			Unit:        stringValue(attributeValueEntity.Attribute.Unit),
+			Value:       attributeValueEntity.ValueText,
+		})
+	}
+
+	imageEntities, err := ps.productRepository.GetProductImagesByProductId(ctx, productEntity.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	images := make([]*product.DetailProductResponseImage, 0)
+	for _, imageEntity := range imageEntities {
+		imageUrl, err := ps.storage.URL(ctx, imageprocessor.RenditionFileName(imageEntity.ImageFileName, imageprocessor.RenditionMedium))
+		if err != nil {
+			return nil, err
+		}
+
+		images = append(images, &product.DetailProductResponseImage{
+			Id:        imageEntity.Id,
+			ImageUrl:  imageUrl,
+			IsPrimary: imageEntity.IsPrimary,
+		})
+	}
+
+	imageUrl, err := ps.storage.URL(ctx, imageprocessor.RenditionFileName(productEntity.ImageFileName, imageprocessor.RenditionMedium))
+	if err != nil {
+		return nil, err
+	}
+
+	_, isOnSale := productSalePrice(productEntity, now)
+	var saleEndsAt *timestamppb.Timestamp
+	if isOnSale && productEntity.SaleEndsAt != nil {
+		saleEndsAt = timestamppb.New(*productEntity.SaleEndsAt)
+	}
+
 	return &product.DetailProductResponse{
-		Base:        utils.SuccessResponse("Success Get detail product"),
-		Id:          productEntity.Id,
-		Name:        productEntity.Name,
-		Description: productEntity.Description,
-		Price:       productEntity.Price,
-		ImageUrl:    fmt.Sprintf("%s/product/%s", os.Getenv("STORAGE_SERVICE_URL"), productEntity.ImageFileName),
+		Base:               utils.SuccessResponse("Success Get detail product"),
+		Id:                 productEntity.Id,
+		Name:               productEntity.Name,
+		Description:        productEntity.Description,
+		Price:              converter.Convert(productVariantPrice(productEntity, nil, now)),
+		ImageUrl:           imageUrl,
+		Stock:              productEntity.Stock,
+		CategoryBreadcrumb: categoryBreadcrumb,
+		Variants:           variants,
+		Images:             images,
+		Sku:                stringValue(productEntity.Sku),
+		RatingAverage:      productEntity.RatingAverage,
+		ReviewCount:        productEntity.ReviewCount,
+		OriginalPrice:      converter.Convert(productEntity.Price),
+		IsOnSale:           isOnSale,
+		SaleEndsAt:         saleEndsAt,
+		Slug:               productEntity.Slug,
+		SeoTitle:           stringValue(productEntity.SeoTitle),
+		SeoDescription:     stringValue(productEntity.SeoDescription),
+		Status:             productEntity.Status,
+		PublishAt:          optionalTimestamp(productEntity.PublishAt),
+		Currency:           converter.currency,
+		Type:               productEntity.Type,
+		BundleItems:        bundleItems,
+		Attributes:         attributes,
+		MinOrderQuantity:   productEntity.MinOrderQuantity,
+		MaxOrderQuantity:   productEntity.MaxOrderQuantity,
 	}, nil
 }
 
@@ -113,41 +436,188 @@ func (ps *productService) EditProduct(ctx context.Context, request *product.Edit
 		}, nil
 	}
 
-	if productEntity.ImageFileName != request.ImageFileName {
-		newImagePath := filepath.Join("storage", "product", request.ImageFileName)
-		_, err := os.Stat(newImagePath)
+	categoryId, err := ps.resolveCategoryId(ctx, request.CategoryId)
+	if err != nil {
+		return nil, err
+	}
+	if request.CategoryId != "" && categoryId == nil {
+		return &product.EditProductResponse{
+			Base: utils.BadRequestResponse("Category not found"),
+		}, nil
+	}
+
+	if request.Sku != "" {
+		skuProduct, err := ps.productRepository.GetProductBySku(ctx, request.Sku)
 		if err != nil {
-			if os.IsNotExist(err) {
-				return &product.EditProductResponse{
-					Base: utils.BadRequestResponse("Image not found"),
-				}, nil
-			}
+			return nil, err
+		}
+		if skuProduct != nil && skuProduct.Id != productEntity.Id {
+			return &product.EditProductResponse{
+				Base: utils.BadRequestResponse("SKU already exists"),
+			}, nil
+		}
+	}
+
+	slug := productEntity.Slug
+	if request.Slug != "" {
+		message, err := ps.checkProductSlug(ctx, request.Slug, productEntity.Id)
+		if err != nil {
+			return nil, err
+		}
+		if message != "" {
+			return &product.EditProductResponse{
+				Base: utils.BadRequestResponse(message),
+			}, nil
+		}
 
+		slug = request.Slug
+	}
+
+	imageChanged := productEntity.ImageFileName != request.ImageFileName
+	if imageChanged {
+		imageExists, err := ps.storage.Exists(ctx, request.ImageFileName)
+		if err != nil {
 			return nil, err
 		}
+		if !imageExists {
+			return &product.EditProductResponse{
+				Base: utils.BadRequestResponse("Image not found"),
+			}, nil
+		}
+	}
+
+	saleStartsAt := optionalTime(request.SaleStartsAt)
+	saleEndsAt := optionalTime(request.SaleEndsAt)
+	if message := validateProductSale(request.Price, request.SalePrice, saleStartsAt, saleEndsAt); message != "" {
+		return &product.EditProductResponse{
+			Base: utils.BadRequestResponse(message),
+		}, nil
+	}
+	if message := validateProductOrderQuantity(request.MinOrderQuantity, request.MaxOrderQuantity); message != "" {
+		return &product.EditProductResponse{
+			Base: utils.BadRequestResponse(message),
+		}, nil
+	}
 
-		oldImagePath := filepath.Join("storage", "product", productEntity.ImageFileName)
-		err = os.Remove(oldImagePath)
+	now := time.Now()
+	stock := request.Stock
+	var bundleItems []*entity.ProductBundleItem
+	if productEntity.Type == entity.ProductTypeBundle {
+		var message string
+		bundleItems, message, err = ps.buildProductBundleItems(ctx, productEntity.Id, request.BundleItems, now, claims.Fullname)
 		if err != nil {
 			return nil, err
 		}
+		if message != "" {
+			return &product.EditProductResponse{
+				Base: utils.BadRequestResponse(message),
+			}, nil
+		}
+
+		stock = 0
+	} else if len(request.BundleItems) > 0 {
+		return &product.EditProductResponse{
+			Base: utils.BadRequestResponse("Only bundles can contain products"),
+		}, nil
+	}
+
+	// the previous file stays in storage, orders placed before are entitled
+	// to it
+	digitalFileName := productEntity.DigitalFileName
+	if productEntity.Type == entity.ProductTypeDigital {
+		if request.DigitalFileName != "" && request.DigitalFileName != stringValue(productEntity.DigitalFileName) {
+			digitalFileExists, err := ps.digitalStorage.Exists(ctx, request.DigitalFileName)
+			if err != nil {
+				return nil, err
+			}
+			if !digitalFileExists {
+				return &product.EditProductResponse{
+					Base: utils.BadRequestResponse("Digital file not found"),
+				}, nil
+			}
+
+			digitalFileName = &request.DigitalFileName
+		}
+	} else if request.DigitalFileName != "" {
+		return &product.EditProductResponse{
+			Base: utils.BadRequestResponse("Only digital products have a file"),
+		}, nil
+	}
+
+	// orders reserve and release stock while the product is being edited,
+	// the edit only goes through when the stock is still what the admin saw
+	previousStock := storedProductStock(productEntity)
+	if request.PreviousStock != nil && productEntity.Type != entity.ProductTypeBundle {
+		previousStock = *request.PreviousStock
+	}
+
+	attributeValues, message, err := ps.buildProductAttributeValues(ctx, productEntity.Id, categoryId, request.Attributes)
+	if err != nil {
+		return nil, err
+	}
+	if message != "" {
+		return &product.EditProductResponse{
+			Base: utils.BadRequestResponse(message),
+		}, nil
 	}
 
 	newProduct := entity.Product{
-		Id:            request.Id,
-		Name:          request.Name,
-		Description:   request.Description,
-		Price:         request.Price,
-		ImageFileName: request.ImageFileName,
-		UpdatedAt:     time.Now(),
-		UpdatedBy:     &claims.Fullname,
+		Id:               request.Id,
+		Sku:              optionalString(request.Sku),
+		Slug:             slug,
+		Name:             request.Name,
+		Description:      request.Description,
+		SeoTitle:         optionalString(request.SeoTitle),
+		SeoDescription:   optionalString(request.SeoDescription),
+		Price:            request.Price,
+		SalePrice:        request.SalePrice,
+		SaleStartsAt:     saleStartsAt,
+		SaleEndsAt:       saleEndsAt,
+		ImageFileName:    request.ImageFileName,
+		Stock:            stock,
+		CategoryId:       categoryId,
+		Status:           productStatusOrDefault(request.Status, productEntity.Status),
+		PublishAt:        optionalTime(request.PublishAt),
+		Type:             productEntity.Type,
+		UpdatedAt:        now,
+		UpdatedBy:        &claims.Fullname,
+		DigitalFileName:  digitalFileName,
+		MinOrderQuantity: request.MinOrderQuantity,
+		MaxOrderQuantity: request.MaxOrderQuantity,
 	}
 
-	err = ps.productRepository.UpdateProduct(ctx, &newProduct)
+	err = ps.runInTransaction(func(productRepo repository.IProductRepository) error {
+		err := updateProductWithImage(ctx, productRepo, &newProduct, productEntity, previousStock)
+		if err != nil {
+			return err
+		}
+
+		err = productRepo.ReplaceProductAttributeValues(ctx, productEntity.Id, attributeValues)
+		if err != nil {
+			return err
+		}
+		if bundleItems == nil {
+			return nil
+		}
+
+		return productRepo.ReplaceProductBundleItems(ctx, productEntity.Id, bundleItems)
+	})
+	if errors.Is(err, errProductStockChanged) {
+		return &product.EditProductResponse{
+			Base: utils.BadRequestResponse("Product stock changed since it was loaded, reload the product and try again"),
+		}, nil
+	}
 	if err != nil {
 		return nil, err
 	}
 
+	if imageChanged {
+		err = ps.removeUnusedImage(ctx, productEntity.ImageFileName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &product.EditProductResponse{
 		Base: utils.SuccessResponse("Edit Product Success"),
 		Id:   request.Id,
@@ -185,26 +655,119 @@ func (ps *productService) DeleteProduct(ctx context.Context, request *product.De
 }
 
 func (ps *productService) ListProduct(ctx context.Context, request *product.ListProductRequest) (*product.ListProductResponse, error) {
-	products, paginationResponse, err := ps.productRepository.GetProductsPagination(ctx, request.Pagination)
+	filter, err := ps.buildProductFilter(ctx, request.CategoryId)
+	if err != nil {
+		return nil, err
+	}
+	filter.Search = request.Search
+	filter.PublishedOnly = true
+
+	now := time.Now()
+	converter, err := newPriceConverter(ctx, ps.exchangeRateRepository, displayCurrency(ctx, request.Currency), now)
+	if errors.Is(err, errCurrencyNotAvailable) {
+		return &product.ListProductResponse{
+			Base: utils.BadRequestResponse("Currency is not available"),
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// prices are filtered in the currency they are shown in
+	if request.MinPrice != nil {
+		minPrice := converter.ToIDR(*request.MinPrice)
+		filter.MinPrice = &minPrice
+	}
+	if request.MaxPrice != nil {
+		maxPrice := converter.ToIDR(*request.MaxPrice)
+		filter.MaxPrice = &maxPrice
+	}
+	for _, attributeFilter := range request.AttributeFilters {
+		filter.AttributeFilters = append(filter.AttributeFilters, &repository.ProductAttributeFilter{
+			Code:   attributeFilter.Code,
+			Values: attributeFilter.Values,
+			Min:    attributeFilter.Min,
+			Max:    attributeFilter.Max,
+		})
+	}
+
+	products, paginationResponse, err := ps.productRepository.GetProductsPagination(ctx, request.Pagination, filter)
+	var validationErr *repository.QueryValidationError
+	if errors.As(err, &validationErr) {
+		return &product.ListProductResponse{
+			Base: utils.ValidationErrorResponse(validationErr.ValidationErrors),
+		}, nil
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	var data []*product.ListProductResponseItem = make([]*product.ListProductResponseItem, 0)
 	for _, prod := range products {
+		imageUrl, err := ps.storage.URL(ctx, imageprocessor.RenditionFileName(prod.ImageFileName, imageprocessor.RenditionThumbnail))
+		if err != nil {
+			return nil, err
+		}
+
+		_, isOnSale := productSalePrice(prod, now)
 		data = append(data, &product.ListProductResponseItem{
-			Id:          prod.Id,
-			Name:        prod.Name,
-			Description: prod.Description,
-			Price:       prod.Price,
-			ImageUrl:    fmt.Sprintf("%s/product/%s", os.Getenv("STORAGE_SERVICE_URL"), prod.ImageFileName),
+			Id:            prod.Id,
+			Slug:          prod.Slug,
+			Name:          prod.Name,
+			Description:   prod.Description,
+			Price:         converter.Convert(productVariantPrice(prod, nil, now)),
+			ImageUrl:      imageUrl,
+			Stock:         prod.Stock,
+			RatingAverage: prod.RatingAverage,
+			ReviewCount:   prod.ReviewCount,
+			OriginalPrice: converter.Convert(prod.Price),
+			IsOnSale:      isOnSale,
+			Type:          prod.Type,
+		})
+	}
+
+	productFacets, err := ps.productRepository.GetProductFacets(ctx, request.Pagination, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	facets := make([]*product.ListProductResponseFacet, 0)
+	for _, attributeFacet := range productFacets.Attributes {
+		values := make([]*product.ListProductResponseFacetValue, 0)
+		for _, facetValue := range attributeFacet.Values {
+			values = append(values, &product.ListProductResponseFacetValue{
+				Value: facetValue.Value,
+				Count: facetValue.Count,
+			})
+		}
+
+		facets = append(facets, &product.ListProductResponseFacet{
+			Code:   attributeFacet.Code,
+			Name:   attributeFacet.Name,
+			Type:   attributeFacet.Type,
+			Unit:   stringValue(attributeFacet.Unit),
+			Count:  attributeFacet.Count,
+			Values: values,
+			Min:    attributeFacet.MinNumber,
+			Max:    attributeFacet.MaxNumber,
 		})
 	}
 
+	var priceRange *product.ListProductResponsePriceRange
+	if productFacets.MinPrice != nil && productFacets.MaxPrice != nil {
+		priceRange = &product.ListProductResponsePriceRange{
+			Min: converter.Convert(*productFacets.MinPrice),
+			Max: converter.Convert(*productFacets.MaxPrice),
+		}
+	}
+
 	return &product.ListProductResponse{
 		Base:       utils.SuccessResponse("Get List Product Success"),
 		Pagination: paginationResponse,
 		Data:       data,
+		Currency:   converter.currency,
+		Facets:     facets,
+		PriceRange: priceRange,
 	}, nil
 }
 
@@ -218,19 +781,45 @@ func (ps *productService) ListProductAdmin(ctx context.Context, request *product
 		return nil, utils.UnauthenticatedResponse()
 	}
 
-	products, paginationResponse, err := ps.productRepository.GetProductsPaginationAdmin(ctx, request.Pagination)
+	filter, err := ps.buildProductFilter(ctx, request.CategoryId)
+	if err != nil {
+		return nil, err
+	}
+	filter.Status = request.Status
+
+	products, paginationResponse, err := ps.productRepository.GetProductsPaginationAdmin(ctx, request.Pagination, filter)
+	var validationErr *repository.QueryValidationError
+	if errors.As(err, &validationErr) {
+		return &product.ListProductAdminResponse{
+			Base: utils.ValidationErrorResponse(validationErr.ValidationErrors),
+		}, nil
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	var data []*product.ListProductAdminResponseItem = make([]*product.ListProductAdminResponseItem, 0)
 	for _, prod := range products {
+		imageUrl, err := ps.storage.URL(ctx, imageprocessor.RenditionFileName(prod.ImageFileName, imageprocessor.RenditionThumbnail))
+		if err != nil {
+			return nil, err
+		}
+
 		data = append(data, &product.ListProductAdminResponseItem{
-			Id:          prod.Id,
-			Name:        prod.Name,
-			Description: prod.Description,
-			Price:       prod.Price,
-			ImageUrl:    fmt.Sprintf("%s/product/%s", os.Getenv("STORAGE_SERVICE_URL"), prod.ImageFileName),
+			Id:           prod.Id,
+			Slug:         prod.Slug,
+			Name:         prod.Name,
+			Description:  prod.Description,
+			Price:        prod.Price,
+			ImageUrl:     imageUrl,
+			Stock:        prod.Stock,
+			Sku:          stringValue(prod.Sku),
+			SalePrice:    prod.SalePrice,
+			SaleStartsAt: optionalTimestamp(prod.SaleStartsAt),
+			SaleEndsAt:   optionalTimestamp(prod.SaleEndsAt),
+			Status:       prod.Status,
+			PublishAt:    optionalTimestamp(prod.PublishAt),
+			Type:         prod.Type,
 		})
 	}
 
@@ -242,30 +831,1112 @@ func (ps *productService) ListProductAdmin(ctx context.Context, request *product
 }
 
 func (ps *productService) HighlightProducts(ctx context.Context, request *product.HighlightProductRequest) (*product.HighlightProductResponse, error) {
-	products, err := ps.productRepository.GetProductHighlight(ctx)
+	now := time.Now()
+	var collectionEntity *entity.Collection
+	if request.CollectionKey != "" {
+		var err error
+		collectionEntity, err = ps.collectionRepository.GetCollectionByKey(ctx, request.CollectionKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var products []*entity.Product
+	var err error
+	collectionName := ""
+	if collectionEntity != nil && collectionIsActive(collectionEntity, now) {
+		collectionName = collectionEntity.Name
+		products, err = ps.collectionProducts(ctx, collectionEntity, now)
+	} else {
+		products, err = ps.productRepository.GetBestSellerProducts(ctx, nil, defaultHighlightProductLimit)
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	var data []*product.HighlightProductResponseItem = make([]*product.HighlightProductResponseItem, 0)
 	for _, prod := range products {
-		data = append(data, &product.HighlightProductResponseItem{
-			Id:          prod.Id,
-			Name:        prod.Name,
-			Description: prod.Description,
-			Price:       prod.Price,
-			ImageUrl:    fmt.Sprintf("%s/product/%s", os.Getenv("STORAGE_SERVICE_URL"), prod.ImageFileName),
+		imageUrl, err := ps.storage.URL(ctx, imageprocessor.RenditionFileName(prod.ImageFileName, imageprocessor.RenditionThumbnail))
+		if err != nil {
+			return nil, err
+		}
+
+		data = append(data, &product.HighlightProductResponseItem{
+			Id:            prod.Id,
+			Slug:          prod.Slug,
+			Name:          prod.Name,
+			Description:   prod.Description,
+			Price:         productVariantPrice(prod, nil, now),
+			ImageUrl:      imageUrl,
+			OriginalPrice: prod.Price,
 		})
 	}
 
 	return &product.HighlightProductResponse{
-		Base: utils.SuccessResponse("Get Highlight Products Success"),
-		Data: data,
+		Base:           utils.SuccessResponse("Get Highlight Products Success"),
+		Data:           data,
+		CollectionName: collectionName,
+	}, nil
+}
+
+// collectionProducts fills a collection by its rule.
+func (ps *productService) collectionProducts(ctx context.Context, collectionEntity *entity.Collection, now time.Time) ([]*entity.Product, error) {
+	switch collectionEntity.RuleType {
+	case entity.CollectionRuleTypeBestSeller:
+		var since *time.Time
+		if collectionEntity.RuleDays > 0 {
+			t := now.AddDate(0, 0, -int(collectionEntity.RuleDays))
+			since = &t
+		}
+
+		return ps.productRepository.GetBestSellerProducts(ctx, since, collectionEntity.ProductLimit)
+	case entity.CollectionRuleTypeNewest:
+		return ps.productRepository.GetNewestProducts(ctx, collectionEntity.ProductLimit)
+	default:
+		return ps.productRepository.GetCollectionProducts(ctx, collectionEntity.Id, collectionEntity.ProductLimit)
+	}
+}
+
+func (ps *productService) CreateProductVariant(ctx context.Context, request *product.CreateProductVariantRequest) (*product.CreateProductVariantResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	productEntity, err := ps.productRepository.GetProductById(ctx, request.ProductId)
+	if err != nil {
+		return nil, err
+	}
+	if productEntity == nil {
+		return &product.CreateProductVariantResponse{
+			Base: utils.NotFoundResponse("Product not found"),
+		}, nil
+	}
+	if productEntity.Type == entity.ProductTypeBundle {
+		return &product.CreateProductVariantResponse{
+			Base: utils.BadRequestResponse("Bundles can not have variants"),
+		}, nil
+	}
+	if productEntity.Type == entity.ProductTypeDigital {
+		return &product.CreateProductVariantResponse{
+			Base: utils.BadRequestResponse("Digital products can not have variants"),
+		}, nil
+	}
+
+	bundleCount, err := ps.productRepository.CountProductBundles(ctx, productEntity.Id)
+	if err != nil {
+		return nil, err
+	}
+	if bundleCount > 0 {
+		return &product.CreateProductVariantResponse{
+			Base: utils.BadRequestResponse("Product is part of a bundle and can not have variants"),
+		}, nil
+	}
+
+	skuVariant, err := ps.productRepository.GetProductVariantBySku(ctx, request.Sku)
+	if err != nil {
+		return nil, err
+	}
+	if skuVariant != nil {
+		return &product.CreateProductVariantResponse{
+			Base: utils.BadRequestResponse("SKU already exists"),
+		}, nil
+	}
+
+	var imageFileName *string
+	if request.ImageFileName != "" {
+		imageExists, err := ps.storage.Exists(ctx, request.ImageFileName)
+		if err != nil {
+			return nil, err
+		}
+		if !imageExists {
+			return &product.CreateProductVariantResponse{
+				Base: utils.BadRequestResponse("Image not found"),
+			}, nil
+		}
+
+		imageFileName = &request.ImageFileName
+	}
+
+	variantEntity := entity.ProductVariant{
+		Id:            uuid.NewString(),
+		ProductId:     productEntity.Id,
+		Sku:           request.Sku,
+		Attributes:    request.Attributes,
+		Price:         request.Price,
+		ImageFileName: imageFileName,
+		Stock:         request.Stock,
+		CreatedAt:     time.Now(),
+		CreatedBy:     claims.Fullname,
+	}
+	err = ps.productRepository.CreateProductVariant(ctx, &variantEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product.CreateProductVariantResponse{
+		Base: utils.SuccessResponse("Product variant is created"),
+		Id:   variantEntity.Id,
+	}, nil
+}
+
+func (ps *productService) EditProductVariant(ctx context.Context, request *product.EditProductVariantRequest) (*product.EditProductVariantResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	variantEntity, err := ps.productRepository.GetProductVariantById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if variantEntity == nil {
+		return &product.EditProductVariantResponse{
+			Base: utils.NotFoundResponse("Product variant not found"),
+		}, nil
+	}
+
+	skuVariant, err := ps.productRepository.GetProductVariantBySku(ctx, request.Sku)
+	if err != nil {
+		return nil, err
+	}
+	if skuVariant != nil && skuVariant.Id != variantEntity.Id {
+		return &product.EditProductVariantResponse{
+			Base: utils.BadRequestResponse("SKU already exists"),
+		}, nil
+	}
+
+	var imageFileName *string
+	if request.ImageFileName != "" {
+		imageExists, err := ps.storage.Exists(ctx, request.ImageFileName)
+		if err != nil {
+			return nil, err
+		}
+		if !imageExists {
+			return &product.EditProductVariantResponse{
+				Base: utils.BadRequestResponse("Image not found"),
+			}, nil
+		}
+
+		imageFileName = &request.ImageFileName
+	}
+
+	oldImageFileName := variantEntity.ImageFileName
+	now := time.Now()
+	variantEntity.Sku = request.Sku
+	variantEntity.Attributes = request.Attributes
+	variantEntity.Price = request.Price
+	variantEntity.ImageFileName = imageFileName
+	variantEntity.Stock = request.Stock
+	variantEntity.UpdatedAt = &now
+	variantEntity.UpdatedBy = &claims.Fullname
+
+	err = ps.productRepository.UpdateProductVariant(ctx, variantEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	if oldImageFileName != nil && (imageFileName == nil || *oldImageFileName != *imageFileName) {
+		err = ps.removeUnusedImage(ctx, *oldImageFileName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &product.EditProductVariantResponse{
+		Base: utils.SuccessResponse("Edit Product Variant Success"),
+		Id:   variantEntity.Id,
+	}, nil
+}
+
+func (ps *productService) DeleteProductVariant(ctx context.Context, request *product.DeleteProductVariantRequest) (*product.DeleteProductVariantResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	variantEntity, err := ps.productRepository.GetProductVariantById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if variantEntity == nil {
+		return &product.DeleteProductVariantResponse{
+			Base: utils.NotFoundResponse("Product variant not found"),
+		}, nil
+	}
+
+	err = ps.productRepository.DeleteProductVariant(ctx, request.Id, time.Now(), claims.Fullname)
+	if err != nil {
+		return nil, err
+	}
+
+	if variantEntity.ImageFileName != nil {
+		err = ps.removeUnusedImage(ctx, *variantEntity.ImageFileName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &product.DeleteProductVariantResponse{
+		Base: utils.SuccessResponse("Delete Product Variant Success"),
+	}, nil
+}
+
+func (ps *productService) AddProductImage(ctx context.Context, request *product.AddProductImageRequest) (*product.AddProductImageResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	productEntity, err := ps.productRepository.GetProductById(ctx, request.ProductId)
+	if err != nil {
+		return nil, err
+	}
+	if productEntity == nil {
+		return &product.AddProductImageResponse{
+			Base: utils.NotFoundResponse("Product not found"),
+		}, nil
+	}
+
+	imageExists, err := ps.storage.Exists(ctx, request.ImageFileName)
+	if err != nil {
+		return nil, err
+	}
+	if !imageExists {
+		return &product.AddProductImageResponse{
+			Base: utils.BadRequestResponse("Image not found"),
+		}, nil
+	}
+
+	images, err := ps.productRepository.GetProductImagesByProductId(ctx, productEntity.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	imageEntity := entity.ProductImage{
+		Id:            uuid.NewString(),
+		ProductId:     productEntity.Id,
+		ImageFileName: request.ImageFileName,
+		Position:      len(images),
+		CreatedAt:     time.Now(),
+		CreatedBy:     claims.Fullname,
+	}
+	err = ps.runInTransaction(func(productRepo repository.IProductRepository) error {
+		err := productRepo.CreateProductImage(ctx, &imageEntity)
+		if err != nil || len(images) > 0 {
+			return err
+		}
+
+		return productRepo.SetPrimaryProductImage(ctx, productEntity.Id, imageEntity.Id)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &product.AddProductImageResponse{
+		Base: utils.SuccessResponse("Add Product Image Success"),
+		Id:   imageEntity.Id,
+	}, nil
+}
+
+func (ps *productService) RemoveProductImage(ctx context.Context, request *product.RemoveProductImageRequest) (*product.RemoveProductImageResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	imageEntity, err := ps.productRepository.GetProductImageById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if imageEntity == nil {
+		return &product.RemoveProductImageResponse{
+			Base: utils.NotFoundResponse("Product image not found"),
+		}, nil
+	}
+
+	images, err := ps.productRepository.GetProductImagesByProductId(ctx, imageEntity.ProductId)
+	if err != nil {
+		return nil, err
+	}
+	if len(images) <= 1 {
+		return &product.RemoveProductImageResponse{
+			Base: utils.BadRequestResponse("Product must have at least one image"),
+		}, nil
+	}
+
+	now := time.Now()
+	err = ps.runInTransaction(func(productRepo repository.IProductRepository) error {
+		err := productRepo.DeleteProductImage(ctx, imageEntity.Id)
+		if err != nil {
+			return err
+		}
+
+		remaining := slices.DeleteFunc(images, func(image *entity.ProductImage) bool {
+			return image.Id == imageEntity.Id
+		})
+		for i, image := range remaining {
+			if image.Position == i {
+				continue
+			}
+
+			image.Position = i
+			image.UpdatedAt = &now
+			image.UpdatedBy = &claims.Fullname
+			err = productRepo.UpdateProductImage(ctx, image)
+			if err != nil {
+				return err
+			}
+		}
+
+		if imageEntity.IsPrimary {
+			return productRepo.SetPrimaryProductImage(ctx, imageEntity.ProductId, remaining[0].Id)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = ps.removeUnusedImage(ctx, imageEntity.ImageFileName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product.RemoveProductImageResponse{
+		Base: utils.SuccessResponse("Remove Product Image Success"),
+	}, nil
+}
+
+func (ps *productService) ReorderProductImages(ctx context.Context, request *product.ReorderProductImagesRequest) (*product.ReorderProductImagesResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	images, err := ps.productRepository.GetProductImagesByProductId(ctx, request.ProductId)
+	if err != nil {
+		return nil, err
+	}
+	if len(images) == 0 {
+		return &product.ReorderProductImagesResponse{
+			Base: utils.NotFoundResponse("Product not found"),
+		}, nil
+	}
+
+	imageMap := make(map[string]*entity.ProductImage)
+	for _, image := range images {
+		imageMap[image.Id] = image
+	}
+	if len(request.ImageIds) != len(images) {
+		return &product.ReorderProductImagesResponse{
+			Base: utils.BadRequestResponse("Image ids must contain every product image"),
+		}, nil
+	}
+	for _, imageId := range request.ImageIds {
+		if imageMap[imageId] == nil {
+			return &product.ReorderProductImagesResponse{
+				Base: utils.BadRequestResponse(fmt.Sprintf("Product image %s not found", imageId)),
+			}, nil
+		}
+	}
+
+	now := time.Now()
+	err = ps.runInTransaction(func(productRepo repository.IProductRepository) error {
+		for i, imageId := range request.ImageIds {
+			image := imageMap[imageId]
+			if image.Position == i {
+				continue
+			}
+
+			image.Position = i
+			image.UpdatedAt = &now
+			image.UpdatedBy = &claims.Fullname
+			err := productRepo.UpdateProductImage(ctx, image)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &product.ReorderProductImagesResponse{
+		Base: utils.SuccessResponse("Reorder Product Images Success"),
 	}, nil
 }
 
-func NewProductService(productRepository repository.IProductRepository) IProductService {
+func (ps *productService) SetPrimaryProductImage(ctx context.Context, request *product.SetPrimaryProductImageRequest) (*product.SetPrimaryProductImageResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	imageEntity, err := ps.productRepository.GetProductImageById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if imageEntity == nil {
+		return &product.SetPrimaryProductImageResponse{
+			Base: utils.NotFoundResponse("Product image not found"),
+		}, nil
+	}
+
+	if !imageEntity.IsPrimary {
+		err = ps.runInTransaction(func(productRepo repository.IProductRepository) error {
+			return productRepo.SetPrimaryProductImage(ctx, imageEntity.ProductId, imageEntity.Id)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &product.SetPrimaryProductImageResponse{
+		Base: utils.SuccessResponse("Set Primary Product Image Success"),
+	}, nil
+}
+
+func (ps *productService) ListProductPriceHistory(ctx context.Context, request *product.ListProductPriceHistoryRequest) (*product.ListProductPriceHistoryResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	histories, paginationResponse, err := ps.productRepository.GetProductPriceHistoryPagination(ctx, request.ProductId, request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*product.ListProductPriceHistoryResponseItem, 0)
+	for _, history := range histories {
+		items = append(items, &product.ListProductPriceHistoryResponseItem{
+			Id:           history.Id,
+			Price:        history.Price,
+			SalePrice:    history.SalePrice,
+			SaleStartsAt: optionalTimestamp(history.SaleStartsAt),
+			SaleEndsAt:   optionalTimestamp(history.SaleEndsAt),
+			CreatedAt:    timestamppb.New(history.CreatedAt),
+			CreatedBy:    history.CreatedBy,
+		})
+	}
+
+	return &product.ListProductPriceHistoryResponse{
+		Base:       utils.SuccessResponse("Get List Product Price History Success"),
+		Pagination: paginationResponse,
+		Items:      items,
+	}, nil
+}
+
+// createProductWithImage inserts the product together with its image as the
+// primary one of the gallery. A product without a slug gets one generated from
+// its name.
+func createProductWithImage(ctx context.Context, productRepo repository.IProductRepository, productEntity *entity.Product) error {
+	if productEntity.Slug == "" {
+		slug, err := uniqueProductSlug(ctx, productRepo, productSlugFromName(productEntity.Name), productEntity.Id)
+		if err != nil {
+			return err
+		}
+		productEntity.Slug = slug
+	}
+
+	err := productRepo.CreateNewProduct(ctx, productEntity)
+	if err != nil {
+		return err
+	}
+
+	err = productRepo.CreateProductPriceHistory(ctx, newProductPriceHistory(productEntity, productEntity.CreatedAt, productEntity.CreatedBy))
+	if err != nil {
+		return err
+	}
+
+	return productRepo.CreateProductImage(ctx, &entity.ProductImage{
+		Id:            uuid.NewString(),
+		ProductId:     productEntity.Id,
+		ImageFileName: productEntity.ImageFileName,
+		Position:      0,
+		IsPrimary:     true,
+		CreatedAt:     productEntity.CreatedAt,
+		CreatedBy:     productEntity.CreatedBy,
+	})
+}
+
+// errProductStockChanged is returned when a product's stock changed between
+// reading and updating it.
+var errProductStockChanged = errors.New("product stock changed")
+
+// updateProductWithImage updates the product from its previous state, a
+// changed image replaces the primary one in the gallery, a changed price is
+// added to the price history and a replaced slug is kept in the slug history.
+// A product moved to another category loses its attribute values, they were
+// set for the attributes of the previous one. It fails with
+// errProductStockChanged when the stock is no longer previousStock.
+func updateProductWithImage(ctx context.Context, productRepo repository.IProductRepository, productEntity *entity.Product, previous *entity.Product, previousStock int64) error {
+	if previous.Slug != productEntity.Slug {
+		// the product may take back one of its own previous slugs
+		err := productRepo.DeleteProductSlugHistory(ctx, productEntity.Id, productEntity.Slug)
+		if err != nil {
+			return err
+		}
+
+		err = productRepo.CreateProductSlugHistory(ctx, &entity.ProductSlugHistory{
+			Id:        uuid.NewString(),
+			ProductId: productEntity.Id,
+			Slug:      previous.Slug,
+			CreatedAt: productEntity.UpdatedAt,
+			CreatedBy: *productEntity.UpdatedBy,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	updated, err := productRepo.UpdateProduct(ctx, productEntity, previousStock)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return errProductStockChanged
+	}
+
+	if stringValue(previous.CategoryId) != stringValue(productEntity.CategoryId) {
+		err = productRepo.ReplaceProductAttributeValues(ctx, productEntity.Id, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	if productPriceChanged(previous, productEntity) {
+		err = productRepo.CreateProductPriceHistory(ctx, newProductPriceHistory(productEntity, productEntity.UpdatedAt, *productEntity.UpdatedBy))
+		if err != nil {
+			return err
+		}
+	}
+
+	if previous.ImageFileName == productEntity.ImageFileName {
+		return nil
+	}
+
+	images, err := productRepo.GetProductImagesByProductId(ctx, productEntity.Id)
+	if err != nil {
+		return err
+	}
+	for _, image := range images {
+		if image.IsPrimary {
+			image.ImageFileName = productEntity.ImageFileName
+			image.UpdatedAt = &productEntity.UpdatedAt
+			image.UpdatedBy = productEntity.UpdatedBy
+
+			return productRepo.UpdateProductImage(ctx, image)
+		}
+	}
+
+	return nil
+}
+
+// runInTransaction runs fn with a product repository bound to a new
+// transaction, committing when fn succeeds and rolling back otherwise.
+func (ps *productService) runInTransaction(fn func(productRepo repository.IProductRepository) error) (err error) {
+	tx, err := ps.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if e := recover(); e != nil {
+			tx.Rollback()
+
+			debug.PrintStack()
+			panic(e)
+		}
+	}()
+
+	err = fn(ps.productRepository.WithTransaction(tx))
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// removeUnusedImage deletes the stored file and its renditions once no
+// product, gallery entry, variant or order item refers to it anymore.
+func (ps *productService) removeUnusedImage(ctx context.Context, imageFileName string) error {
+	count, err := ps.productRepository.CountImageFileNameReferences(ctx, imageFileName)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+
+	for _, fileName := range imageprocessor.FileNames(imageFileName) {
+		err = ps.storage.Delete(ctx, fileName)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// buildProductBundleItems checks the components requested for a bundle. It
+// returns a message for the client when they can not make up a bundle.
+func (ps *productService) buildProductBundleItems(ctx context.Context, bundleProductId string, requestItems []*product.ProductBundleItemRequest, createdAt time.Time, createdBy string) ([]*entity.ProductBundleItem, string, error) {
+	if len(requestItems) == 0 {
+		return nil, "Bundle must contain at least one product", nil
+	}
+
+	productIds := make([]string, len(requestItems))
+	for i, requestItem := range requestItems {
+		if slices.Contains(productIds[:i], requestItem.ProductId) {
+			return nil, fmt.Sprintf("Product %s is listed twice in the bundle", requestItem.ProductId), nil
+		}
+		if requestItem.ProductId == bundleProductId {
+			return nil, "Bundle can not contain itself", nil
+		}
+
+		productIds[i] = requestItem.ProductId
+	}
+
+	products, err := ps.productRepository.GetProductsByIds(ctx, productIds)
+	if err != nil {
+		return nil, "", err
+	}
+
+	productMap := make(map[string]*entity.Product)
+	for _, prod := range products {
+		productMap[prod.Id] = prod
+	}
+
+	items := make([]*entity.ProductBundleItem, 0)
+	for _, requestItem := range requestItems {
+		prod := productMap[requestItem.ProductId]
+		if prod == nil {
+			return nil, fmt.Sprintf("Product %s not found", requestItem.ProductId), nil
+		}
+		// a component is reserved by product stock alone, so it can have
+		// neither variants nor components of its own and must have stock
+		if prod.Type == entity.ProductTypeBundle {
+			return nil, fmt.Sprintf("Product %s is a bundle itself", prod.Name), nil
+		}
+		if prod.HasVariants {
+			return nil, fmt.Sprintf("Product %s has variants and can not be bundled", prod.Name), nil
+		}
+		if prod.Type == entity.ProductTypeDigital {
+			return nil, fmt.Sprintf("Product %s is digital and can not be bundled", prod.Name), nil
+		}
+
+		items = append(items, &entity.ProductBundleItem{
+			Id:              uuid.NewString(),
+			BundleProductId: bundleProductId,
+			ProductId:       prod.Id,
+			Quantity:        requestItem.Quantity,
+			CreatedAt:       createdAt,
+			CreatedBy:       createdBy,
+		})
+	}
+
+	return items, "", nil
+}
+
+// buildProductAttributeValues checks the attribute values requested for a
+// product in the category with categoryId. Only attributes of the category or
+// its parents can be set. It returns a message for the client when a value can
+// not be set.
+func (ps *productService) buildProductAttributeValues(ctx context.Context, productId string, categoryId *string, requestValues []*product.ProductAttributeValueRequest) ([]*entity.ProductAttributeValue, string, error) {
+	if len(requestValues) == 0 {
+		return nil, "", nil
+	}
+	if categoryId == nil {
+		return nil, "Product without a category can not have attributes", nil
+	}
+
+	ancestors, err := ps.categoryRepository.GetCategoryAncestors(ctx, *categoryId)
+	if err != nil {
+		return nil, "", err
+	}
+	ancestorIds := make([]string, 0)
+	for _, ancestor := range ancestors {
+		ancestorIds = append(ancestorIds, ancestor.Id)
+	}
+
+	attributes, err := ps.categoryRepository.GetCategoryAttributesByCategoryIds(ctx, ancestorIds)
+	if err != nil {
+		return nil, "", err
+	}
+	attributeMap := make(map[string]*entity.CategoryAttribute)
+	for _, attribute := range attributes {
+		attributeMap[attribute.Id] = attribute
+	}
+
+	values := make([]*entity.ProductAttributeValue, 0)
+	for i, requestValue := range requestValues {
+		attribute := attributeMap[requestValue.AttributeId]
+		if attribute == nil {
+			return nil, fmt.Sprintf("Attribute %s not found in the product category", requestValue.AttributeId), nil
+		}
+		if slices.ContainsFunc(requestValues[:i], func(previous *product.ProductAttributeValueRequest) bool {
+			return previous.AttributeId == requestValue.AttributeId
+		}) {
+			return nil, fmt.Sprintf("Attribute %s is set twice", attribute.Name), nil
+		}
+
+		value := entity.ProductAttributeValue{
+			ProductId:   productId,
+			AttributeId: attribute.Id,
+			ValueText:   strings.TrimSpace(requestValue.Value),
+		}
+		switch attribute.Type {
+		case entity.CategoryAttributeTypeNumber:
+			number, err := strconv.ParseFloat(value.ValueText, 64)
+			if err != nil || math.IsNaN(number) || math.IsInf(number, 0) {
+				return nil, fmt.Sprintf("Attribute %s must be a number", attribute.Name), nil
+			}
+
+			// numbers are kept in one notation so equal values facet together
+			value.ValueText = strconv.FormatFloat(number, 'f', -1, 64)
+			value.ValueNumber = &number
+		case entity.CategoryAttributeTypeBoolean:
+			if value.ValueText != "true" && value.ValueText != "false" {
+				return nil, fmt.Sprintf("Attribute %s must be true or false", attribute.Name), nil
+			}
+		}
+		if value.ValueText == "" {
+			return nil, fmt.Sprintf("Attribute %s must have a value", attribute.Name), nil
+		}
+
+		values = append(values, &value)
+	}
+
+	return values, "", nil
+}
+
+// resolveCategoryId returns nil when categoryId is empty or does not exist.
+func (ps *productService) resolveCategoryId(ctx context.Context, categoryId string) (*string, error) {
+	if categoryId == "" {
+		return nil, nil
+	}
+
+	categoryEntity, err := ps.categoryRepository.GetCategoryById(ctx, categoryId)
+	if err != nil {
+		return nil, err
+	}
+	if categoryEntity == nil {
+		return nil, nil
+	}
+
+	return &categoryEntity.Id, nil
+}
+
+func (ps *productService) buildProductFilter(ctx context.Context, categoryId string) (*repository.ProductFilter, error) {
+	filter := repository.ProductFilter{}
+	if categoryId != "" {
+		categoryIds, err := ps.categoryRepository.GetCategoryDescendantIds(ctx, categoryId)
+		if err != nil {
+			return nil, err
+		}
+
+		filter.CategoryIds = categoryIds
+	}
+
+	return &filter, nil
+}
+
+// checkProductSlug returns why slug can not be used by the product with
+// productId, or an empty string when it can. An empty productId stands for a
+// new product.
+func (ps *productService) checkProductSlug(ctx context.Context, slug string, productId string) (string, error) {
+	if uuid.Validate(slug) == nil {
+		return "Slug must not look like a product id", nil
+	}
+
+	slugProductId, err := ps.productRepository.GetSlugProductId(ctx, slug)
+	if err != nil {
+		return "", err
+	}
+	if slugProductId != nil && *slugProductId != productId {
+		return "Slug already exists", nil
+	}
+
+	return "", nil
+}
+
+// productSlugMaxLength leaves room in the slug column for the suffix
+// uniqueProductSlug may append.
+const productSlugMaxLength = 200
+
+// productSlugFromName lowercases name, strips accents and joins its runs of
+// ascii letters and digits with dashes, e.g. "Kaos Polos (Hitam)" becomes
+// "kaos-polos-hitam".
+func productSlugFromName(name string) string {
+	// decomposing splits accented letters into the letter and its marks
+	name = strings.Map(func(r rune) rune {
+		if unicode.Is(unicode.Mn, r) {
+			return -1
+		}
+
+		return r
+	}, norm.NFD.String(strings.ToLower(name)))
+
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return (r < 'a' || r > 'z') && (r < '0' || r > '9')
+	})
+
+	slug := strings.Join(words, "-")
+	if len(slug) > productSlugMaxLength {
+		slug = strings.TrimRight(slug[:productSlugMaxLength], "-")
+	}
+	// a slug must never be mistaken for an id by DetailProduct
+	if slug == "" || uuid.Validate(slug) == nil {
+		slug = strings.TrimSuffix("product-"+slug, "-")
+	}
+
+	return slug
+}
+
+// uniqueProductSlug returns slug, or slug with the first free numeric suffix
+// when another product has used it.
+func uniqueProductSlug(ctx context.Context, productRepo repository.IProductRepository, slug string, productId string) (string, error) {
+	candidate := slug
+	for i := 2; ; i++ {
+		slugProductId, err := productRepo.GetSlugProductId(ctx, candidate)
+		if err != nil {
+			return "", err
+		}
+		if slugProductId == nil || *slugProductId == productId {
+			return candidate, nil
+		}
+
+		candidate = fmt.Sprintf("%s-%d", slug, i)
+	}
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
+
+// productVariantOriginalPrice returns the variant's price override, or the
+// product price when there is no variant or it has no override.
+func productVariantOriginalPrice(prod *entity.Product, variant *entity.ProductVariant) float64 {
+	if variant == nil || variant.Price == nil {
+		return prod.Price
+	}
+
+	return *variant.Price
+}
+
+// productVariantPrice returns what the product or its variant costs at now. A
+// running sale replaces the product price, variants with their own price get
+// the same relative discount.
+func productVariantPrice(prod *entity.Product, variant *entity.ProductVariant, now time.Time) float64 {
+	originalPrice := productVariantOriginalPrice(prod, variant)
+	salePrice, isOnSale := productSalePrice(prod, now)
+	if !isOnSale {
+		return originalPrice
+	}
+	if variant == nil || variant.Price == nil {
+		return salePrice
+	}
+	if prod.Price == 0 {
+		return originalPrice
+	}
+
+	return math.Round(originalPrice*salePrice/prod.Price*100) / 100
+}
+
+// productSalePrice returns the sale price of prod and whether the sale is
+// running at now. The start is inclusive and the end exclusive.
+func productSalePrice(prod *entity.Product, now time.Time) (float64, bool) {
+	if prod.SalePrice == nil {
+		return 0, false
+	}
+	if prod.SaleStartsAt != nil && now.Before(*prod.SaleStartsAt) {
+		return 0, false
+	}
+	if prod.SaleEndsAt != nil && !now.Before(*prod.SaleEndsAt) {
+		return 0, false
+	}
+
+	return *prod.SalePrice, true
+}
+
+// productIsPublished reports whether customers can see and buy prod at now.
+func productIsPublished(prod *entity.Product, now time.Time) bool {
+	if prod.Status != entity.ProductStatusPublished {
+		return false
+	}
+
+	return prod.PublishAt == nil || !now.Before(*prod.PublishAt)
+}
+
+// productStatusOrDefault returns status, or fallback when it is left empty.
+func productStatusOrDefault(status string, fallback string) string {
+	if status == "" {
+		return fallback
+	}
+
+	return status
+}
+
+// validateProductSale returns why a sale can not be set on a product, or an
+// empty string when it can.
+func validateProductSale(price float64, salePrice *float64, saleStartsAt *time.Time, saleEndsAt *time.Time) string {
+	if salePrice == nil {
+		if saleStartsAt != nil || saleEndsAt != nil {
+			return "Sale price is required when a sale period is set"
+		}
+
+		return ""
+	}
+	if *salePrice >= price {
+		return "Sale price must be lower than the price"
+	}
+	if saleStartsAt != nil && saleEndsAt != nil && !saleEndsAt.After(*saleStartsAt) {
+		return "Sale end must be after the sale start"
+	}
+
+	return ""
+}
+
+// storedProductStock returns the stock kept on the product row. A bundle
+// keeps none, the stock it is read with comes from its components.
+func storedProductStock(prod *entity.Product) int64 {
+	if prod.Type == entity.ProductTypeBundle {
+		return 0
+	}
+
+	return prod.Stock
+}
+
+// validateProductOrderQuantity returns why the order quantity limits can not
+// be used, or an empty string when they can.
+func validateProductOrderQuantity(minQuantity *int64, maxQuantity *int64) string {
+	if minQuantity != nil && maxQuantity != nil && *minQuantity > *maxQuantity {
+		return "Minimum order quantity can not be more than the maximum"
+	}
+
+	return ""
+}
+
+// productOrderQuantityMessage returns why quantity units of the product, all
+// its variants together, can not be ordered at once, or an empty string when
+// they can.
+func productOrderQuantityMessage(prod *entity.Product, quantity int64) string {
+	if prod.MinOrderQuantity != nil && quantity < *prod.MinOrderQuantity {
+		return fmt.Sprintf("Product %s must be ordered at least %d at a time", prod.Name, *prod.MinOrderQuantity)
+	}
+	if prod.MaxOrderQuantity != nil && quantity > *prod.MaxOrderQuantity {
+		return fmt.Sprintf("Product %s can be ordered at most %d at a time", prod.Name, *prod.MaxOrderQuantity)
+	}
+
+	return ""
+}
+
+func productPriceChanged(previous *entity.Product, current *entity.Product) bool {
+	return previous.Price != current.Price ||
+		!equalOptional(previous.SalePrice, current.SalePrice, func(a, b float64) bool { return a == b }) ||
+		!equalOptional(previous.SaleStartsAt, current.SaleStartsAt, time.Time.Equal) ||
+		!equalOptional(previous.SaleEndsAt, current.SaleEndsAt, time.Time.Equal)
+}
+
+func equalOptional[T any](a *T, b *T, equal func(T, T) bool) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	return equal(*a, *b)
+}
+
+func newProductPriceHistory(prod *entity.Product, createdAt time.Time, createdBy string) *entity.ProductPriceHistory {
+	return &entity.ProductPriceHistory{
+		Id:           uuid.NewString(),
+		ProductId:    prod.Id,
+		Price:        prod.Price,
+		SalePrice:    prod.SalePrice,
+		SaleStartsAt: prod.SaleStartsAt,
+		SaleEndsAt:   prod.SaleEndsAt,
+		CreatedAt:    createdAt,
+		CreatedBy:    createdBy,
+	}
+}
+
+func optionalTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+
+	t := ts.AsTime()
+	return &t
+}
+
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
+
+// formatVariantAttributes renders attributes as "colour: red, size: M" with
+// the keys sorted so the output is stable.
+func formatVariantAttributes(attributes entity.ProductVariantAttributes) string {
+	keys := slices.Sorted(maps.Keys(attributes))
+	parts := make([]string, len(keys))
+	for i, key := range keys {
+		parts[i] = fmt.Sprintf("%s: %s", key, attributes[key])
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+func NewProductService(db *sql.DB, productRepository repository.IProductRepository, categoryRepository repository.ICategoryRepository, collectionRepository repository.ICollectionRepository, exchangeRateRepository repository.IExchangeRateRepository, storage storage.IStorage, digitalStorage storage.IStorage) IProductService {
 	return &productService{
-		productRepository: productRepository,
+		db:                     db,
+		productRepository:      productRepository,
+		categoryRepository:     categoryRepository,
+		collectionRepository:   collectionRepository,
+		exchangeRateRepository: exchangeRateRepository,
+		storage:                storage,
+		digitalStorage:         digitalStorage,
 	}
 }
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/xryar/golang-grpc-ecommerce/internal/entity"
+	jwtentity "github.com/xryar/golang-grpc-ecommerce/internal/entity/jwt"
+	"github.com/xryar/golang-grpc-ecommerce/internal/imageprocessor"
+	"github.com/xryar/golang-grpc-ecommerce/internal/repository"
+	"github.com/xryar/golang-grpc-ecommerce/internal/utils"
+	"github.com/xryar/golang-grpc-ecommerce/pb/product"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (ps *productService) ListDeletedProduct(ctx context.Context, request *product.ListDeletedProductRequest) (*product.ListDeletedProductResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	products, paginationResponse, err := ps.productRepository.GetDeletedProductsPagination(ctx, request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]*product.ListDeletedProductResponseItem, 0)
+	for _, prod := range products {
+		imageUrl, err := ps.storage.URL(ctx, imageprocessor.RenditionFileName(prod.ImageFileName, imageprocessor.RenditionThumbnail))
+		if err != nil {
+			return nil, err
+		}
+
+		data = append(data, &product.ListDeletedProductResponseItem{
+			Id:          prod.Id,
+			Name:        prod.Name,
+			Description: prod.Description,
+			Price:       prod.Price,
+			ImageUrl:    imageUrl,
+			Stock:       prod.Stock,
+			Sku:         stringValue(prod.Sku),
+			DeletedAt:   timestamppb.New(prod.DeletedAt),
+			DeletedBy:   stringValue(prod.DeletedBy),
+		})
+	}
+
+	return &product.ListDeletedProductResponse{
+		Base:       utils.SuccessResponse("Get List Deleted Product Success"),
+		Pagination: paginationResponse,
+		Data:       data,
+	}, nil
+}
+
+func (ps *productService) RestoreProduct(ctx context.Context, request *product.RestoreProductRequest) (*product.RestoreProductResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	productEntity, err := ps.productRepository.GetDeletedProductById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if productEntity == nil {
+		return &product.RestoreProductResponse{
+			Base: utils.NotFoundResponse("Deleted product not found"),
+		}, nil
+	}
+
+	// the sku may have been given to another product since this one was deleted
+	if productEntity.Sku != nil {
+		skuProduct, err := ps.productRepository.GetProductBySku(ctx, *productEntity.Sku)
+		if err != nil {
+			return nil, err
+		}
+		if skuProduct != nil {
+			return &product.RestoreProductResponse{
+				Base: utils.BadRequestResponse("SKU already exists"),
+			}, nil
+		}
+	}
+
+	err = ps.productRepository.RestoreProduct(ctx, productEntity.Id, time.Now(), claims.Fullname)
+	if err != nil {
+		return nil, err
+	}
+
+	return &product.RestoreProductResponse{
+		Base: utils.SuccessResponse("Restore Product Success"),
+	}, nil
+}
+
+// PurgeProduct permanently removes a product from the trash bin. Its image
+// files are removed too unless something else, like an order, still uses them.
+func (ps *productService) PurgeProduct(ctx context.Context, request *product.PurgeProductRequest) (*product.PurgeProductResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	productEntity, err := ps.productRepository.GetDeletedProductById(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if productEntity == nil {
+		return &product.PurgeProductResponse{
+			Base: utils.NotFoundResponse("Deleted product not found"),
+		}, nil
+	}
+
+	bundleCount, err := ps.productRepository.CountProductBundles(ctx, productEntity.Id)
+	if err != nil {
+		return nil, err
+	}
+	if bundleCount > 0 {
+		return &product.PurgeProductResponse{
+			Base: utils.BadRequestResponse("Product is part of a bundle"),
+		}, nil
+	}
+
+	imageFileNames := []string{productEntity.ImageFileName}
+	images, err := ps.productRepository.GetProductImagesByProductId(ctx, productEntity.Id)
+	if err != nil {
+		return nil, err
+	}
+	for _, image := range images {
+		imageFileNames = append(imageFileNames, image.ImageFileName)
+	}
+	variants, err := ps.productRepository.GetProductVariantsByProductId(ctx, productEntity.Id)
+	if err != nil {
+		return nil, err
+	}
+	for _, variant := range variants {
+		if variant.ImageFileName != nil {
+			imageFileNames = append(imageFileNames, *variant.ImageFileName)
+		}
+	}
+
+	err = ps.runInTransaction(func(productRepo repository.IProductRepository) error {
+		return productRepo.PurgeProduct(ctx, productEntity.Id)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	removed := make(map[string]bool)
+	for _, imageFileName := range imageFileNames {
+		if removed[imageFileName] {
+			continue
+		}
+		removed[imageFileName] = true
+
+		err = ps.removeUnusedImage(ctx, imageFileName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &product.PurgeProductResponse{
+		Base: utils.SuccessResponse("Purge Product Success"),
+	}, nil
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"time"
+
+	"github.com/xryar/golang-grpc-ecommerce/internal/entity"
+	jwtentity "github.com/xryar/golang-grpc-ecommerce/internal/entity/jwt"
+	"github.com/xryar/golang-grpc-ecommerce/internal/imageprocessor"
+	"github.com/xryar/golang-grpc-ecommerce/internal/repository"
+	"github.com/xryar/golang-grpc-ecommerce/internal/storage"
+	"github.com/xryar/golang-grpc-ecommerce/internal/utils"
+	"github.com/xryar/golang-grpc-ecommerce/pb/recommendation"
+)
+
+const defaultRecommendationLimit = 5
+
+// recommendationOrderStatusCodes are the orders that count as bought.
+var recommendationOrderStatusCodes = []string{
+	entity.OrderStatusCodePaid,
+	entity.OrderStatusCodeShipped,
+	entity.OrderStatusCodeDone,
+}
+
+type IRecommendationService interface {
+	FrequentlyBoughtTogether(ctx context.Context, request *recommendation.FrequentlyBoughtTogetherRequest) (*recommendation.FrequentlyBoughtTogetherResponse, error)
+	CartRecommendation(ctx context.Context, request *recommendation.CartRecommendationRequest) (*recommendation.CartRecommendationResponse, error)
+	RefreshRecommendations(ctx context.Context) error
+}
+
+type recommendationService struct {
+	db                       *sql.DB
+	recommendationRepository repository.IRecommendationRepository
+	cartRepository           repository.ICartRepository
+	storage                  storage.IStorage
+}
+
+func (rs *recommendationService) FrequentlyBoughtTogether(ctx context.Context, request *recommendation.FrequentlyBoughtTogetherRequest) (*recommendation.FrequentlyBoughtTogetherResponse, error) {
+	data, err := rs.recommendations(ctx, []string{request.ProductId}, request.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &recommendation.FrequentlyBoughtTogetherResponse{
+		Base: utils.SuccessResponse("Get Frequently Bought Together Success"),
+		Data: data,
+	}, nil
+}
+
+func (rs *recommendationService) CartRecommendation(ctx context.Context, request *recommendation.CartRecommendationRequest) (*recommendation.CartRecommendationResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	carts, err := rs.cartRepository.GetListCart(ctx, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	productIds := make([]string, 0)
+	for _, cartEntity := range carts {
+		productIds = append(productIds, cartEntity.ProductId)
+	}
+
+	data := make([]*recommendation.RecommendationItem, 0)
+	if len(productIds) > 0 {
+		data, err = rs.recommendations(ctx, productIds, request.Limit)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &recommendation.CartRecommendationResponse{
+		Base: utils.SuccessResponse("Get Cart Recommendation Success"),
+		Data: data,
+	}, nil
+}
+
+func (rs *recommendationService) recommendations(ctx context.Context, productIds []string, limit int32) ([]*recommendation.RecommendationItem, error) {
+	if limit == 0 {
+		limit = defaultRecommendationLimit
+	}
+
+	recommendations, err := rs.recommendationRepository.GetCoPurchasedProducts(ctx, productIds, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	data := make([]*recommendation.RecommendationItem, 0)
+	for _, rec := range recommendations {
+		imageUrl, err := rs.storage.URL(ctx, imageprocessor.RenditionFileName(rec.Product.ImageFileName, imageprocessor.RenditionThumbnail))
+		if err != nil {
+			return nil, err
+		}
+
+		data = append(data, &recommendation.RecommendationItem{
+			Id:            rec.Product.Id,
+			Name:          rec.Product.Name,
+			Price:         productVariantPrice(rec.Product, nil, now),
+			OriginalPrice: rec.Product.Price,
+			ImageUrl:      imageUrl,
+			OrderCount:    rec.OrderCount,
+		})
+	}
+
+	return data, nil
+}
+
+// RefreshRecommendations rebuilds the co-purchase counts the recommendations
+// are read from, so requests never have to scan the order history.
+func (rs *recommendationService) RefreshRecommendations(ctx context.Context) error {
+	tx, err := rs.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	refreshed, err := rs.recommendationRepository.WithTransaction(tx).RefreshCoPurchases(ctx, recommendationOrderStatusCodes, time.Now())
+	if err != nil || !refreshed {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	log.Println("Refreshed product recommendations")
+	return nil
+}
+
+func NewRecommendationService(db *sql.DB, recommendationRepository repository.IRecommendationRepository, cartRepository repository.ICartRepository, storage storage.IStorage) IRecommendationService {
+	return &recommendationService{
+		db:                       db,
+		recommendationRepository: recommendationRepository,
+		cartRepository:           cartRepository,
+		storage:                  storage,
+	}
+}
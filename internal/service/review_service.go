@@ -0,0 +1,228 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/xryar/golang-grpc-ecommerce/internal/entity"
+	jwtentity "github.com/xryar/golang-grpc-ecommerce/internal/entity/jwt"
+	"github.com/xryar/golang-grpc-ecommerce/internal/repository"
+	"github.com/xryar/golang-grpc-ecommerce/internal/utils"
+	"github.com/xryar/golang-grpc-ecommerce/pb/review"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type IReviewService interface {
+	CreateReview(ctx context.Context, request *review.CreateReviewRequest) (*review.CreateReviewResponse, error)
+	ListReview(ctx context.Context, request *review.ListReviewRequest) (*review.ListReviewResponse, error)
+	ListReviewAdmin(ctx context.Context, request *review.ListReviewAdminRequest) (*review.ListReviewAdminResponse, error)
+	ApproveReview(ctx context.Context, request *review.ApproveReviewRequest) (*review.ApproveReviewResponse, error)
+	HideReview(ctx context.Context, request *review.HideReviewRequest) (*review.HideReviewResponse, error)
+}
+
+type reviewService struct {
+	reviewRepository  repository.IReviewRepository
+	productRepository repository.IProductRepository
+}
+
+func (rs *reviewService) CreateReview(ctx context.Context, request *review.CreateReviewRequest) (*review.CreateReviewResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	productEntity, err := rs.productRepository.GetProductById(ctx, request.ProductId)
+	if err != nil {
+		return nil, err
+	}
+	if productEntity == nil {
+		return &review.CreateReviewResponse{
+			Base: utils.NotFoundResponse("Product not found"),
+		}, nil
+	}
+
+	// only customers who received the product may review it
+	hasDoneOrder, err := rs.reviewRepository.HasDoneOrderWithProduct(ctx, claims.Subject, productEntity.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !hasDoneOrder {
+		return &review.CreateReviewResponse{
+			Base: utils.BadRequestResponse("You can only review products from your completed orders"),
+		}, nil
+	}
+
+	existingReview, err := rs.reviewRepository.GetReviewByProductAndUserId(ctx, productEntity.Id, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if existingReview != nil {
+		return &review.CreateReviewResponse{
+			Base: utils.BadRequestResponse("You have already reviewed this product"),
+		}, nil
+	}
+
+	reviewEntity := entity.Review{
+		Id:           uuid.NewString(),
+		ProductId:    productEntity.Id,
+		UserId:       claims.Subject,
+		UserFullName: claims.Fullname,
+		Rating:       request.Rating,
+		Comment:      request.Comment,
+		StatusCode:   entity.ReviewStatusCodePending,
+		CreatedAt:    time.Now(),
+		CreatedBy:    claims.Fullname,
+	}
+	err = rs.reviewRepository.CreateNewReview(ctx, &reviewEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &review.CreateReviewResponse{
+		Base: utils.SuccessResponse("Review is created and waiting for approval"),
+		Id:   reviewEntity.Id,
+	}, nil
+}
+
+func (rs *reviewService) ListReview(ctx context.Context, request *review.ListReviewRequest) (*review.ListReviewResponse, error) {
+	reviews, paginationResponse, err := rs.reviewRepository.GetReviewsPagination(ctx, request.ProductId, request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	var data []*review.ListReviewResponseItem = make([]*review.ListReviewResponseItem, 0)
+	for _, reviewEntity := range reviews {
+		data = append(data, &review.ListReviewResponseItem{
+			Id:           reviewEntity.Id,
+			UserFullName: reviewEntity.UserFullName,
+			Rating:       reviewEntity.Rating,
+			Comment:      reviewEntity.Comment,
+			CreatedAt:    timestamppb.New(reviewEntity.CreatedAt),
+		})
+	}
+
+	return &review.ListReviewResponse{
+		Base:       utils.SuccessResponse("Get List Review Success"),
+		Pagination: paginationResponse,
+		Data:       data,
+	}, nil
+}
+
+func (rs *reviewService) ListReviewAdmin(ctx context.Context, request *review.ListReviewAdminRequest) (*review.ListReviewAdminResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Role != entity.UserRoleAdmin {
+		return nil, utils.UnauthenticatedResponse()
+	}
+
+	reviews, paginationResponse, err := rs.reviewRepository.GetReviewsPaginationAdmin(ctx, request.Pagination, &repository.ReviewFilter{
+		ProductId:  request.ProductId,
+		StatusCode: request.StatusCode,
+	})
+	var validationErr *repository.QueryValidationError
+	if errors.As(err, &validationErr) {
+		return &review.ListReviewAdminResponse{
+			Base: utils.ValidationErrorResponse(validationErr.ValidationErrors),
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var data []*review.ListReviewAdminResponseItem = make([]*review.ListReviewAdminResponseItem, 0)
+	for _, reviewEntity := range reviews {
+		data = append(data, &review.ListReviewAdminResponseItem{
+			Id:           reviewEntity.Id,
+			ProductId:    reviewEntity.ProductId,
+			ProductName:  reviewEntity.ProductName,
+			UserId:       reviewEntity.UserId,
+			UserFullName: reviewEntity.UserFullName,
+			Rating:       reviewEntity.Rating,
+			Comment:      reviewEntity.Comment,
+			StatusCode:   reviewEntity.StatusCode,
+			CreatedAt:    timestamppb.New(reviewEntity.CreatedAt),
+		})
+	}
+
+	return &review.ListReviewAdminResponse{
+		Base:       utils.SuccessResponse("Get List Review Admin Success"),
+		Pagination: paginationResponse,
+		Data:       data,
+	}, nil
+}
+
+func (rs *reviewService) ApproveReview(ctx context.Context, request *review.ApproveReviewRequest) (*review.ApproveReviewResponse, error) {
+	found, err := rs.updateReviewStatus(ctx, request.Id, entity.ReviewStatusCodeApproved)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return &review.ApproveReviewResponse{
+			Base: utils.NotFoundResponse("Review not found"),
+		}, nil
+	}
+
+	return &review.ApproveReviewResponse{
+		Base: utils.SuccessResponse("Approve Review Success"),
+	}, nil
+}
+
+func (rs *reviewService) HideReview(ctx context.Context, request *review.HideReviewRequest) (*review.HideReviewResponse, error) {
+	found, err := rs.updateReviewStatus(ctx, request.Id, entity.ReviewStatusCodeHidden)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return &review.HideReviewResponse{
+			Base: utils.NotFoundResponse("Review not found"),
+		}, nil
+	}
+
+	return &review.HideReviewResponse{
+		Base: utils.SuccessResponse("Hide Review Success"),
+	}, nil
+}
+
+// updateReviewStatus moderates a review as an admin, it returns false when the
+// review does not exist.
+func (rs *reviewService) updateReviewStatus(ctx context.Context, id string, statusCode string) (bool, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if claims.Role != entity.UserRoleAdmin {
+		return false, utils.UnauthenticatedResponse()
+	}
+
+	reviewEntity, err := rs.reviewRepository.GetReviewById(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	if reviewEntity == nil {
+		return false, nil
+	}
+
+	now := time.Now()
+	reviewEntity.StatusCode = statusCode
+	reviewEntity.UpdatedAt = &now
+	reviewEntity.UpdatedBy = &claims.Fullname
+	err = rs.reviewRepository.UpdateReviewStatus(ctx, reviewEntity)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func NewReviewService(reviewRepository repository.IReviewRepository, productRepository repository.IProductRepository) IReviewService {
+	return &reviewService{
+		reviewRepository:  reviewRepository,
+		productRepository: productRepository,
+	}
+}
@@ -2,20 +2,33 @@ package service
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"log"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/xryar/golang-grpc-ecommerce/internal/dto"
 	"github.com/xryar/golang-grpc-ecommerce/internal/entity"
 	"github.com/xryar/golang-grpc-ecommerce/internal/repository"
 )
 
+const (
+	xenditInvoiceStatusPaid    = "PAID"
+	xenditInvoiceStatusSettled = "SETTLED"
+	xenditInvoiceStatusExpired = "EXPIRED"
+)
+
 type IWebhookService interface {
 	ReceiveInvoice(ctx context.Context, request *dto.XenditInvoiceRequest) error
 }
 
 type webhookService struct {
-	orderRepository repository.IOrderRepository
+	db                            *sql.DB
+	orderRepository               repository.IOrderRepository
+	productRepository             repository.IProductRepository
+	downloadEntitlementRepository repository.IDownloadEntitlementRepository
+	downloadLimit                 int64
 }
 
 func (ws *webhookService) ReceiveInvoice(ctx context.Context, request *dto.XenditInvoiceRequest) error {
@@ -29,23 +42,94 @@ func (ws *webhookService) ReceiveInvoice(ctx context.Context, request *dto.Xendi
 
 	now := time.Now()
 	updatedBy := "System"
+
+	if request.Status == xenditInvoiceStatusExpired {
+		orderEntity.OrderStatusCode = entity.OrderStatusCodeExpired
+		orderEntity.UpdatedAt = &now
+		orderEntity.UpdatedBy = &updatedBy
+
+		_, err = releaseOrderStock(ctx, ws.db, ws.orderRepository, ws.productRepository, orderEntity, entity.OrderStatusCodeUnpaid)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+
+	if request.Status != xenditInvoiceStatusPaid && request.Status != xenditInvoiceStatusSettled {
+		return nil
+	}
+
+	// digital items are delivered by their downloads, an order of nothing
+	// else has nothing to ship
+	digitalOnly := len(orderEntity.Items) > 0
+	for _, item := range orderEntity.Items {
+		if item.ProductDigitalFileName == nil {
+			digitalOnly = false
+		}
+	}
+
 	orderEntity.OrderStatusCode = entity.OrderStatusCodePaid
+	if digitalOnly {
+		orderEntity.OrderStatusCode = entity.OrderStatusCodeDone
+	}
 	orderEntity.UpdatedAt = &now
 	orderEntity.UpdatedBy = &updatedBy
 	orderEntity.XenditPaidAt = &now
 	orderEntity.XenditPaymentChannel = &request.PaymentChannel
 	orderEntity.XenditPaymentMethod = &request.PaymentMethod
 
-	err = ws.orderRepository.UpdateOrder(ctx, orderEntity)
+	tx, err := ws.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// only an unpaid order still holds its stock, a replayed callback or one
+	// arriving after the order expired or was canceled changes nothing
+	updated, err := ws.orderRepository.WithTransaction(tx).UpdateOrderFromStatus(ctx, orderEntity, entity.OrderStatusCodeUnpaid)
 	if err != nil {
 		return err
 	}
+	if !updated {
+		log.Printf("Ignoring %s invoice of order %s, it is no longer unpaid", request.Status, orderEntity.Id)
+		return nil
+	}
+
+	downloadEntitlementRepo := ws.downloadEntitlementRepository.WithTransaction(tx)
+	for _, item := range orderEntity.Items {
+		if item.ProductDigitalFileName == nil {
+			continue
+		}
+
+		err = downloadEntitlementRepo.CreateDownloadEntitlement(ctx, &entity.DownloadEntitlement{
+			Id:           uuid.NewString(),
+			OrderId:      orderEntity.Id,
+			OrderItemId:  item.Id,
+			UserId:       orderEntity.UserId,
+			ProductId:    item.ProductId,
+			ProductName:  item.ProductName,
+			FileName:     *item.ProductDigitalFileName,
+			MaxDownloads: ws.downloadLimit * item.Quantity,
+			CreatedAt:    now,
+			CreatedBy:    updatedBy,
+		})
+		if err != nil {
+			return err
+		}
+	}
 
-	return nil
+	return tx.Commit()
 }
 
-func NewWebhookService(orderRepository repository.IOrderRepository) IWebhookService {
+// NewWebhookService grants the buyer of a digital item downloadLimit
+// downloads per unit bought.
+func NewWebhookService(db *sql.DB, orderRepository repository.IOrderRepository, productRepository repository.IProductRepository, downloadEntitlementRepository repository.IDownloadEntitlementRepository, downloadLimit int64) IWebhookService {
 	return &webhookService{
-		orderRepository: orderRepository,
+		db:                            db,
+		orderRepository:               orderRepository,
+		productRepository:             productRepository,
+		downloadEntitlementRepository: downloadEntitlementRepository,
+		downloadLimit:                 downloadLimit,
 	}
 }
@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/xryar/golang-grpc-ecommerce/internal/entity"
+	jwtentity "github.com/xryar/golang-grpc-ecommerce/internal/entity/jwt"
+	"github.com/xryar/golang-grpc-ecommerce/internal/imageprocessor"
+	"github.com/xryar/golang-grpc-ecommerce/internal/repository"
+	"github.com/xryar/golang-grpc-ecommerce/internal/storage"
+	"github.com/xryar/golang-grpc-ecommerce/internal/utils"
+	"github.com/xryar/golang-grpc-ecommerce/pb/cart"
+	"github.com/xryar/golang-grpc-ecommerce/pb/wishlist"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type IWishlistService interface {
+	AddProductToWishlist(ctx context.Context, request *wishlist.AddProductToWishlistRequest) (*wishlist.AddProductToWishlistResponse, error)
+	ListWishlist(ctx context.Context, request *wishlist.ListWishlistRequest) (*wishlist.ListWishlistResponse, error)
+	DeleteWishlist(ctx context.Context, request *wishlist.DeleteWishlistRequest) (*wishlist.DeleteWishlistResponse, error)
+	MoveWishlistToCart(ctx context.Context, request *wishlist.MoveWishlistToCartRequest) (*wishlist.MoveWishlistToCartResponse, error)
+}
+
+type wishlistService struct {
+	productRepository  repository.IProductRepository
+	wishlistRepository repository.IWishlistRepository
+	cartService        ICartService
+	storage            storage.IStorage
+}
+
+func (ws *wishlistService) AddProductToWishlist(ctx context.Context, request *wishlist.AddProductToWishlistRequest) (*wishlist.AddProductToWishlistResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	productEntity, err := ws.productRepository.GetProductById(ctx, request.ProductId)
+	if err != nil {
+		return nil, err
+	}
+	if productEntity == nil || !productIsPublished(productEntity, time.Now()) {
+		return &wishlist.AddProductToWishlistResponse{
+			Base: utils.NotFoundResponse("Product not found"),
+		}, nil
+	}
+
+	// variant boleh kosong, varian dipilih saat dipindahkan ke cart
+	var variantEntity *entity.ProductVariant
+	var productVariantId *string
+	if request.VariantId != "" {
+		variantEntity, err = ws.productRepository.GetProductVariantById(ctx, request.VariantId)
+		if err != nil {
+			return nil, err
+		}
+		if variantEntity == nil || variantEntity.ProductId != productEntity.Id {
+			return &wishlist.AddProductToWishlistResponse{
+				Base: utils.NotFoundResponse("Product variant not found"),
+			}, nil
+		}
+
+		productVariantId = &variantEntity.Id
+	}
+
+	wishlistEntity, err := ws.wishlistRepository.GetWishlistByProductAndUserId(ctx, productEntity.Id, productVariantId, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if wishlistEntity != nil {
+		return &wishlist.AddProductToWishlistResponse{
+			Base: utils.SuccessResponse("Add Product to Wishlist Success"),
+			Id:   wishlistEntity.Id,
+		}, nil
+	}
+
+	now := time.Now()
+	newWishlistEntity := entity.UserWishlist{
+		Id:               uuid.NewString(),
+		UserId:           claims.Subject,
+		ProductId:        productEntity.Id,
+		ProductVariantId: productVariantId,
+		PriceWhenAdded:   productVariantPrice(productEntity, variantEntity, now),
+		CreatedAt:        now,
+		CreatedBy:        claims.Fullname,
+	}
+	err = ws.wishlistRepository.CreateNewWishlist(ctx, &newWishlistEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &wishlist.AddProductToWishlistResponse{
+		Base: utils.SuccessResponse("Add Product to Wishlist Success"),
+		Id:   newWishlistEntity.Id,
+	}, nil
+}
+
+func (ws *wishlistService) ListWishlist(ctx context.Context, request *wishlist.ListWishlistRequest) (*wishlist.ListWishlistResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	wishlists, err := ws.wishlistRepository.GetListWishlist(ctx, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var items []*wishlist.ListWishlistResponseItem = make([]*wishlist.ListWishlistResponseItem, 0)
+	for _, wishlistEntity := range wishlists {
+		imageFileName := wishlistEntity.Product.ImageFileName
+		price := productVariantPrice(wishlistEntity.Product, wishlistEntity.ProductVariant, now)
+		item := wishlist.ListWishlistResponseItem{
+			WishlistId:     wishlistEntity.Id,
+			ProductId:      wishlistEntity.Product.Id,
+			ProductName:    wishlistEntity.Product.Name,
+			ProductPrice:   price,
+			PriceWhenAdded: wishlistEntity.PriceWhenAdded,
+			PriceDropped:   price < wishlistEntity.PriceWhenAdded,
+			CreatedAt:      timestamppb.New(wishlistEntity.CreatedAt),
+		}
+		if wishlistEntity.ProductVariant != nil {
+			item.VariantId = wishlistEntity.ProductVariant.Id
+			item.VariantSku = wishlistEntity.ProductVariant.Sku
+			item.VariantAttributes = wishlistEntity.ProductVariant.Attributes
+			if wishlistEntity.ProductVariant.ImageFileName != nil {
+				imageFileName = *wishlistEntity.ProductVariant.ImageFileName
+			}
+		}
+
+		// digital products are never out of stock
+		stock := cartItemStock(wishlistEntity.Product, wishlistEntity.ProductVariant)
+		item.InStock = stock == nil || *stock > 0
+		item.ProductImageUrl, err = ws.storage.URL(ctx, imageprocessor.RenditionFileName(imageFileName, imageprocessor.RenditionThumbnail))
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, &item)
+	}
+
+	return &wishlist.ListWishlistResponse{
+		Base:  utils.SuccessResponse("Get List Wishlist Success"),
+		Items: items,
+	}, nil
+}
+
+func (ws *wishlistService) DeleteWishlist(ctx context.Context, request *wishlist.DeleteWishlistRequest) (*wishlist.DeleteWishlistResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	wishlistEntity, err := ws.wishlistRepository.GetWishlistById(ctx, request.WishlistId)
+	if err != nil {
+		return nil, err
+	}
+	if wishlistEntity == nil {
+		return &wishlist.DeleteWishlistResponse{
+			Base: utils.NotFoundResponse("Wishlist Not Found"),
+		}, nil
+	}
+
+	if wishlistEntity.UserId != claims.Subject {
+		return &wishlist.DeleteWishlistResponse{
+			Base: utils.BadRequestResponse("Wishlist user is not matched"),
+		}, nil
+	}
+
+	err = ws.wishlistRepository.DeleteWishlist(ctx, request.WishlistId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &wishlist.DeleteWishlistResponse{
+		Base: utils.SuccessResponse("Delete Wishlist Success"),
+	}, nil
+}
+
+func (ws *wishlistService) MoveWishlistToCart(ctx context.Context, request *wishlist.MoveWishlistToCartRequest) (*wishlist.MoveWishlistToCartResponse, error) {
+	claims, err := jwtentity.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	wishlistEntity, err := ws.wishlistRepository.GetWishlistById(ctx, request.WishlistId)
+	if err != nil {
+		return nil, err
+	}
+	if wishlistEntity == nil {
+		return &wishlist.MoveWishlistToCartResponse{
+			Base: utils.NotFoundResponse("Wishlist Not Found"),
+		}, nil
+	}
+
+	if wishlistEntity.UserId != claims.Subject {
+		return &wishlist.MoveWishlistToCartResponse{
+			Base: utils.BadRequestResponse("Wishlist user is not matched"),
+		}, nil
+	}
+
+	// varian dari wishlist diutamakan, jika kosong pakai varian dari request
+	variantId := request.VariantId
+	if wishlistEntity.ProductVariantId != nil {
+		variantId = *wishlistEntity.ProductVariantId
+	}
+
+	// validasi product, varian, dan stok mengikuti aturan cart
+	cartResponse, err := ws.cartService.AddProductToCart(ctx, &cart.AddProductToCartRequest{
+		ProductId: wishlistEntity.ProductId,
+		VariantId: variantId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if cartResponse.Base.IsError {
+		return &wishlist.MoveWishlistToCartResponse{
+			Base: cartResponse.Base,
+		}, nil
+	}
+
+	err = ws.wishlistRepository.DeleteWishlist(ctx, wishlistEntity.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &wishlist.MoveWishlistToCartResponse{
+		Base:   utils.SuccessResponse("Move Wishlist to Cart Success"),
+		CartId: cartResponse.Id,
+	}, nil
+}
+
+func NewWishlistService(productRepository repository.IProductRepository, wishlistRepository repository.IWishlistRepository, cartService ICartService, storage storage.IStorage) IWishlistService {
+	return &wishlistService{
+		productRepository:  productRepository,
+		wishlistRepository: wishlistRepository,
+		cartService:        cartService,
+		storage:            storage,
+	}
+}
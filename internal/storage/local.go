@@ -0,0 +1,145 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+type localStorage struct {
+	dir     string
+	baseUrl string
+}
+
+func (ls *localStorage) Save(ctx context.Context, name string, reader io.Reader, size int64, contentType string) error {
+	path, err := ls.path(name)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(ls.dir, 0755)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = io.Copy(file, reader)
+	if err != nil {
+		return err
+	}
+
+	return file.Close()
+}
+
+func (ls *localStorage) Open(ctx context.Context, name string) (io.ReadCloser, error) {
+	path, err := ls.path(name)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	return file, nil
+}
+
+func (ls *localStorage) Exists(ctx context.Context, name string) (bool, error) {
+	path, err := ls.path(name)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = os.Stat(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (ls *localStorage) Delete(ctx context.Context, name string) error {
+	path, err := ls.path(name)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+func (ls *localStorage) URL(ctx context.Context, name string) (string, error) {
+	return fmt.Sprintf("%s/%s", ls.baseUrl, name), nil
+}
+
+func (ls *localStorage) List(ctx context.Context) ([]*ObjectInfo, error) {
+	entries, err := os.ReadDir(ls.dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return []*ObjectInfo{}, nil
+		}
+
+		return nil, err
+	}
+
+	objects := make([]*ObjectInfo, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			// removed since the directory was read
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		objects = append(objects, &ObjectInfo{
+			Name:       entry.Name(),
+			ModifiedAt: info.ModTime(),
+		})
+	}
+
+	return objects, nil
+}
+
+// path keeps names inside dir so they cannot be used to reach other files.
+func (ls *localStorage) path(name string) (string, error) {
+	if name == "" || name != filepath.Base(name) || name == "." || name == ".." {
+		return "", fmt.Errorf("invalid object name %q", name)
+	}
+
+	return filepath.Join(ls.dir, name), nil
+}
+
+func NewLocalStorage(dir string, baseUrl string) IStorage {
+	return &localStorage{
+		dir:     dir,
+		baseUrl: baseUrl,
+	}
+}
@@ -0,0 +1,156 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+type S3Config struct {
+	Endpoint  string
+	AccessKey string
+	SecretKey string
+	Region    string
+	Bucket    string
+	UseSSL    bool
+	// Prefix is prepended to every object name, e.g. "product/".
+	Prefix string
+	// PublicUrl is used for object URLs when the bucket is publicly readable.
+	// When empty, presigned URLs valid for PresignExpiry are returned instead.
+	PublicUrl     string
+	PresignExpiry time.Duration
+}
+
+type s3Storage struct {
+	client *minio.Client
+	config S3Config
+}
+
+func (ss *s3Storage) Save(ctx context.Context, name string, reader io.Reader, size int64, contentType string) error {
+	_, err := ss.client.PutObject(ctx, ss.config.Bucket, ss.key(name), reader, size, minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ss *s3Storage) Open(ctx context.Context, name string) (io.ReadCloser, error) {
+	object, err := ss.client.GetObject(ctx, ss.config.Bucket, ss.key(name), minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	// GetObject is lazy, stat it so a missing object is reported here
+	_, err = object.Stat()
+	if err != nil {
+		object.Close()
+		if isS3NotFound(err) {
+			return nil, ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	return object, nil
+}
+
+func (ss *s3Storage) Exists(ctx context.Context, name string) (bool, error) {
+	_, err := ss.client.StatObject(ctx, ss.config.Bucket, ss.key(name), minio.StatObjectOptions{})
+	if err != nil {
+		if isS3NotFound(err) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (ss *s3Storage) Delete(ctx context.Context, name string) error {
+	err := ss.client.RemoveObject(ctx, ss.config.Bucket, ss.key(name), minio.RemoveObjectOptions{})
+	if err != nil && !isS3NotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (ss *s3Storage) URL(ctx context.Context, name string) (string, error) {
+	if ss.config.PublicUrl != "" {
+		return fmt.Sprintf("%s/%s", strings.TrimSuffix(ss.config.PublicUrl, "/"), ss.key(name)), nil
+	}
+
+	presignedUrl, err := ss.client.PresignedGetObject(ctx, ss.config.Bucket, ss.key(name), ss.config.PresignExpiry, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return presignedUrl.String(), nil
+}
+
+func (ss *s3Storage) List(ctx context.Context) ([]*ObjectInfo, error) {
+	objects := make([]*ObjectInfo, 0)
+	for object := range ss.client.ListObjects(ctx, ss.config.Bucket, minio.ListObjectsOptions{
+		Prefix:    ss.config.Prefix,
+		Recursive: true,
+	}) {
+		if object.Err != nil {
+			return nil, object.Err
+		}
+
+		objects = append(objects, &ObjectInfo{
+			Name:       strings.TrimPrefix(object.Key, ss.config.Prefix),
+			ModifiedAt: object.LastModified,
+		})
+	}
+
+	return objects, nil
+}
+
+func (ss *s3Storage) key(name string) string {
+	return ss.config.Prefix + name
+}
+
+func isS3NotFound(err error) bool {
+	response := minio.ToErrorResponse(err)
+	return response.StatusCode == http.StatusNotFound || response.Code == "NoSuchKey"
+}
+
+// NewS3Storage connects to an S3 compatible service such as MinIO and creates
+// the bucket when it does not exist yet.
+func NewS3Storage(ctx context.Context, config S3Config) (IStorage, error) {
+	client, err := minio.New(config.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(config.AccessKey, config.SecretKey, ""),
+		Secure: config.UseSSL,
+		Region: config.Region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := client.BucketExists(ctx, config.Bucket)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		err = client.MakeBucket(ctx, config.Bucket, minio.MakeBucketOptions{Region: config.Region})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &s3Storage{
+		client: client,
+		config: config,
+	}, nil
+}
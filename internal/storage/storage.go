@@ -0,0 +1,83 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+var ErrNotFound = errors.New("object not found")
+
+// ObjectInfo describes a stored object as returned by List.
+type ObjectInfo struct {
+	Name       string
+	ModifiedAt time.Time
+}
+
+// IStorage stores objects by name, e.g. uploaded product images.
+type IStorage interface {
+	Save(ctx context.Context, name string, reader io.Reader, size int64, contentType string) error
+	// Open returns ErrNotFound when the object does not exist.
+	Open(ctx context.Context, name string) (io.ReadCloser, error)
+	Exists(ctx context.Context, name string) (bool, error)
+	// Delete does not fail when the object is already gone.
+	Delete(ctx context.Context, name string) error
+	URL(ctx context.Context, name string) (string, error)
+	// List returns every stored object.
+	List(ctx context.Context) ([]*ObjectInfo, error)
+}
+
+// NewProductStorageFromEnv builds the product image storage selected by
+// STORAGE_DRIVER, which is either "local" (the default) or "s3".
+func NewProductStorageFromEnv(ctx context.Context) (IStorage, error) {
+	return newStorageFromEnv(ctx, "product", os.Getenv("S3_BUCKET"), os.Getenv("S3_PUBLIC_URL"))
+}
+
+// NewDigitalStorageFromEnv builds the storage of digital product files on the
+// same driver as the product images. Its files are private, they are only
+// handed out through signed download links and never get a public url. On s3
+// they are kept in DIGITAL_S3_BUCKET, which must not be the public bucket of
+// the product images.
+func NewDigitalStorageFromEnv(ctx context.Context) (IStorage, error) {
+	bucket := os.Getenv("DIGITAL_S3_BUCKET")
+	if os.Getenv("STORAGE_DRIVER") == "s3" {
+		if bucket == "" {
+			return nil, errors.New("DIGITAL_S3_BUCKET is not set")
+		}
+		if bucket == os.Getenv("S3_BUCKET") {
+			return nil, errors.New("DIGITAL_S3_BUCKET must be a private bucket apart from S3_BUCKET")
+		}
+	}
+
+	return newStorageFromEnv(ctx, "digital", bucket, "")
+}
+
+func newStorageFromEnv(ctx context.Context, name string, s3Bucket string, s3PublicUrl string) (IStorage, error) {
+	switch os.Getenv("STORAGE_DRIVER") {
+	case "", "local":
+		return NewLocalStorage(filepath.Join("storage", name), fmt.Sprintf("%s/%s", os.Getenv("STORAGE_SERVICE_URL"), name)), nil
+	case "s3":
+		presignExpiry, err := time.ParseDuration(os.Getenv("S3_PRESIGN_EXPIRY"))
+		if err != nil {
+			presignExpiry = time.Hour
+		}
+
+		return NewS3Storage(ctx, S3Config{
+			Endpoint:      os.Getenv("S3_ENDPOINT"),
+			AccessKey:     os.Getenv("S3_ACCESS_KEY"),
+			SecretKey:     os.Getenv("S3_SECRET_KEY"),
+			Region:        os.Getenv("S3_REGION"),
+			Bucket:        s3Bucket,
+			UseSSL:        os.Getenv("S3_USE_SSL") == "true",
+			Prefix:        name + "/",
+			PublicUrl:     s3PublicUrl,
+			PresignExpiry: presignExpiry,
+		})
+	default:
+		return nil, fmt.Errorf("unknown storage driver %q", os.Getenv("STORAGE_DRIVER"))
+	}
+}
@@ -0,0 +1,2 @@
+ALTER TABLE product ADD COLUMN stock BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE product ADD CONSTRAINT product_stock_non_negative CHECK (stock >= 0);
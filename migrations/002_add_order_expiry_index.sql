@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS order_unpaid_expired_at_idx ON "order" (expired_at) WHERE order_status_code = 'unpaid' AND is_deleted = false;
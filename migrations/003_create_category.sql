@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS category (
+    id UUID PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    parent_id UUID REFERENCES category (id),
+    created_at TIMESTAMPTZ NOT NULL,
+    created_by VARCHAR(255) NOT NULL,
+    updated_at TIMESTAMPTZ,
+    updated_by VARCHAR(255),
+    deleted_at TIMESTAMPTZ,
+    deleted_by VARCHAR(255),
+    is_deleted BOOLEAN NOT NULL DEFAULT false
+);
+
+CREATE INDEX IF NOT EXISTS category_parent_id_idx ON category (parent_id) WHERE is_deleted = false;
+
+ALTER TABLE product ADD COLUMN category_id UUID REFERENCES category (id);
+
+CREATE INDEX IF NOT EXISTS product_category_id_idx ON product (category_id) WHERE is_deleted = false;
@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS product_variant (
+    id UUID PRIMARY KEY,
+    product_id UUID NOT NULL REFERENCES product (id),
+    sku VARCHAR(100) NOT NULL,
+    attributes JSONB NOT NULL DEFAULT '{}'::jsonb,
+    price NUMERIC,
+    image_file_name VARCHAR(255),
+    stock BIGINT NOT NULL DEFAULT 0 CHECK (stock >= 0),
+    created_at TIMESTAMPTZ NOT NULL,
+    created_by VARCHAR(255) NOT NULL,
+    updated_at TIMESTAMPTZ,
+    updated_by VARCHAR(255),
+    deleted_at TIMESTAMPTZ,
+    deleted_by VARCHAR(255),
+    is_deleted BOOLEAN NOT NULL DEFAULT false
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS product_variant_sku_idx ON product_variant (sku) WHERE is_deleted = false;
+CREATE INDEX IF NOT EXISTS product_variant_product_id_idx ON product_variant (product_id) WHERE is_deleted = false;
+
+ALTER TABLE user_cart ADD COLUMN product_variant_id UUID REFERENCES product_variant (id);
+
+ALTER TABLE order_item ADD COLUMN product_variant_id UUID;
+ALTER TABLE order_item ADD COLUMN product_variant_sku VARCHAR(100);
+ALTER TABLE order_item ADD COLUMN product_variant_attributes JSONB;
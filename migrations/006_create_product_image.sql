@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS product_image (
+    id UUID PRIMARY KEY,
+    product_id UUID NOT NULL REFERENCES product (id),
+    image_file_name VARCHAR(255) NOT NULL,
+    position INT NOT NULL,
+    is_primary BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMPTZ NOT NULL,
+    created_by VARCHAR(255) NOT NULL,
+    updated_at TIMESTAMPTZ,
+    updated_by VARCHAR(255)
+);
+
+CREATE INDEX IF NOT EXISTS product_image_product_id_idx ON product_image (product_id, position);
+CREATE UNIQUE INDEX IF NOT EXISTS product_image_primary_idx ON product_image (product_id) WHERE is_primary = true;
+CREATE INDEX IF NOT EXISTS product_image_file_name_idx ON product_image (image_file_name);
+
+-- every existing product starts with its current image as the primary one
+INSERT INTO product_image (id, product_id, image_file_name, position, is_primary, created_at, created_by)
+SELECT gen_random_uuid(), id, image_file_name, 0, true, created_at, created_by
+FROM product
+WHERE NOT EXISTS (SELECT 1 FROM product_image pi WHERE pi.product_id = product.id);
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS product_review (
+    id UUID PRIMARY KEY,
+    product_id UUID NOT NULL REFERENCES product (id),
+    user_id UUID NOT NULL,
+    user_full_name VARCHAR(255) NOT NULL,
+    rating INT NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    comment TEXT NOT NULL DEFAULT '',
+    status_code VARCHAR(50) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    created_by VARCHAR(255) NOT NULL,
+    updated_at TIMESTAMPTZ,
+    updated_by VARCHAR(255),
+    deleted_at TIMESTAMPTZ,
+    deleted_by VARCHAR(255),
+    is_deleted BOOLEAN NOT NULL DEFAULT false
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS product_review_product_user_idx ON product_review (product_id, user_id) WHERE is_deleted = false;
+CREATE INDEX IF NOT EXISTS product_review_status_idx ON product_review (product_id, status_code, created_at DESC) WHERE is_deleted = false;
@@ -0,0 +1,3 @@
+ALTER TABLE product ADD COLUMN sku VARCHAR(100);
+
+CREATE UNIQUE INDEX IF NOT EXISTS product_sku_idx ON product (sku) WHERE is_deleted = false;
@@ -0,0 +1,24 @@
+ALTER TABLE product ADD COLUMN sale_price NUMERIC;
+ALTER TABLE product ADD COLUMN sale_starts_at TIMESTAMPTZ;
+ALTER TABLE product ADD COLUMN sale_ends_at TIMESTAMPTZ;
+
+CREATE TABLE IF NOT EXISTS product_price_history (
+    id UUID PRIMARY KEY,
+    product_id UUID NOT NULL REFERENCES product (id),
+    price NUMERIC NOT NULL,
+    sale_price NUMERIC,
+    sale_starts_at TIMESTAMPTZ,
+    sale_ends_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL,
+    created_by VARCHAR(255) NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS product_price_history_product_id_idx ON product_price_history (product_id, created_at DESC);
+
+-- start every existing product's history with its current price
+INSERT INTO product_price_history (id, product_id, price, created_at, created_by)
+SELECT gen_random_uuid(), id, price, COALESCE(updated_at, created_at), COALESCE(updated_by, created_by) FROM product;
+
+ALTER TABLE order_item ADD COLUMN product_original_price NUMERIC;
+UPDATE order_item SET product_original_price = product_price;
+ALTER TABLE order_item ALTER COLUMN product_original_price SET NOT NULL;
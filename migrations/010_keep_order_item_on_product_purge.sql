@@ -0,0 +1,15 @@
+-- order items keep a snapshot of the product, so they must survive the
+-- product being purged from the trash bin
+DO $$
+DECLARE
+    fk_name TEXT;
+BEGIN
+    FOR fk_name IN
+        SELECT conname FROM pg_constraint
+        WHERE conrelid = 'order_item'::regclass AND confrelid = 'product'::regclass AND contype = 'f'
+    LOOP
+        EXECUTE format('ALTER TABLE order_item DROP CONSTRAINT %I', fk_name);
+    END LOOP;
+END $$;
+
+CREATE INDEX IF NOT EXISTS product_deleted_at_idx ON product (deleted_at DESC) WHERE is_deleted = true;
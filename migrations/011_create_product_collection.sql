@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS product_collection (
+    id UUID PRIMARY KEY,
+    key VARCHAR(100) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    rule_type VARCHAR(50) NOT NULL,
+    rule_days INT NOT NULL DEFAULT 0,
+    product_limit INT NOT NULL,
+    starts_at TIMESTAMPTZ,
+    ends_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL,
+    created_by VARCHAR(255) NOT NULL,
+    updated_at TIMESTAMPTZ,
+    updated_by VARCHAR(255),
+    deleted_at TIMESTAMPTZ,
+    deleted_by VARCHAR(255),
+    is_deleted BOOLEAN NOT NULL DEFAULT false
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS product_collection_key_idx ON product_collection (key) WHERE is_deleted = false;
+
+CREATE TABLE IF NOT EXISTS product_collection_item (
+    collection_id UUID NOT NULL REFERENCES product_collection (id),
+    product_id UUID NOT NULL REFERENCES product (id),
+    position INT NOT NULL,
+    PRIMARY KEY (collection_id, product_id)
+);
+
+CREATE INDEX IF NOT EXISTS order_item_created_at_idx ON order_item (created_at DESC) WHERE is_deleted = false;
@@ -0,0 +1,11 @@
+-- rebuilt periodically from paid orders, see the recommendation refresh job
+CREATE TABLE IF NOT EXISTS product_co_purchase (
+    product_id UUID NOT NULL,
+    related_product_id UUID NOT NULL,
+    order_count INT NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (product_id, related_product_id)
+);
+
+CREATE INDEX IF NOT EXISTS product_co_purchase_rank_idx ON product_co_purchase (product_id, order_count DESC);
+CREATE INDEX IF NOT EXISTS order_item_order_id_idx ON order_item (order_id) WHERE is_deleted = false;
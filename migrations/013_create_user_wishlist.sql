@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS user_wishlist (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    product_id UUID NOT NULL REFERENCES product (id),
+    product_variant_id UUID REFERENCES product_variant (id),
+    price_when_added NUMERIC NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    created_by VARCHAR(255) NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS user_wishlist_user_product_idx ON user_wishlist (user_id, product_id, COALESCE(product_variant_id, '00000000-0000-0000-0000-000000000000'));
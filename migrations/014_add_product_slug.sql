@@ -0,0 +1,32 @@
+ALTER TABLE product ADD COLUMN slug VARCHAR(255);
+ALTER TABLE product ADD COLUMN seo_title VARCHAR(255);
+ALTER TABLE product ADD COLUMN seo_description VARCHAR(500);
+
+-- derive a slug from every existing name, products sharing a name get a
+-- piece of their id appended
+WITH slugged AS (
+    SELECT id, COALESCE(NULLIF(TRIM(BOTH '-' FROM regexp_replace(lower(name), '[^a-z0-9]+', '-', 'g')), ''), 'product') AS slug
+    FROM product
+), ranked AS (
+    SELECT id, slug, ROW_NUMBER() OVER (PARTITION BY slug ORDER BY id) AS slug_rank FROM slugged
+)
+UPDATE product SET slug = CASE WHEN ranked.slug_rank = 1 THEN ranked.slug ELSE ranked.slug || '-' || LEFT(ranked.id::text, 8) END
+FROM ranked
+WHERE product.id = ranked.id;
+
+ALTER TABLE product ALTER COLUMN slug SET NOT NULL;
+
+-- slugs stay reserved after the product is deleted so old links never point
+-- to another product
+CREATE UNIQUE INDEX IF NOT EXISTS product_slug_idx ON product (slug);
+
+CREATE TABLE IF NOT EXISTS product_slug_history (
+    id UUID PRIMARY KEY,
+    product_id UUID NOT NULL REFERENCES product (id),
+    slug VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    created_by VARCHAR(255) NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS product_slug_history_slug_idx ON product_slug_history (slug);
+CREATE INDEX IF NOT EXISTS product_slug_history_product_id_idx ON product_slug_history (product_id);
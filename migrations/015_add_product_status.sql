@@ -0,0 +1,6 @@
+-- products created before statuses existed stay visible
+ALTER TABLE product ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'published';
+ALTER TABLE product ALTER COLUMN status DROP DEFAULT;
+ALTER TABLE product ADD COLUMN publish_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS product_status_idx ON product (status, publish_at) WHERE is_deleted = false;
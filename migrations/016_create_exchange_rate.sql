@@ -0,0 +1,22 @@
+-- rate is how many IDR one unit of currency_code is worth
+CREATE TABLE IF NOT EXISTS exchange_rate (
+    id UUID PRIMARY KEY,
+    currency_code VARCHAR(3) NOT NULL,
+    rate NUMERIC NOT NULL,
+    effective_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    created_by VARCHAR(255) NOT NULL,
+    deleted_at TIMESTAMPTZ,
+    deleted_by VARCHAR(255),
+    is_deleted BOOLEAN NOT NULL DEFAULT false
+);
+
+CREATE INDEX IF NOT EXISTS exchange_rate_currency_effective_idx ON exchange_rate (currency_code, effective_at DESC) WHERE is_deleted = false;
+
+-- orders are settled in IDR, the display currency and its rate are kept for reference
+ALTER TABLE "order" ADD COLUMN currency VARCHAR(3) NOT NULL DEFAULT 'IDR';
+ALTER TABLE "order" ADD COLUMN display_currency VARCHAR(3) NOT NULL DEFAULT 'IDR';
+ALTER TABLE "order" ADD COLUMN exchange_rate NUMERIC NOT NULL DEFAULT 1;
+ALTER TABLE "order" ALTER COLUMN currency DROP DEFAULT;
+ALTER TABLE "order" ALTER COLUMN display_currency DROP DEFAULT;
+ALTER TABLE "order" ALTER COLUMN exchange_rate DROP DEFAULT;
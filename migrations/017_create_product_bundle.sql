@@ -0,0 +1,17 @@
+ALTER TABLE product ADD COLUMN type VARCHAR(20) NOT NULL DEFAULT 'simple';
+
+CREATE TABLE IF NOT EXISTS product_bundle_item (
+    id UUID PRIMARY KEY,
+    bundle_product_id UUID NOT NULL REFERENCES product (id),
+    product_id UUID NOT NULL REFERENCES product (id),
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    created_at TIMESTAMPTZ NOT NULL,
+    created_by VARCHAR(255) NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS product_bundle_item_bundle_product_idx ON product_bundle_item (bundle_product_id, product_id);
+CREATE INDEX IF NOT EXISTS product_bundle_item_product_id_idx ON product_bundle_item (product_id);
+
+-- a bundle is ordered as one item per component, these point back at it
+ALTER TABLE order_item ADD COLUMN bundle_product_id UUID;
+ALTER TABLE order_item ADD COLUMN bundle_product_name VARCHAR(255);
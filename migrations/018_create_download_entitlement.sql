@@ -0,0 +1,24 @@
+-- the file of a digital product, kept in the private digital storage
+ALTER TABLE product ADD COLUMN digital_file_name VARCHAR(255);
+
+-- the file bought, so replacing the product's file does not change past orders
+ALTER TABLE order_item ADD COLUMN product_digital_file_name VARCHAR(255);
+
+CREATE TABLE IF NOT EXISTS download_entitlement (
+    id UUID PRIMARY KEY,
+    order_id UUID NOT NULL REFERENCES "order" (id),
+    order_item_id UUID NOT NULL REFERENCES order_item (id),
+    user_id UUID NOT NULL,
+    product_id UUID NOT NULL,
+    product_name VARCHAR(255) NOT NULL,
+    file_name VARCHAR(255) NOT NULL,
+    max_downloads INTEGER NOT NULL CHECK (max_downloads > 0),
+    download_count INTEGER NOT NULL DEFAULT 0,
+    last_downloaded_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL,
+    created_by VARCHAR(255) NOT NULL
+);
+
+-- a repeated paid webhook must not grant the downloads twice
+CREATE UNIQUE INDEX IF NOT EXISTS download_entitlement_order_item_idx ON download_entitlement (order_item_id);
+CREATE INDEX IF NOT EXISTS download_entitlement_order_id_idx ON download_entitlement (order_id);
@@ -0,0 +1,32 @@
+-- attributes apply to the products of the category and of its subcategories
+CREATE TABLE IF NOT EXISTS category_attribute (
+    id UUID PRIMARY KEY,
+    category_id UUID NOT NULL REFERENCES category (id),
+    code VARCHAR(100) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    type VARCHAR(20) NOT NULL,
+    unit VARCHAR(50),
+    created_at TIMESTAMPTZ NOT NULL,
+    created_by VARCHAR(255) NOT NULL,
+    updated_at TIMESTAMPTZ,
+    updated_by VARCHAR(255),
+    deleted_at TIMESTAMPTZ,
+    deleted_by VARCHAR(255),
+    is_deleted BOOLEAN NOT NULL DEFAULT false
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS category_attribute_category_code_idx ON category_attribute (category_id, code) WHERE is_deleted = false;
+CREATE INDEX IF NOT EXISTS category_attribute_code_idx ON category_attribute (code) WHERE is_deleted = false;
+
+-- value_text holds every value in its canonical text form, value_number is
+-- also set for number attributes so they can be filtered by range
+CREATE TABLE IF NOT EXISTS product_attribute_value (
+    product_id UUID NOT NULL REFERENCES product (id),
+    attribute_id UUID NOT NULL REFERENCES category_attribute (id),
+    value_text VARCHAR(255) NOT NULL,
+    value_number NUMERIC,
+    PRIMARY KEY (product_id, attribute_id)
+);
+
+CREATE INDEX IF NOT EXISTS product_attribute_value_attribute_text_idx ON product_attribute_value (attribute_id, value_text);
+CREATE INDEX IF NOT EXISTS product_attribute_value_attribute_number_idx ON product_attribute_value (attribute_id, value_number) WHERE value_number IS NOT NULL;
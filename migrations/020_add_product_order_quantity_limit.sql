@@ -0,0 +1,9 @@
+-- how many units of the product one order may contain, NULL leaves that
+-- side unlimited
+ALTER TABLE product ADD COLUMN min_order_quantity BIGINT CHECK (min_order_quantity > 0);
+ALTER TABLE product ADD COLUMN max_order_quantity BIGINT CHECK (max_order_quantity > 0);
+ALTER TABLE product ADD CONSTRAINT product_order_quantity_range CHECK (min_order_quantity <= max_order_quantity);
+
+-- the price the item had when it was last added or updated, so the cart can
+-- tell the user it changed since. NULL for items added before
+ALTER TABLE user_cart ADD COLUMN price NUMERIC;
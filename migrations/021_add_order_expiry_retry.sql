@@ -0,0 +1,4 @@
+-- how often expiring the order failed and when the expiry job tries it
+-- again, so an order it keeps failing on does not hold up the ones after it
+ALTER TABLE "order" ADD COLUMN expiry_attempts INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE "order" ADD COLUMN expiry_retry_at TIMESTAMPTZ;
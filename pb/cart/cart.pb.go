@@ -24,8 +24,12 @@ const (
 )
 
 type AddProductToCartRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId string                 `protobuf:"bytes,2,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	// how many units to add, left empty one is added or as many as the
+	// product needs to reach its minimum order quantity
+	Quantity      int64 `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -67,6 +71,20 @@ func (x *AddProductToCartRequest) GetProductId() string {
 	return ""
 }
 
+func (x *AddProductToCartRequest) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *AddProductToCartRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
 type AddProductToCartResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -120,7 +138,10 @@ func (x *AddProductToCartResponse) GetId() string {
 }
 
 type ListCartRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the currency prices are shown in, left empty the x-currency metadata or
+	// else IDR is used
+	Currency      string `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -155,16 +176,37 @@ func (*ListCartRequest) Descriptor() ([]byte, []int) {
 	return file_cart_cart_proto_rawDescGZIP(), []int{2}
 }
 
+func (x *ListCartRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 type ListCartResponseItem struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	CartId          string                 `protobuf:"bytes,1,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
-	ProductId       string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	ProductName     string                 `protobuf:"bytes,3,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
-	ProductImageUrl string                 `protobuf:"bytes,4,opt,name=product_image_url,json=productImageUrl,proto3" json:"product_image_url,omitempty"`
-	ProductPrice    float64                `protobuf:"fixed64,5,opt,name=product_price,json=productPrice,proto3" json:"product_price,omitempty"`
-	Quantity        int64                  `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	CartId               string                 `protobuf:"bytes,1,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
+	ProductId            string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName          string                 `protobuf:"bytes,3,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	ProductImageUrl      string                 `protobuf:"bytes,4,opt,name=product_image_url,json=productImageUrl,proto3" json:"product_image_url,omitempty"`
+	ProductPrice         float64                `protobuf:"fixed64,5,opt,name=product_price,json=productPrice,proto3" json:"product_price,omitempty"`
+	Quantity             int64                  `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	VariantId            string                 `protobuf:"bytes,7,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	VariantSku           string                 `protobuf:"bytes,8,opt,name=variant_sku,json=variantSku,proto3" json:"variant_sku,omitempty"`
+	VariantAttributes    map[string]string      `protobuf:"bytes,9,rep,name=variant_attributes,json=variantAttributes,proto3" json:"variant_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ProductOriginalPrice float64                `protobuf:"fixed64,10,opt,name=product_original_price,json=productOriginalPrice,proto3" json:"product_original_price,omitempty"`
+	// how many units can be ordered right now, unset for digital products
+	// which are never out of stock
+	AvailableStock *int64 `protobuf:"varint,11,opt,name=available_stock,json=availableStock,proto3,oneof" json:"available_stock,omitempty"`
+	// how many units of the product one order may contain, all its variants
+	// together. unset when unlimited
+	MinOrderQuantity *int64 `protobuf:"varint,12,opt,name=min_order_quantity,json=minOrderQuantity,proto3,oneof" json:"min_order_quantity,omitempty"`
+	MaxOrderQuantity *int64 `protobuf:"varint,13,opt,name=max_order_quantity,json=maxOrderQuantity,proto3,oneof" json:"max_order_quantity,omitempty"`
+	// why the item can not be ordered as it is, empty when it can. a price
+	// change does not stop the order but should be shown before checkout
+	Warnings      []*ListCartResponseItemWarning `protobuf:"bytes,14,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListCartResponseItem) Reset() {
@@ -239,17 +281,142 @@ func (x *ListCartResponseItem) GetQuantity() int64 {
 	return 0
 }
 
+func (x *ListCartResponseItem) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *ListCartResponseItem) GetVariantSku() string {
+	if x != nil {
+		return x.VariantSku
+	}
+	return ""
+}
+
+func (x *ListCartResponseItem) GetVariantAttributes() map[string]string {
+	if x != nil {
+		return x.VariantAttributes
+	}
+	return nil
+}
+
+func (x *ListCartResponseItem) GetProductOriginalPrice() float64 {
+	if x != nil {
+		return x.ProductOriginalPrice
+	}
+	return 0
+}
+
+func (x *ListCartResponseItem) GetAvailableStock() int64 {
+	if x != nil && x.AvailableStock != nil {
+		return *x.AvailableStock
+	}
+	return 0
+}
+
+func (x *ListCartResponseItem) GetMinOrderQuantity() int64 {
+	if x != nil && x.MinOrderQuantity != nil {
+		return *x.MinOrderQuantity
+	}
+	return 0
+}
+
+func (x *ListCartResponseItem) GetMaxOrderQuantity() int64 {
+	if x != nil && x.MaxOrderQuantity != nil {
+		return *x.MaxOrderQuantity
+	}
+	return 0
+}
+
+func (x *ListCartResponseItem) GetWarnings() []*ListCartResponseItemWarning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type ListCartResponseItemWarning struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// one of unavailable, out_of_stock, reduced_availability,
+	// below_min_quantity, above_max_quantity and price_changed
+	Code    string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// the price the item had when it was added or its price was last
+	// accepted with AcceptCartPrice, set for price_changed
+	PreviousPrice *float64 `protobuf:"fixed64,3,opt,name=previous_price,json=previousPrice,proto3,oneof" json:"previous_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCartResponseItemWarning) Reset() {
+	*x = ListCartResponseItemWarning{}
+	mi := &file_cart_cart_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCartResponseItemWarning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCartResponseItemWarning) ProtoMessage() {}
+
+func (x *ListCartResponseItemWarning) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_cart_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCartResponseItemWarning.ProtoReflect.Descriptor instead.
+func (*ListCartResponseItemWarning) Descriptor() ([]byte, []int) {
+	return file_cart_cart_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListCartResponseItemWarning) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ListCartResponseItemWarning) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListCartResponseItemWarning) GetPreviousPrice() float64 {
+	if x != nil && x.PreviousPrice != nil {
+		return *x.PreviousPrice
+	}
+	return 0
+}
+
 type ListCartResponse struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Base          *common.BaseResponse    `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Items         []*ListCartResponseItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	state protoimpl.MessageState  `protogen:"open.v1"`
+	Base  *common.BaseResponse    `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Items []*ListCartResponseItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	// the currency every price is in
+	Currency string `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	// false when the cart is empty or an item has a warning CreateOrder would
+	// fail on
+	CanCheckout   bool `protobuf:"varint,4,opt,name=can_checkout,json=canCheckout,proto3" json:"can_checkout,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListCartResponse) Reset() {
 	*x = ListCartResponse{}
-	mi := &file_cart_cart_proto_msgTypes[4]
+	mi := &file_cart_cart_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -261,7 +428,7 @@ func (x *ListCartResponse) String() string {
 func (*ListCartResponse) ProtoMessage() {}
 
 func (x *ListCartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_cart_proto_msgTypes[4]
+	mi := &file_cart_cart_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -274,7 +441,7 @@ func (x *ListCartResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCartResponse.ProtoReflect.Descriptor instead.
 func (*ListCartResponse) Descriptor() ([]byte, []int) {
-	return file_cart_cart_proto_rawDescGZIP(), []int{4}
+	return file_cart_cart_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ListCartResponse) GetBase() *common.BaseResponse {
@@ -291,6 +458,20 @@ func (x *ListCartResponse) GetItems() []*ListCartResponseItem {
 	return nil
 }
 
+func (x *ListCartResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ListCartResponse) GetCanCheckout() bool {
+	if x != nil {
+		return x.CanCheckout
+	}
+	return false
+}
+
 type DeleteCartRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CartId        string                 `protobuf:"bytes,1,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
@@ -300,7 +481,7 @@ type DeleteCartRequest struct {
 
 func (x *DeleteCartRequest) Reset() {
 	*x = DeleteCartRequest{}
-	mi := &file_cart_cart_proto_msgTypes[5]
+	mi := &file_cart_cart_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -312,7 +493,7 @@ func (x *DeleteCartRequest) String() string {
 func (*DeleteCartRequest) ProtoMessage() {}
 
 func (x *DeleteCartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_cart_proto_msgTypes[5]
+	mi := &file_cart_cart_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -325,7 +506,7 @@ func (x *DeleteCartRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCartRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCartRequest) Descriptor() ([]byte, []int) {
-	return file_cart_cart_proto_rawDescGZIP(), []int{5}
+	return file_cart_cart_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *DeleteCartRequest) GetCartId() string {
@@ -344,7 +525,7 @@ type DeleteCartResponse struct {
 
 func (x *DeleteCartResponse) Reset() {
 	*x = DeleteCartResponse{}
-	mi := &file_cart_cart_proto_msgTypes[6]
+	mi := &file_cart_cart_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -356,7 +537,7 @@ func (x *DeleteCartResponse) String() string {
 func (*DeleteCartResponse) ProtoMessage() {}
 
 func (x *DeleteCartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_cart_proto_msgTypes[6]
+	mi := &file_cart_cart_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -369,7 +550,7 @@ func (x *DeleteCartResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCartResponse.ProtoReflect.Descriptor instead.
 func (*DeleteCartResponse) Descriptor() ([]byte, []int) {
-	return file_cart_cart_proto_rawDescGZIP(), []int{6}
+	return file_cart_cart_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *DeleteCartResponse) GetBase() *common.BaseResponse {
@@ -389,7 +570,7 @@ type UpdateCartQuantityRequest struct {
 
 func (x *UpdateCartQuantityRequest) Reset() {
 	*x = UpdateCartQuantityRequest{}
-	mi := &file_cart_cart_proto_msgTypes[7]
+	mi := &file_cart_cart_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -401,7 +582,7 @@ func (x *UpdateCartQuantityRequest) String() string {
 func (*UpdateCartQuantityRequest) ProtoMessage() {}
 
 func (x *UpdateCartQuantityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_cart_proto_msgTypes[7]
+	mi := &file_cart_cart_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -414,7 +595,7 @@ func (x *UpdateCartQuantityRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCartQuantityRequest.ProtoReflect.Descriptor instead.
 func (*UpdateCartQuantityRequest) Descriptor() ([]byte, []int) {
-	return file_cart_cart_proto_rawDescGZIP(), []int{7}
+	return file_cart_cart_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UpdateCartQuantityRequest) GetCartId() string {
@@ -440,7 +621,7 @@ type UpdateCartQuantityResponse struct {
 
 func (x *UpdateCartQuantityResponse) Reset() {
 	*x = UpdateCartQuantityResponse{}
-	mi := &file_cart_cart_proto_msgTypes[8]
+	mi := &file_cart_cart_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -452,7 +633,7 @@ func (x *UpdateCartQuantityResponse) String() string {
 func (*UpdateCartQuantityResponse) ProtoMessage() {}
 
 func (x *UpdateCartQuantityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_cart_proto_msgTypes[8]
+	mi := &file_cart_cart_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -465,7 +646,7 @@ func (x *UpdateCartQuantityResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCartQuantityResponse.ProtoReflect.Descriptor instead.
 func (*UpdateCartQuantityResponse) Descriptor() ([]byte, []int) {
-	return file_cart_cart_proto_rawDescGZIP(), []int{8}
+	return file_cart_cart_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateCartQuantityResponse) GetBase() *common.BaseResponse {
@@ -475,19 +656,112 @@ func (x *UpdateCartQuantityResponse) GetBase() *common.BaseResponse {
 	return nil
 }
 
+type AcceptCartPriceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CartId        string                 `protobuf:"bytes,1,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptCartPriceRequest) Reset() {
+	*x = AcceptCartPriceRequest{}
+	mi := &file_cart_cart_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptCartPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptCartPriceRequest) ProtoMessage() {}
+
+func (x *AcceptCartPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_cart_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptCartPriceRequest.ProtoReflect.Descriptor instead.
+func (*AcceptCartPriceRequest) Descriptor() ([]byte, []int) {
+	return file_cart_cart_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AcceptCartPriceRequest) GetCartId() string {
+	if x != nil {
+		return x.CartId
+	}
+	return ""
+}
+
+type AcceptCartPriceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptCartPriceResponse) Reset() {
+	*x = AcceptCartPriceResponse{}
+	mi := &file_cart_cart_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptCartPriceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptCartPriceResponse) ProtoMessage() {}
+
+func (x *AcceptCartPriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_cart_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptCartPriceResponse.ProtoReflect.Descriptor instead.
+func (*AcceptCartPriceResponse) Descriptor() ([]byte, []int) {
+	return file_cart_cart_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AcceptCartPriceResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
 var File_cart_cart_proto protoreflect.FileDescriptor
 
 const file_cart_cart_proto_rawDesc = "" +
 	"\n" +
-	"\x0fcart/cart.proto\x12\x04cart\x1a\x1acommon/base_response.proto\x1a\x1bbuf/validate/validate.proto\"D\n" +
+	"\x0fcart/cart.proto\x12\x04cart\x1a\x1acommon/base_response.proto\x1a\x1bbuf/validate/validate.proto\"\x95\x01\n" +
 	"\x17AddProductToCartRequest\x12)\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\tproductId\"T\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\tproductId\x12'\n" +
+	"\n" +
+	"variant_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\tvariantId\x12&\n" +
+	"\bquantity\x18\x03 \x01(\x03B\n" +
+	"\xbaH\a\"\x05\x18\xe8\a(\x00R\bquantity\"T\n" +
 	"\x18AddProductToCartResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
-	"\x02id\x18\x02 \x01(\tR\x02id\"\x11\n" +
-	"\x0fListCartRequest\"\xde\x01\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"F\n" +
+	"\x0fListCartRequest\x123\n" +
+	"\bcurrency\x18\x01 \x01(\tB\x17\xbaH\x14\xd8\x01\x02r\x0fR\x03IDRR\x03MYRR\x03SGDR\bcurrency\"\x91\x06\n" +
 	"\x14ListCartResponseItem\x12\x17\n" +
 	"\acart_id\x18\x01 \x01(\tR\x06cartId\x12\x1d\n" +
 	"\n" +
@@ -495,10 +769,34 @@ const file_cart_cart_proto_rawDesc = "" +
 	"\fproduct_name\x18\x03 \x01(\tR\vproductName\x12*\n" +
 	"\x11product_image_url\x18\x04 \x01(\tR\x0fproductImageUrl\x12#\n" +
 	"\rproduct_price\x18\x05 \x01(\x01R\fproductPrice\x12\x1a\n" +
-	"\bquantity\x18\x06 \x01(\x03R\bquantity\"n\n" +
+	"\bquantity\x18\x06 \x01(\x03R\bquantity\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\a \x01(\tR\tvariantId\x12\x1f\n" +
+	"\vvariant_sku\x18\b \x01(\tR\n" +
+	"variantSku\x12`\n" +
+	"\x12variant_attributes\x18\t \x03(\v21.cart.ListCartResponseItem.VariantAttributesEntryR\x11variantAttributes\x124\n" +
+	"\x16product_original_price\x18\n" +
+	" \x01(\x01R\x14productOriginalPrice\x12,\n" +
+	"\x0favailable_stock\x18\v \x01(\x03H\x00R\x0eavailableStock\x88\x01\x01\x121\n" +
+	"\x12min_order_quantity\x18\f \x01(\x03H\x01R\x10minOrderQuantity\x88\x01\x01\x121\n" +
+	"\x12max_order_quantity\x18\r \x01(\x03H\x02R\x10maxOrderQuantity\x88\x01\x01\x12=\n" +
+	"\bwarnings\x18\x0e \x03(\v2!.cart.ListCartResponseItemWarningR\bwarnings\x1aD\n" +
+	"\x16VariantAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x12\n" +
+	"\x10_available_stockB\x15\n" +
+	"\x13_min_order_quantityB\x15\n" +
+	"\x13_max_order_quantity\"\x8a\x01\n" +
+	"\x1bListCartResponseItemWarning\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12*\n" +
+	"\x0eprevious_price\x18\x03 \x01(\x01H\x00R\rpreviousPrice\x88\x01\x01B\x11\n" +
+	"\x0f_previous_price\"\xad\x01\n" +
 	"\x10ListCartResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x120\n" +
-	"\x05items\x18\x02 \x03(\v2\x1a.cart.ListCartResponseItemR\x05items\"8\n" +
+	"\x05items\x18\x02 \x03(\v2\x1a.cart.ListCartResponseItemR\x05items\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12!\n" +
+	"\fcan_checkout\x18\x04 \x01(\bR\vcanCheckout\"8\n" +
 	"\x11DeleteCartRequest\x12#\n" +
 	"\acart_id\x18\x01 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x06cartId\">\n" +
@@ -509,13 +807,19 @@ const file_cart_cart_proto_rawDesc = "" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x06cartId\x12*\n" +
 	"\fnew_quantity\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\vnewQuantity\"F\n" +
 	"\x1aUpdateCartQuantityResponse\x12(\n" +
-	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base2\xb5\x02\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"=\n" +
+	"\x16AcceptCartPriceRequest\x12#\n" +
+	"\acart_id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x06cartId\"C\n" +
+	"\x17AcceptCartPriceResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base2\x85\x03\n" +
 	"\vCartService\x12Q\n" +
 	"\x10AddProductToCart\x12\x1d.cart.AddProductToCartRequest\x1a\x1e.cart.AddProductToCartResponse\x129\n" +
 	"\bListCart\x12\x15.cart.ListCartRequest\x1a\x16.cart.ListCartResponse\x12?\n" +
 	"\n" +
 	"DeleteCart\x12\x17.cart.DeleteCartRequest\x1a\x18.cart.DeleteCartResponse\x12W\n" +
-	"\x12UpdateCartQuantity\x12\x1f.cart.UpdateCartQuantityRequest\x1a .cart.UpdateCartQuantityResponseB0Z.github.com/xryar/golang-grpc-ecommerce/pb/cartb\x06proto3"
+	"\x12UpdateCartQuantity\x12\x1f.cart.UpdateCartQuantityRequest\x1a .cart.UpdateCartQuantityResponse\x12N\n" +
+	"\x0fAcceptCartPrice\x12\x1c.cart.AcceptCartPriceRequest\x1a\x1d.cart.AcceptCartPriceResponseB0Z.github.com/xryar/golang-grpc-ecommerce/pb/cartb\x06proto3"
 
 var (
 	file_cart_cart_proto_rawDescOnce sync.Once
@@ -529,38 +833,47 @@ func file_cart_cart_proto_rawDescGZIP() []byte {
 	return file_cart_cart_proto_rawDescData
 }
 
-var file_cart_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_cart_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_cart_cart_proto_goTypes = []any{
-	(*AddProductToCartRequest)(nil),    // 0: cart.AddProductToCartRequest
-	(*AddProductToCartResponse)(nil),   // 1: cart.AddProductToCartResponse
-	(*ListCartRequest)(nil),            // 2: cart.ListCartRequest
-	(*ListCartResponseItem)(nil),       // 3: cart.ListCartResponseItem
-	(*ListCartResponse)(nil),           // 4: cart.ListCartResponse
-	(*DeleteCartRequest)(nil),          // 5: cart.DeleteCartRequest
-	(*DeleteCartResponse)(nil),         // 6: cart.DeleteCartResponse
-	(*UpdateCartQuantityRequest)(nil),  // 7: cart.UpdateCartQuantityRequest
-	(*UpdateCartQuantityResponse)(nil), // 8: cart.UpdateCartQuantityResponse
-	(*common.BaseResponse)(nil),        // 9: common.BaseResponse
+	(*AddProductToCartRequest)(nil),     // 0: cart.AddProductToCartRequest
+	(*AddProductToCartResponse)(nil),    // 1: cart.AddProductToCartResponse
+	(*ListCartRequest)(nil),             // 2: cart.ListCartRequest
+	(*ListCartResponseItem)(nil),        // 3: cart.ListCartResponseItem
+	(*ListCartResponseItemWarning)(nil), // 4: cart.ListCartResponseItemWarning
+	(*ListCartResponse)(nil),            // 5: cart.ListCartResponse
+	(*DeleteCartRequest)(nil),           // 6: cart.DeleteCartRequest
+	(*DeleteCartResponse)(nil),          // 7: cart.DeleteCartResponse
+	(*UpdateCartQuantityRequest)(nil),   // 8: cart.UpdateCartQuantityRequest
+	(*UpdateCartQuantityResponse)(nil),  // 9: cart.UpdateCartQuantityResponse
+	(*AcceptCartPriceRequest)(nil),      // 10: cart.AcceptCartPriceRequest
+	(*AcceptCartPriceResponse)(nil),     // 11: cart.AcceptCartPriceResponse
+	nil,                                 // 12: cart.ListCartResponseItem.VariantAttributesEntry
+	(*common.BaseResponse)(nil),         // 13: common.BaseResponse
 }
 var file_cart_cart_proto_depIdxs = []int32{
-	9, // 0: cart.AddProductToCartResponse.base:type_name -> common.BaseResponse
-	9, // 1: cart.ListCartResponse.base:type_name -> common.BaseResponse
-	3, // 2: cart.ListCartResponse.items:type_name -> cart.ListCartResponseItem
-	9, // 3: cart.DeleteCartResponse.base:type_name -> common.BaseResponse
-	9, // 4: cart.UpdateCartQuantityResponse.base:type_name -> common.BaseResponse
-	0, // 5: cart.CartService.AddProductToCart:input_type -> cart.AddProductToCartRequest
-	2, // 6: cart.CartService.ListCart:input_type -> cart.ListCartRequest
-	5, // 7: cart.CartService.DeleteCart:input_type -> cart.DeleteCartRequest
-	7, // 8: cart.CartService.UpdateCartQuantity:input_type -> cart.UpdateCartQuantityRequest
-	1, // 9: cart.CartService.AddProductToCart:output_type -> cart.AddProductToCartResponse
-	4, // 10: cart.CartService.ListCart:output_type -> cart.ListCartResponse
-	6, // 11: cart.CartService.DeleteCart:output_type -> cart.DeleteCartResponse
-	8, // 12: cart.CartService.UpdateCartQuantity:output_type -> cart.UpdateCartQuantityResponse
-	9, // [9:13] is the sub-list for method output_type
-	5, // [5:9] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	13, // 0: cart.AddProductToCartResponse.base:type_name -> common.BaseResponse
+	12, // 1: cart.ListCartResponseItem.variant_attributes:type_name -> cart.ListCartResponseItem.VariantAttributesEntry
+	4,  // 2: cart.ListCartResponseItem.warnings:type_name -> cart.ListCartResponseItemWarning
+	13, // 3: cart.ListCartResponse.base:type_name -> common.BaseResponse
+	3,  // 4: cart.ListCartResponse.items:type_name -> cart.ListCartResponseItem
+	13, // 5: cart.DeleteCartResponse.base:type_name -> common.BaseResponse
+	13, // 6: cart.UpdateCartQuantityResponse.base:type_name -> common.BaseResponse
+	13, // 7: cart.AcceptCartPriceResponse.base:type_name -> common.BaseResponse
+	0,  // 8: cart.CartService.AddProductToCart:input_type -> cart.AddProductToCartRequest
+	2,  // 9: cart.CartService.ListCart:input_type -> cart.ListCartRequest
+	6,  // 10: cart.CartService.DeleteCart:input_type -> cart.DeleteCartRequest
+	8,  // 11: cart.CartService.UpdateCartQuantity:input_type -> cart.UpdateCartQuantityRequest
+	10, // 12: cart.CartService.AcceptCartPrice:input_type -> cart.AcceptCartPriceRequest
+	1,  // 13: cart.CartService.AddProductToCart:output_type -> cart.AddProductToCartResponse
+	5,  // 14: cart.CartService.ListCart:output_type -> cart.ListCartResponse
+	7,  // 15: cart.CartService.DeleteCart:output_type -> cart.DeleteCartResponse
+	9,  // 16: cart.CartService.UpdateCartQuantity:output_type -> cart.UpdateCartQuantityResponse
+	11, // 17: cart.CartService.AcceptCartPrice:output_type -> cart.AcceptCartPriceResponse
+	13, // [13:18] is the sub-list for method output_type
+	8,  // [8:13] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_cart_cart_proto_init() }
@@ -568,13 +881,15 @@ func file_cart_cart_proto_init() {
 	if File_cart_cart_proto != nil {
 		return
 	}
+	file_cart_cart_proto_msgTypes[3].OneofWrappers = []any{}
+	file_cart_cart_proto_msgTypes[4].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cart_cart_proto_rawDesc), len(file_cart_cart_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
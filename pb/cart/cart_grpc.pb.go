@@ -23,6 +23,7 @@ const (
 	CartService_ListCart_FullMethodName           = "/cart.CartService/ListCart"
 	CartService_DeleteCart_FullMethodName         = "/cart.CartService/DeleteCart"
 	CartService_UpdateCartQuantity_FullMethodName = "/cart.CartService/UpdateCartQuantity"
+	CartService_AcceptCartPrice_FullMethodName    = "/cart.CartService/AcceptCartPrice"
 )
 
 // CartServiceClient is the client API for CartService service.
@@ -33,6 +34,7 @@ type CartServiceClient interface {
 	ListCart(ctx context.Context, in *ListCartRequest, opts ...grpc.CallOption) (*ListCartResponse, error)
 	DeleteCart(ctx context.Context, in *DeleteCartRequest, opts ...grpc.CallOption) (*DeleteCartResponse, error)
 	UpdateCartQuantity(ctx context.Context, in *UpdateCartQuantityRequest, opts ...grpc.CallOption) (*UpdateCartQuantityResponse, error)
+	AcceptCartPrice(ctx context.Context, in *AcceptCartPriceRequest, opts ...grpc.CallOption) (*AcceptCartPriceResponse, error)
 }
 
 type cartServiceClient struct {
@@ -83,6 +85,16 @@ func (c *cartServiceClient) UpdateCartQuantity(ctx context.Context, in *UpdateCa
 	return out, nil
 }
 
+func (c *cartServiceClient) AcceptCartPrice(ctx context.Context, in *AcceptCartPriceRequest, opts ...grpc.CallOption) (*AcceptCartPriceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptCartPriceResponse)
+	err := c.cc.Invoke(ctx, CartService_AcceptCartPrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CartServiceServer is the server API for CartService service.
 // All implementations must embed UnimplementedCartServiceServer
 // for forward compatibility.
@@ -91,6 +103,7 @@ type CartServiceServer interface {
 	ListCart(context.Context, *ListCartRequest) (*ListCartResponse, error)
 	DeleteCart(context.Context, *DeleteCartRequest) (*DeleteCartResponse, error)
 	UpdateCartQuantity(context.Context, *UpdateCartQuantityRequest) (*UpdateCartQuantityResponse, error)
+	AcceptCartPrice(context.Context, *AcceptCartPriceRequest) (*AcceptCartPriceResponse, error)
 	mustEmbedUnimplementedCartServiceServer()
 }
 
@@ -113,6 +126,9 @@ func (UnimplementedCartServiceServer) DeleteCart(context.Context, *DeleteCartReq
 func (UnimplementedCartServiceServer) UpdateCartQuantity(context.Context, *UpdateCartQuantityRequest) (*UpdateCartQuantityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCartQuantity not implemented")
 }
+func (UnimplementedCartServiceServer) AcceptCartPrice(context.Context, *AcceptCartPriceRequest) (*AcceptCartPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptCartPrice not implemented")
+}
 func (UnimplementedCartServiceServer) mustEmbedUnimplementedCartServiceServer() {}
 func (UnimplementedCartServiceServer) testEmbeddedByValue()                     {}
 
@@ -206,6 +222,24 @@ func _CartService_UpdateCartQuantity_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CartService_AcceptCartPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptCartPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).AcceptCartPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_AcceptCartPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).AcceptCartPrice(ctx, req.(*AcceptCartPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CartService_ServiceDesc is the grpc.ServiceDesc for CartService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,6 +263,10 @@ var CartService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCartQuantity",
 			Handler:    _CartService_UpdateCartQuantity_Handler,
 		},
+		{
+			MethodName: "AcceptCartPrice",
+			Handler:    _CartService_AcceptCartPrice_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cart/cart.proto",
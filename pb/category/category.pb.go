@@ -0,0 +1,1177 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: category/category.proto
+
+package category
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	common "github.com/xryar/golang-grpc-ecommerce/pb/common"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ParentId      string                 `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_category_category_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_category_category_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_category_category_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+type CreateCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryResponse) Reset() {
+	*x = CreateCategoryResponse{}
+	mi := &file_category_category_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryResponse) ProtoMessage() {}
+
+func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_category_category_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*CreateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_category_category_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateCategoryResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CreateCategoryResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type EditCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ParentId      string                 `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditCategoryRequest) Reset() {
+	*x = EditCategoryRequest{}
+	mi := &file_category_category_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditCategoryRequest) ProtoMessage() {}
+
+func (x *EditCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_category_category_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditCategoryRequest.ProtoReflect.Descriptor instead.
+func (*EditCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_category_category_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EditCategoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EditCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EditCategoryRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+type EditCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditCategoryResponse) Reset() {
+	*x = EditCategoryResponse{}
+	mi := &file_category_category_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditCategoryResponse) ProtoMessage() {}
+
+func (x *EditCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_category_category_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditCategoryResponse.ProtoReflect.Descriptor instead.
+func (*EditCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_category_category_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EditCategoryResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *EditCategoryResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	mi := &file_category_category_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_category_category_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_category_category_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteCategoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryResponse) Reset() {
+	*x = DeleteCategoryResponse{}
+	mi := &file_category_category_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryResponse) ProtoMessage() {}
+
+func (x *DeleteCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_category_category_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_category_category_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteCategoryResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type ListCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoryRequest) Reset() {
+	*x = ListCategoryRequest{}
+	mi := &file_category_category_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoryRequest) ProtoMessage() {}
+
+func (x *ListCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_category_category_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoryRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_category_category_proto_rawDescGZIP(), []int{6}
+}
+
+type ListCategoryResponseItem struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Id            string                      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ParentId      string                      `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Children      []*ListCategoryResponseItem `protobuf:"bytes,4,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoryResponseItem) Reset() {
+	*x = ListCategoryResponseItem{}
+	mi := &file_category_category_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoryResponseItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoryResponseItem) ProtoMessage() {}
+
+func (x *ListCategoryResponseItem) ProtoReflect() protoreflect.Message {
+	mi := &file_category_category_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoryResponseItem.ProtoReflect.Descriptor instead.
+func (*ListCategoryResponseItem) Descriptor() ([]byte, []int) {
+	return file_category_category_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListCategoryResponseItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListCategoryResponseItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListCategoryResponseItem) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *ListCategoryResponseItem) GetChildren() []*ListCategoryResponseItem {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type ListCategoryResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Base          *common.BaseResponse        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          []*ListCategoryResponseItem `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoryResponse) Reset() {
+	*x = ListCategoryResponse{}
+	mi := &file_category_category_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoryResponse) ProtoMessage() {}
+
+func (x *ListCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_category_category_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoryResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_category_category_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListCategoryResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListCategoryResponse) GetData() []*ListCategoryResponseItem {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CreateCategoryAttributeRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	// names the attribute in product filters, categories may share a code
+	// for attributes that mean the same thing
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	// e.g. kg for a weight, only shown next to the value
+	Unit          string `protobuf:"bytes,5,opt,name=unit,proto3" json:"unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryAttributeRequest) Reset() {
+	*x = CreateCategoryAttributeRequest{}
+	mi := &file_category_category_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryAttributeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryAttributeRequest) ProtoMessage() {}
+
+func (x *CreateCategoryAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_category_category_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryAttributeRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_category_category_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateCategoryAttributeRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *CreateCategoryAttributeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateCategoryAttributeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCategoryAttributeRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateCategoryAttributeRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type CreateCategoryAttributeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryAttributeResponse) Reset() {
+	*x = CreateCategoryAttributeResponse{}
+	mi := &file_category_category_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryAttributeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryAttributeResponse) ProtoMessage() {}
+
+func (x *CreateCategoryAttributeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_category_category_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryAttributeResponse.ProtoReflect.Descriptor instead.
+func (*CreateCategoryAttributeResponse) Descriptor() ([]byte, []int) {
+	return file_category_category_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateCategoryAttributeResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CreateCategoryAttributeResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// the code and type of an attribute can not be changed
+type EditCategoryAttributeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Unit          string                 `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditCategoryAttributeRequest) Reset() {
+	*x = EditCategoryAttributeRequest{}
+	mi := &file_category_category_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditCategoryAttributeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditCategoryAttributeRequest) ProtoMessage() {}
+
+func (x *EditCategoryAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_category_category_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditCategoryAttributeRequest.ProtoReflect.Descriptor instead.
+func (*EditCategoryAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_category_category_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *EditCategoryAttributeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EditCategoryAttributeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EditCategoryAttributeRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type EditCategoryAttributeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditCategoryAttributeResponse) Reset() {
+	*x = EditCategoryAttributeResponse{}
+	mi := &file_category_category_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditCategoryAttributeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditCategoryAttributeResponse) ProtoMessage() {}
+
+func (x *EditCategoryAttributeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_category_category_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditCategoryAttributeResponse.ProtoReflect.Descriptor instead.
+func (*EditCategoryAttributeResponse) Descriptor() ([]byte, []int) {
+	return file_category_category_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EditCategoryAttributeResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *EditCategoryAttributeResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteCategoryAttributeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryAttributeRequest) Reset() {
+	*x = DeleteCategoryAttributeRequest{}
+	mi := &file_category_category_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryAttributeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryAttributeRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_category_category_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryAttributeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_category_category_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteCategoryAttributeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteCategoryAttributeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryAttributeResponse) Reset() {
+	*x = DeleteCategoryAttributeResponse{}
+	mi := &file_category_category_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryAttributeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryAttributeResponse) ProtoMessage() {}
+
+func (x *DeleteCategoryAttributeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_category_category_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryAttributeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryAttributeResponse) Descriptor() ([]byte, []int) {
+	return file_category_category_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeleteCategoryAttributeResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type ListCategoryAttributeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoryAttributeRequest) Reset() {
+	*x = ListCategoryAttributeRequest{}
+	mi := &file_category_category_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoryAttributeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoryAttributeRequest) ProtoMessage() {}
+
+func (x *ListCategoryAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_category_category_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoryAttributeRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoryAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_category_category_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListCategoryAttributeRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+type ListCategoryAttributeResponseItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the category the attribute is defined on, the requested one or one of
+	// its parents
+	CategoryId    string `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Code          string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	Unit          string `protobuf:"bytes,6,opt,name=unit,proto3" json:"unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoryAttributeResponseItem) Reset() {
+	*x = ListCategoryAttributeResponseItem{}
+	mi := &file_category_category_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoryAttributeResponseItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoryAttributeResponseItem) ProtoMessage() {}
+
+func (x *ListCategoryAttributeResponseItem) ProtoReflect() protoreflect.Message {
+	mi := &file_category_category_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoryAttributeResponseItem.ProtoReflect.Descriptor instead.
+func (*ListCategoryAttributeResponseItem) Descriptor() ([]byte, []int) {
+	return file_category_category_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListCategoryAttributeResponseItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListCategoryAttributeResponseItem) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *ListCategoryAttributeResponseItem) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ListCategoryAttributeResponseItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListCategoryAttributeResponseItem) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListCategoryAttributeResponseItem) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type ListCategoryAttributeResponse struct {
+	state         protoimpl.MessageState               `protogen:"open.v1"`
+	Base          *common.BaseResponse                 `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          []*ListCategoryAttributeResponseItem `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoryAttributeResponse) Reset() {
+	*x = ListCategoryAttributeResponse{}
+	mi := &file_category_category_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoryAttributeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoryAttributeResponse) ProtoMessage() {}
+
+func (x *ListCategoryAttributeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_category_category_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoryAttributeResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoryAttributeResponse) Descriptor() ([]byte, []int) {
+	return file_category_category_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListCategoryAttributeResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListCategoryAttributeResponse) GetData() []*ListCategoryAttributeResponseItem {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_category_category_proto protoreflect.FileDescriptor
+
+const file_category_category_proto_rawDesc = "" +
+	"\n" +
+	"\x17category/category.proto\x12\bcategory\x1a\x1acommon/base_response.proto\x1a\x1bbuf/validate/validate.proto\"^\n" +
+	"\x15CreateCategoryRequest\x12\x1e\n" +
+	"\x04name\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12%\n" +
+	"\tparent_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\bparentId\"R\n" +
+	"\x16CreateCategoryResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"x\n" +
+	"\x13EditCategoryRequest\x12\x1a\n" +
+	"\x02id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\x12\x1e\n" +
+	"\x04name\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12%\n" +
+	"\tparent_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\bparentId\"P\n" +
+	"\x14EditCategoryResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"3\n" +
+	"\x15DeleteCategoryRequest\x12\x1a\n" +
+	"\x02id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\"B\n" +
+	"\x16DeleteCategoryResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"\x15\n" +
+	"\x13ListCategoryRequest\"\x9b\x01\n" +
+	"\x18ListCategoryResponseItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\tR\bparentId\x12>\n" +
+	"\bchildren\x18\x04 \x03(\v2\".category.ListCategoryResponseItemR\bchildren\"x\n" +
+	"\x14ListCategoryResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x126\n" +
+	"\x04data\x18\x02 \x03(\v2\".category.ListCategoryResponseItemR\x04data\"\xf5\x01\n" +
+	"\x1eCreateCategoryAttributeRequest\x12+\n" +
+	"\vcategory_id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\n" +
+	"categoryId\x127\n" +
+	"\x04code\x18\x02 \x01(\tB#\xbaH r\x1e\x10\x01\x18d2\x18^[a-z0-9]+(_[a-z0-9]+)*$R\x04code\x12\x1e\n" +
+	"\x04name\x18\x03 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x120\n" +
+	"\x04type\x18\x04 \x01(\tB\x1c\xbaH\x19r\x17R\x04textR\x06numberR\abooleanR\x04type\x12\x1b\n" +
+	"\x04unit\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x182R\x04unit\"[\n" +
+	"\x1fCreateCategoryAttributeResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"w\n" +
+	"\x1cEditCategoryAttributeRequest\x12\x1a\n" +
+	"\x02id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\x12\x1e\n" +
+	"\x04name\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12\x1b\n" +
+	"\x04unit\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x182R\x04unit\"Y\n" +
+	"\x1dEditCategoryAttributeResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"<\n" +
+	"\x1eDeleteCategoryAttributeRequest\x12\x1a\n" +
+	"\x02id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\"K\n" +
+	"\x1fDeleteCategoryAttributeResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"K\n" +
+	"\x1cListCategoryAttributeRequest\x12+\n" +
+	"\vcategory_id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\n" +
+	"categoryId\"\xa4\x01\n" +
+	"!ListCategoryAttributeResponseItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\tR\n" +
+	"categoryId\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\x12\x12\n" +
+	"\x04unit\x18\x06 \x01(\tR\x04unit\"\x8a\x01\n" +
+	"\x1dListCategoryAttributeResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12?\n" +
+	"\x04data\x18\x02 \x03(\v2+.category.ListCategoryAttributeResponseItemR\x04data2\x8d\x06\n" +
+	"\x0fCategoryService\x12S\n" +
+	"\x0eCreateCategory\x12\x1f.category.CreateCategoryRequest\x1a .category.CreateCategoryResponse\x12M\n" +
+	"\fEditCategory\x12\x1d.category.EditCategoryRequest\x1a\x1e.category.EditCategoryResponse\x12S\n" +
+	"\x0eDeleteCategory\x12\x1f.category.DeleteCategoryRequest\x1a .category.DeleteCategoryResponse\x12M\n" +
+	"\fListCategory\x12\x1d.category.ListCategoryRequest\x1a\x1e.category.ListCategoryResponse\x12n\n" +
+	"\x17CreateCategoryAttribute\x12(.category.CreateCategoryAttributeRequest\x1a).category.CreateCategoryAttributeResponse\x12h\n" +
+	"\x15EditCategoryAttribute\x12&.category.EditCategoryAttributeRequest\x1a'.category.EditCategoryAttributeResponse\x12n\n" +
+	"\x17DeleteCategoryAttribute\x12(.category.DeleteCategoryAttributeRequest\x1a).category.DeleteCategoryAttributeResponse\x12h\n" +
+	"\x15ListCategoryAttribute\x12&.category.ListCategoryAttributeRequest\x1a'.category.ListCategoryAttributeResponseB4Z2github.com/xryar/golang-grpc-ecommerce/pb/categoryb\x06proto3"
+
+var (
+	file_category_category_proto_rawDescOnce sync.Once
+	file_category_category_proto_rawDescData []byte
+)
+
+func file_category_category_proto_rawDescGZIP() []byte {
+	file_category_category_proto_rawDescOnce.Do(func() {
+		file_category_category_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_category_category_proto_rawDesc), len(file_category_category_proto_rawDesc)))
+	})
+	return file_category_category_proto_rawDescData
+}
+
+var file_category_category_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_category_category_proto_goTypes = []any{
+	(*CreateCategoryRequest)(nil),             // 0: category.CreateCategoryRequest
+	(*CreateCategoryResponse)(nil),            // 1: category.CreateCategoryResponse
+	(*EditCategoryRequest)(nil),               // 2: category.EditCategoryRequest
+	(*EditCategoryResponse)(nil),              // 3: category.EditCategoryResponse
+	(*DeleteCategoryRequest)(nil),             // 4: category.DeleteCategoryRequest
+	(*DeleteCategoryResponse)(nil),            // 5: category.DeleteCategoryResponse
+	(*ListCategoryRequest)(nil),               // 6: category.ListCategoryRequest
+	(*ListCategoryResponseItem)(nil),          // 7: category.ListCategoryResponseItem
+	(*ListCategoryResponse)(nil),              // 8: category.ListCategoryResponse
+	(*CreateCategoryAttributeRequest)(nil),    // 9: category.CreateCategoryAttributeRequest
+	(*CreateCategoryAttributeResponse)(nil),   // 10: category.CreateCategoryAttributeResponse
+	(*EditCategoryAttributeRequest)(nil),      // 11: category.EditCategoryAttributeRequest
+	(*EditCategoryAttributeResponse)(nil),     // 12: category.EditCategoryAttributeResponse
+	(*DeleteCategoryAttributeRequest)(nil),    // 13: category.DeleteCategoryAttributeRequest
+	(*DeleteCategoryAttributeResponse)(nil),   // 14: category.DeleteCategoryAttributeResponse
+	(*ListCategoryAttributeRequest)(nil),      // 15: category.ListCategoryAttributeRequest
+	(*ListCategoryAttributeResponseItem)(nil), // 16: category.ListCategoryAttributeResponseItem
+	(*ListCategoryAttributeResponse)(nil),     // 17: category.ListCategoryAttributeResponse
+	(*common.BaseResponse)(nil),               // 18: common.BaseResponse
+}
+var file_category_category_proto_depIdxs = []int32{
+	18, // 0: category.CreateCategoryResponse.base:type_name -> common.BaseResponse
+	18, // 1: category.EditCategoryResponse.base:type_name -> common.BaseResponse
+	18, // 2: category.DeleteCategoryResponse.base:type_name -> common.BaseResponse
+	7,  // 3: category.ListCategoryResponseItem.children:type_name -> category.ListCategoryResponseItem
+	18, // 4: category.ListCategoryResponse.base:type_name -> common.BaseResponse
+	7,  // 5: category.ListCategoryResponse.data:type_name -> category.ListCategoryResponseItem
+	18, // 6: category.CreateCategoryAttributeResponse.base:type_name -> common.BaseResponse
+	18, // 7: category.EditCategoryAttributeResponse.base:type_name -> common.BaseResponse
+	18, // 8: category.DeleteCategoryAttributeResponse.base:type_name -> common.BaseResponse
+	18, // 9: category.ListCategoryAttributeResponse.base:type_name -> common.BaseResponse
+	16, // 10: category.ListCategoryAttributeResponse.data:type_name -> category.ListCategoryAttributeResponseItem
+	0,  // 11: category.CategoryService.CreateCategory:input_type -> category.CreateCategoryRequest
+	2,  // 12: category.CategoryService.EditCategory:input_type -> category.EditCategoryRequest
+	4,  // 13: category.CategoryService.DeleteCategory:input_type -> category.DeleteCategoryRequest
+	6,  // 14: category.CategoryService.ListCategory:input_type -> category.ListCategoryRequest
+	9,  // 15: category.CategoryService.CreateCategoryAttribute:input_type -> category.CreateCategoryAttributeRequest
+	11, // 16: category.CategoryService.EditCategoryAttribute:input_type -> category.EditCategoryAttributeRequest
+	13, // 17: category.CategoryService.DeleteCategoryAttribute:input_type -> category.DeleteCategoryAttributeRequest
+	15, // 18: category.CategoryService.ListCategoryAttribute:input_type -> category.ListCategoryAttributeRequest
+	1,  // 19: category.CategoryService.CreateCategory:output_type -> category.CreateCategoryResponse
+	3,  // 20: category.CategoryService.EditCategory:output_type -> category.EditCategoryResponse
+	5,  // 21: category.CategoryService.DeleteCategory:output_type -> category.DeleteCategoryResponse
+	8,  // 22: category.CategoryService.ListCategory:output_type -> category.ListCategoryResponse
+	10, // 23: category.CategoryService.CreateCategoryAttribute:output_type -> category.CreateCategoryAttributeResponse
+	12, // 24: category.CategoryService.EditCategoryAttribute:output_type -> category.EditCategoryAttributeResponse
+	14, // 25: category.CategoryService.DeleteCategoryAttribute:output_type -> category.DeleteCategoryAttributeResponse
+	17, // 26: category.CategoryService.ListCategoryAttribute:output_type -> category.ListCategoryAttributeResponse
+	19, // [19:27] is the sub-list for method output_type
+	11, // [11:19] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_category_category_proto_init() }
+func file_category_category_proto_init() {
+	if File_category_category_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_category_category_proto_rawDesc), len(file_category_category_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_category_category_proto_goTypes,
+		DependencyIndexes: file_category_category_proto_depIdxs,
+		MessageInfos:      file_category_category_proto_msgTypes,
+	}.Build()
+	File_category_category_proto = out.File
+	file_category_category_proto_goTypes = nil
+	file_category_category_proto_depIdxs = nil
+}
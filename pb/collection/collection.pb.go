@@ -0,0 +1,866 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: collection/collection.proto
+
+package collection
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	common "github.com/xryar/golang-grpc-ecommerce/pb/common"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// rule_type picks how the collection is filled: "manual" uses the products
+// set with SetCollectionProducts, "best_seller" the most ordered products of
+// the last rule_days days (0 for all time) and "newest" the latest products.
+// The collection is only shown between starts_at and ends_at, an unset bound
+// leaves that side open.
+type CreateCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	RuleType      string                 `protobuf:"bytes,3,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
+	RuleDays      int32                  `protobuf:"varint,4,opt,name=rule_days,json=ruleDays,proto3" json:"rule_days,omitempty"`
+	ProductLimit  int32                  `protobuf:"varint,5,opt,name=product_limit,json=productLimit,proto3" json:"product_limit,omitempty"`
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCollectionRequest) Reset() {
+	*x = CreateCollectionRequest{}
+	mi := &file_collection_collection_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectionRequest) ProtoMessage() {}
+
+func (x *CreateCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_collection_collection_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectionRequest.ProtoReflect.Descriptor instead.
+func (*CreateCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_collection_collection_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateCollectionRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CreateCollectionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCollectionRequest) GetRuleType() string {
+	if x != nil {
+		return x.RuleType
+	}
+	return ""
+}
+
+func (x *CreateCollectionRequest) GetRuleDays() int32 {
+	if x != nil {
+		return x.RuleDays
+	}
+	return 0
+}
+
+func (x *CreateCollectionRequest) GetProductLimit() int32 {
+	if x != nil {
+		return x.ProductLimit
+	}
+	return 0
+}
+
+func (x *CreateCollectionRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *CreateCollectionRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+type CreateCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCollectionResponse) Reset() {
+	*x = CreateCollectionResponse{}
+	mi := &file_collection_collection_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectionResponse) ProtoMessage() {}
+
+func (x *CreateCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_collection_collection_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectionResponse.ProtoReflect.Descriptor instead.
+func (*CreateCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_collection_collection_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateCollectionResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CreateCollectionResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type EditCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	RuleType      string                 `protobuf:"bytes,4,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
+	RuleDays      int32                  `protobuf:"varint,5,opt,name=rule_days,json=ruleDays,proto3" json:"rule_days,omitempty"`
+	ProductLimit  int32                  `protobuf:"varint,6,opt,name=product_limit,json=productLimit,proto3" json:"product_limit,omitempty"`
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditCollectionRequest) Reset() {
+	*x = EditCollectionRequest{}
+	mi := &file_collection_collection_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditCollectionRequest) ProtoMessage() {}
+
+func (x *EditCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_collection_collection_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditCollectionRequest.ProtoReflect.Descriptor instead.
+func (*EditCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_collection_collection_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EditCollectionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EditCollectionRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *EditCollectionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EditCollectionRequest) GetRuleType() string {
+	if x != nil {
+		return x.RuleType
+	}
+	return ""
+}
+
+func (x *EditCollectionRequest) GetRuleDays() int32 {
+	if x != nil {
+		return x.RuleDays
+	}
+	return 0
+}
+
+func (x *EditCollectionRequest) GetProductLimit() int32 {
+	if x != nil {
+		return x.ProductLimit
+	}
+	return 0
+}
+
+func (x *EditCollectionRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *EditCollectionRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+type EditCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditCollectionResponse) Reset() {
+	*x = EditCollectionResponse{}
+	mi := &file_collection_collection_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditCollectionResponse) ProtoMessage() {}
+
+func (x *EditCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_collection_collection_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditCollectionResponse.ProtoReflect.Descriptor instead.
+func (*EditCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_collection_collection_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EditCollectionResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *EditCollectionResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCollectionRequest) Reset() {
+	*x = DeleteCollectionRequest{}
+	mi := &file_collection_collection_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCollectionRequest) ProtoMessage() {}
+
+func (x *DeleteCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_collection_collection_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCollectionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_collection_collection_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteCollectionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCollectionResponse) Reset() {
+	*x = DeleteCollectionResponse{}
+	mi := &file_collection_collection_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCollectionResponse) ProtoMessage() {}
+
+func (x *DeleteCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_collection_collection_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCollectionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_collection_collection_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteCollectionResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type ListCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionRequest) Reset() {
+	*x = ListCollectionRequest{}
+	mi := &file_collection_collection_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionRequest) ProtoMessage() {}
+
+func (x *ListCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_collection_collection_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_collection_collection_proto_rawDescGZIP(), []int{6}
+}
+
+type ListCollectionResponseItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	RuleType      string                 `protobuf:"bytes,4,opt,name=rule_type,json=ruleType,proto3" json:"rule_type,omitempty"`
+	RuleDays      int32                  `protobuf:"varint,5,opt,name=rule_days,json=ruleDays,proto3" json:"rule_days,omitempty"`
+	ProductLimit  int32                  `protobuf:"varint,6,opt,name=product_limit,json=productLimit,proto3" json:"product_limit,omitempty"`
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	ProductIds    []string               `protobuf:"bytes,9,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	IsActive      bool                   `protobuf:"varint,10,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionResponseItem) Reset() {
+	*x = ListCollectionResponseItem{}
+	mi := &file_collection_collection_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionResponseItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionResponseItem) ProtoMessage() {}
+
+func (x *ListCollectionResponseItem) ProtoReflect() protoreflect.Message {
+	mi := &file_collection_collection_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionResponseItem.ProtoReflect.Descriptor instead.
+func (*ListCollectionResponseItem) Descriptor() ([]byte, []int) {
+	return file_collection_collection_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListCollectionResponseItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListCollectionResponseItem) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ListCollectionResponseItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListCollectionResponseItem) GetRuleType() string {
+	if x != nil {
+		return x.RuleType
+	}
+	return ""
+}
+
+func (x *ListCollectionResponseItem) GetRuleDays() int32 {
+	if x != nil {
+		return x.RuleDays
+	}
+	return 0
+}
+
+func (x *ListCollectionResponseItem) GetProductLimit() int32 {
+	if x != nil {
+		return x.ProductLimit
+	}
+	return 0
+}
+
+func (x *ListCollectionResponseItem) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *ListCollectionResponseItem) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *ListCollectionResponseItem) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *ListCollectionResponseItem) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type ListCollectionResponse struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Base          *common.BaseResponse          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          []*ListCollectionResponseItem `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionResponse) Reset() {
+	*x = ListCollectionResponse{}
+	mi := &file_collection_collection_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionResponse) ProtoMessage() {}
+
+func (x *ListCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_collection_collection_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_collection_collection_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListCollectionResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListCollectionResponse) GetData() []*ListCollectionResponseItem {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SetCollectionProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CollectionId  string                 `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	ProductIds    []string               `protobuf:"bytes,2,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCollectionProductsRequest) Reset() {
+	*x = SetCollectionProductsRequest{}
+	mi := &file_collection_collection_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCollectionProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCollectionProductsRequest) ProtoMessage() {}
+
+func (x *SetCollectionProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_collection_collection_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCollectionProductsRequest.ProtoReflect.Descriptor instead.
+func (*SetCollectionProductsRequest) Descriptor() ([]byte, []int) {
+	return file_collection_collection_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SetCollectionProductsRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *SetCollectionProductsRequest) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+type SetCollectionProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCollectionProductsResponse) Reset() {
+	*x = SetCollectionProductsResponse{}
+	mi := &file_collection_collection_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCollectionProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCollectionProductsResponse) ProtoMessage() {}
+
+func (x *SetCollectionProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_collection_collection_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCollectionProductsResponse.ProtoReflect.Descriptor instead.
+func (*SetCollectionProductsResponse) Descriptor() ([]byte, []int) {
+	return file_collection_collection_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SetCollectionProductsResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+var File_collection_collection_proto protoreflect.FileDescriptor
+
+const file_collection_collection_proto_rawDesc = "" +
+	"\n" +
+	"\x1bcollection/collection.proto\x12\n" +
+	"collection\x1a\x1acommon/base_response.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf8\x02\n" +
+	"\x17CreateCollectionRequest\x125\n" +
+	"\x03key\x18\x01 \x01(\tB#\xbaH r\x1e\x10\x01\x18d2\x18^[a-z0-9]+(-[a-z0-9]+)*$R\x03key\x12\x1e\n" +
+	"\x04name\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12?\n" +
+	"\trule_type\x18\x03 \x01(\tB\"\xbaH\x1fr\x1dR\x06manualR\vbest_sellerR\x06newestR\bruleType\x12'\n" +
+	"\trule_days\x18\x04 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xc2\x1c(\x00R\bruleDays\x12.\n" +
+	"\rproduct_limit\x18\x05 \x01(\x05B\t\xbaH\x06\x1a\x04\x182(\x01R\fproductLimit\x127\n" +
+	"\tstarts_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\"T\n" +
+	"\x18CreateCollectionResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\x92\x03\n" +
+	"\x15EditCollectionRequest\x12\x1a\n" +
+	"\x02id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\x125\n" +
+	"\x03key\x18\x02 \x01(\tB#\xbaH r\x1e\x10\x01\x18d2\x18^[a-z0-9]+(-[a-z0-9]+)*$R\x03key\x12\x1e\n" +
+	"\x04name\x18\x03 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12?\n" +
+	"\trule_type\x18\x04 \x01(\tB\"\xbaH\x1fr\x1dR\x06manualR\vbest_sellerR\x06newestR\bruleType\x12'\n" +
+	"\trule_days\x18\x05 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xc2\x1c(\x00R\bruleDays\x12.\n" +
+	"\rproduct_limit\x18\x06 \x01(\x05B\t\xbaH\x06\x1a\x04\x182(\x01R\fproductLimit\x127\n" +
+	"\tstarts_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\"R\n" +
+	"\x16EditCollectionResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"5\n" +
+	"\x17DeleteCollectionRequest\x12\x1a\n" +
+	"\x02id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\"D\n" +
+	"\x18DeleteCollectionResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"\x17\n" +
+	"\x15ListCollectionRequest\"\xdd\x02\n" +
+	"\x1aListCollectionResponseItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1b\n" +
+	"\trule_type\x18\x04 \x01(\tR\bruleType\x12\x1b\n" +
+	"\trule_days\x18\x05 \x01(\x05R\bruleDays\x12#\n" +
+	"\rproduct_limit\x18\x06 \x01(\x05R\fproductLimit\x127\n" +
+	"\tstarts_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12\x1f\n" +
+	"\vproduct_ids\x18\t \x03(\tR\n" +
+	"productIds\x12\x1b\n" +
+	"\tis_active\x18\n" +
+	" \x01(\bR\bisActive\"~\n" +
+	"\x16ListCollectionResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12:\n" +
+	"\x04data\x18\x02 \x03(\v2&.collection.ListCollectionResponseItemR\x04data\"\x83\x01\n" +
+	"\x1cSetCollectionProductsRequest\x12/\n" +
+	"\rcollection_id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\fcollectionId\x122\n" +
+	"\vproduct_ids\x18\x02 \x03(\tB\x11\xbaH\x0e\x92\x01\v\x102\x18\x01\"\x05r\x03\xb0\x01\x01R\n" +
+	"productIds\"I\n" +
+	"\x1dSetCollectionProductsResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base2\xf1\x03\n" +
+	"\x11CollectionService\x12]\n" +
+	"\x10CreateCollection\x12#.collection.CreateCollectionRequest\x1a$.collection.CreateCollectionResponse\x12W\n" +
+	"\x0eEditCollection\x12!.collection.EditCollectionRequest\x1a\".collection.EditCollectionResponse\x12]\n" +
+	"\x10DeleteCollection\x12#.collection.DeleteCollectionRequest\x1a$.collection.DeleteCollectionResponse\x12W\n" +
+	"\x0eListCollection\x12!.collection.ListCollectionRequest\x1a\".collection.ListCollectionResponse\x12l\n" +
+	"\x15SetCollectionProducts\x12(.collection.SetCollectionProductsRequest\x1a).collection.SetCollectionProductsResponseB6Z4github.com/xryar/golang-grpc-ecommerce/pb/collectionb\x06proto3"
+
+var (
+	file_collection_collection_proto_rawDescOnce sync.Once
+	file_collection_collection_proto_rawDescData []byte
+)
+
+func file_collection_collection_proto_rawDescGZIP() []byte {
+	file_collection_collection_proto_rawDescOnce.Do(func() {
+		file_collection_collection_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_collection_collection_proto_rawDesc), len(file_collection_collection_proto_rawDesc)))
+	})
+	return file_collection_collection_proto_rawDescData
+}
+
+var file_collection_collection_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_collection_collection_proto_goTypes = []any{
+	(*CreateCollectionRequest)(nil),       // 0: collection.CreateCollectionRequest
+	(*CreateCollectionResponse)(nil),      // 1: collection.CreateCollectionResponse
+	(*EditCollectionRequest)(nil),         // 2: collection.EditCollectionRequest
+	(*EditCollectionResponse)(nil),        // 3: collection.EditCollectionResponse
+	(*DeleteCollectionRequest)(nil),       // 4: collection.DeleteCollectionRequest
+	(*DeleteCollectionResponse)(nil),      // 5: collection.DeleteCollectionResponse
+	(*ListCollectionRequest)(nil),         // 6: collection.ListCollectionRequest
+	(*ListCollectionResponseItem)(nil),    // 7: collection.ListCollectionResponseItem
+	(*ListCollectionResponse)(nil),        // 8: collection.ListCollectionResponse
+	(*SetCollectionProductsRequest)(nil),  // 9: collection.SetCollectionProductsRequest
+	(*SetCollectionProductsResponse)(nil), // 10: collection.SetCollectionProductsResponse
+	(*timestamppb.Timestamp)(nil),         // 11: google.protobuf.Timestamp
+	(*common.BaseResponse)(nil),           // 12: common.BaseResponse
+}
+var file_collection_collection_proto_depIdxs = []int32{
+	11, // 0: collection.CreateCollectionRequest.starts_at:type_name -> google.protobuf.Timestamp
+	11, // 1: collection.CreateCollectionRequest.ends_at:type_name -> google.protobuf.Timestamp
+	12, // 2: collection.CreateCollectionResponse.base:type_name -> common.BaseResponse
+	11, // 3: collection.EditCollectionRequest.starts_at:type_name -> google.protobuf.Timestamp
+	11, // 4: collection.EditCollectionRequest.ends_at:type_name -> google.protobuf.Timestamp
+	12, // 5: collection.EditCollectionResponse.base:type_name -> common.BaseResponse
+	12, // 6: collection.DeleteCollectionResponse.base:type_name -> common.BaseResponse
+	11, // 7: collection.ListCollectionResponseItem.starts_at:type_name -> google.protobuf.Timestamp
+	11, // 8: collection.ListCollectionResponseItem.ends_at:type_name -> google.protobuf.Timestamp
+	12, // 9: collection.ListCollectionResponse.base:type_name -> common.BaseResponse
+	7,  // 10: collection.ListCollectionResponse.data:type_name -> collection.ListCollectionResponseItem
+	12, // 11: collection.SetCollectionProductsResponse.base:type_name -> common.BaseResponse
+	0,  // 12: collection.CollectionService.CreateCollection:input_type -> collection.CreateCollectionRequest
+	2,  // 13: collection.CollectionService.EditCollection:input_type -> collection.EditCollectionRequest
+	4,  // 14: collection.CollectionService.DeleteCollection:input_type -> collection.DeleteCollectionRequest
+	6,  // 15: collection.CollectionService.ListCollection:input_type -> collection.ListCollectionRequest
+	9,  // 16: collection.CollectionService.SetCollectionProducts:input_type -> collection.SetCollectionProductsRequest
+	1,  // 17: collection.CollectionService.CreateCollection:output_type -> collection.CreateCollectionResponse
+	3,  // 18: collection.CollectionService.EditCollection:output_type -> collection.EditCollectionResponse
+	5,  // 19: collection.CollectionService.DeleteCollection:output_type -> collection.DeleteCollectionResponse
+	8,  // 20: collection.CollectionService.ListCollection:output_type -> collection.ListCollectionResponse
+	10, // 21: collection.CollectionService.SetCollectionProducts:output_type -> collection.SetCollectionProductsResponse
+	17, // [17:22] is the sub-list for method output_type
+	12, // [12:17] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_collection_collection_proto_init() }
+func file_collection_collection_proto_init() {
+	if File_collection_collection_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_collection_collection_proto_rawDesc), len(file_collection_collection_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_collection_collection_proto_goTypes,
+		DependencyIndexes: file_collection_collection_proto_depIdxs,
+		MessageInfos:      file_collection_collection_proto_msgTypes,
+	}.Build()
+	File_collection_collection_proto = out.File
+	file_collection_collection_proto_goTypes = nil
+	file_collection_collection_proto_depIdxs = nil
+}
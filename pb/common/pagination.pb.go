@@ -73,18 +73,90 @@ func (x *PaginationSortRequest) GetDirection() string {
 	return ""
 }
 
+type PaginationFilterRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Field string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// one of eq (the default), ne, gt, gte, lt, lte and, for text fields,
+	// contains
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// times are given in RFC 3339
+	Value         string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaginationFilterRequest) Reset() {
+	*x = PaginationFilterRequest{}
+	mi := &file_common_pagination_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaginationFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaginationFilterRequest) ProtoMessage() {}
+
+func (x *PaginationFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_common_pagination_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaginationFilterRequest.ProtoReflect.Descriptor instead.
+func (*PaginationFilterRequest) Descriptor() ([]byte, []int) {
+	return file_common_pagination_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PaginationFilterRequest) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *PaginationFilterRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *PaginationFilterRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
 type PaginationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CurrentPage   int32                  `protobuf:"varint,1,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
-	ItemPerPage   int32                  `protobuf:"varint,2,opt,name=item_per_page,json=itemPerPage,proto3" json:"item_per_page,omitempty"`
-	Sort          *PaginationSortRequest `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CurrentPage int32                  `protobuf:"varint,1,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	ItemPerPage int32                  `protobuf:"varint,2,opt,name=item_per_page,json=itemPerPage,proto3" json:"item_per_page,omitempty"`
+	Sort        *PaginationSortRequest `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
+	// pages by cursor instead of page number where the listing supports it,
+	// current_page is ignored and no totals are counted. also implied by cursor
+	UseCursor bool `protobuf:"varint,4,opt,name=use_cursor,json=useCursor,proto3" json:"use_cursor,omitempty"`
+	// next_cursor or previous_cursor of an earlier response, left empty for
+	// the first page
+	Cursor string `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// every listing accepts its own fields, unknown ones are reported as
+	// validation errors
+	Filters       []*PaginationFilterRequest `protobuf:"bytes,6,rep,name=filters,proto3" json:"filters,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PaginationRequest) Reset() {
 	*x = PaginationRequest{}
-	mi := &file_common_pagination_proto_msgTypes[1]
+	mi := &file_common_pagination_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -96,7 +168,7 @@ func (x *PaginationRequest) String() string {
 func (*PaginationRequest) ProtoMessage() {}
 
 func (x *PaginationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_common_pagination_proto_msgTypes[1]
+	mi := &file_common_pagination_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -109,7 +181,7 @@ func (x *PaginationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaginationRequest.ProtoReflect.Descriptor instead.
 func (*PaginationRequest) Descriptor() ([]byte, []int) {
-	return file_common_pagination_proto_rawDescGZIP(), []int{1}
+	return file_common_pagination_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *PaginationRequest) GetCurrentPage() int32 {
@@ -133,19 +205,43 @@ func (x *PaginationRequest) GetSort() *PaginationSortRequest {
 	return nil
 }
 
+func (x *PaginationRequest) GetUseCursor() bool {
+	if x != nil {
+		return x.UseCursor
+	}
+	return false
+}
+
+func (x *PaginationRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *PaginationRequest) GetFilters() []*PaginationFilterRequest {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
 type PaginationResponse struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	CurrentPage    int32                  `protobuf:"varint,1,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
 	TotalPageCount int32                  `protobuf:"varint,2,opt,name=total_page_count,json=totalPageCount,proto3" json:"total_page_count,omitempty"`
 	ItemPerPage    int32                  `protobuf:"varint,3,opt,name=item_per_page,json=itemPerPage,proto3" json:"item_per_page,omitempty"`
 	TotalItemCount int32                  `protobuf:"varint,4,opt,name=total_item_count,json=totalItemCount,proto3" json:"total_item_count,omitempty"`
+	// only set in cursor mode, empty when there is no such page
+	NextCursor     string `protobuf:"bytes,5,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	PreviousCursor string `protobuf:"bytes,6,opt,name=previous_cursor,json=previousCursor,proto3" json:"previous_cursor,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *PaginationResponse) Reset() {
 	*x = PaginationResponse{}
-	mi := &file_common_pagination_proto_msgTypes[2]
+	mi := &file_common_pagination_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -157,7 +253,7 @@ func (x *PaginationResponse) String() string {
 func (*PaginationResponse) ProtoMessage() {}
 
 func (x *PaginationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_common_pagination_proto_msgTypes[2]
+	mi := &file_common_pagination_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -170,7 +266,7 @@ func (x *PaginationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaginationResponse.ProtoReflect.Descriptor instead.
 func (*PaginationResponse) Descriptor() ([]byte, []int) {
-	return file_common_pagination_proto_rawDescGZIP(), []int{2}
+	return file_common_pagination_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *PaginationResponse) GetCurrentPage() int32 {
@@ -201,6 +297,20 @@ func (x *PaginationResponse) GetTotalItemCount() int32 {
 	return 0
 }
 
+func (x *PaginationResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *PaginationResponse) GetPreviousCursor() string {
+	if x != nil {
+		return x.PreviousCursor
+	}
+	return ""
+}
+
 var File_common_pagination_proto protoreflect.FileDescriptor
 
 const file_common_pagination_proto_rawDesc = "" +
@@ -208,16 +318,27 @@ const file_common_pagination_proto_rawDesc = "" +
 	"\x17common/pagination.proto\x12\x06common\"K\n" +
 	"\x15PaginationSortRequest\x12\x14\n" +
 	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1c\n" +
-	"\tdirection\x18\x02 \x01(\tR\tdirection\"\x8d\x01\n" +
+	"\tdirection\x18\x02 \x01(\tR\tdirection\"a\n" +
+	"\x17PaginationFilterRequest\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1a\n" +
+	"\boperator\x18\x02 \x01(\tR\boperator\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\"\xff\x01\n" +
 	"\x11PaginationRequest\x12!\n" +
 	"\fcurrent_page\x18\x01 \x01(\x05R\vcurrentPage\x12\"\n" +
 	"\ritem_per_page\x18\x02 \x01(\x05R\vitemPerPage\x121\n" +
-	"\x04sort\x18\x03 \x01(\v2\x1d.common.PaginationSortRequestR\x04sort\"\xaf\x01\n" +
+	"\x04sort\x18\x03 \x01(\v2\x1d.common.PaginationSortRequestR\x04sort\x12\x1d\n" +
+	"\n" +
+	"use_cursor\x18\x04 \x01(\bR\tuseCursor\x12\x16\n" +
+	"\x06cursor\x18\x05 \x01(\tR\x06cursor\x129\n" +
+	"\afilters\x18\x06 \x03(\v2\x1f.common.PaginationFilterRequestR\afilters\"\xf9\x01\n" +
 	"\x12PaginationResponse\x12!\n" +
 	"\fcurrent_page\x18\x01 \x01(\x05R\vcurrentPage\x12(\n" +
 	"\x10total_page_count\x18\x02 \x01(\x05R\x0etotalPageCount\x12\"\n" +
 	"\ritem_per_page\x18\x03 \x01(\x05R\vitemPerPage\x12(\n" +
-	"\x10total_item_count\x18\x04 \x01(\x05R\x0etotalItemCountB2Z0github.com/xryar/golang-grpc-ecommerce/pb/commonb\x06proto3"
+	"\x10total_item_count\x18\x04 \x01(\x05R\x0etotalItemCount\x12\x1f\n" +
+	"\vnext_cursor\x18\x05 \x01(\tR\n" +
+	"nextCursor\x12'\n" +
+	"\x0fprevious_cursor\x18\x06 \x01(\tR\x0epreviousCursorB2Z0github.com/xryar/golang-grpc-ecommerce/pb/commonb\x06proto3"
 
 var (
 	file_common_pagination_proto_rawDescOnce sync.Once
@@ -231,19 +352,21 @@ func file_common_pagination_proto_rawDescGZIP() []byte {
 	return file_common_pagination_proto_rawDescData
 }
 
-var file_common_pagination_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_common_pagination_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_common_pagination_proto_goTypes = []any{
-	(*PaginationSortRequest)(nil), // 0: common.PaginationSortRequest
-	(*PaginationRequest)(nil),     // 1: common.PaginationRequest
-	(*PaginationResponse)(nil),    // 2: common.PaginationResponse
+	(*PaginationSortRequest)(nil),   // 0: common.PaginationSortRequest
+	(*PaginationFilterRequest)(nil), // 1: common.PaginationFilterRequest
+	(*PaginationRequest)(nil),       // 2: common.PaginationRequest
+	(*PaginationResponse)(nil),      // 3: common.PaginationResponse
 }
 var file_common_pagination_proto_depIdxs = []int32{
 	0, // 0: common.PaginationRequest.sort:type_name -> common.PaginationSortRequest
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1, // 1: common.PaginationRequest.filters:type_name -> common.PaginationFilterRequest
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_common_pagination_proto_init() }
@@ -257,7 +380,7 @@ func file_common_pagination_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_common_pagination_proto_rawDesc), len(file_common_pagination_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
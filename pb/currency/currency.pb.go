@@ -0,0 +1,526 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: currency/currency.proto
+
+package currency
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	common "github.com/xryar/golang-grpc-ecommerce/pb/common"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateExchangeRateRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	CurrencyCode string                 `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	// how many IDR one unit of currency_code is worth
+	Rate float64 `protobuf:"fixed64,2,opt,name=rate,proto3" json:"rate,omitempty"`
+	// left empty the rate takes effect right away
+	EffectiveAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateExchangeRateRequest) Reset() {
+	*x = CreateExchangeRateRequest{}
+	mi := &file_currency_currency_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateExchangeRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExchangeRateRequest) ProtoMessage() {}
+
+func (x *CreateExchangeRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_currency_currency_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExchangeRateRequest.ProtoReflect.Descriptor instead.
+func (*CreateExchangeRateRequest) Descriptor() ([]byte, []int) {
+	return file_currency_currency_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateExchangeRateRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *CreateExchangeRateRequest) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *CreateExchangeRateRequest) GetEffectiveAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveAt
+	}
+	return nil
+}
+
+type CreateExchangeRateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateExchangeRateResponse) Reset() {
+	*x = CreateExchangeRateResponse{}
+	mi := &file_currency_currency_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateExchangeRateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExchangeRateResponse) ProtoMessage() {}
+
+func (x *CreateExchangeRateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_currency_currency_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExchangeRateResponse.ProtoReflect.Descriptor instead.
+func (*CreateExchangeRateResponse) Descriptor() ([]byte, []int) {
+	return file_currency_currency_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateExchangeRateResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CreateExchangeRateResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListExchangeRateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// left empty the rates of every currency are listed
+	CurrencyCode  string `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExchangeRateRequest) Reset() {
+	*x = ListExchangeRateRequest{}
+	mi := &file_currency_currency_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExchangeRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExchangeRateRequest) ProtoMessage() {}
+
+func (x *ListExchangeRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_currency_currency_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExchangeRateRequest.ProtoReflect.Descriptor instead.
+func (*ListExchangeRateRequest) Descriptor() ([]byte, []int) {
+	return file_currency_currency_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListExchangeRateRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+type ListExchangeRateResponseItem struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CurrencyCode string                 `protobuf:"bytes,2,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	Rate         float64                `protobuf:"fixed64,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	EffectiveAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"`
+	// whether this is the rate prices are converted with right now
+	IsCurrent     bool                   `protobuf:"varint,5,opt,name=is_current,json=isCurrent,proto3" json:"is_current,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExchangeRateResponseItem) Reset() {
+	*x = ListExchangeRateResponseItem{}
+	mi := &file_currency_currency_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExchangeRateResponseItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExchangeRateResponseItem) ProtoMessage() {}
+
+func (x *ListExchangeRateResponseItem) ProtoReflect() protoreflect.Message {
+	mi := &file_currency_currency_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExchangeRateResponseItem.ProtoReflect.Descriptor instead.
+func (*ListExchangeRateResponseItem) Descriptor() ([]byte, []int) {
+	return file_currency_currency_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListExchangeRateResponseItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListExchangeRateResponseItem) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *ListExchangeRateResponseItem) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *ListExchangeRateResponseItem) GetEffectiveAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveAt
+	}
+	return nil
+}
+
+func (x *ListExchangeRateResponseItem) GetIsCurrent() bool {
+	if x != nil {
+		return x.IsCurrent
+	}
+	return false
+}
+
+func (x *ListExchangeRateResponseItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ListExchangeRateResponseItem) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type ListExchangeRateResponse struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Base          *common.BaseResponse            `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          []*ListExchangeRateResponseItem `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExchangeRateResponse) Reset() {
+	*x = ListExchangeRateResponse{}
+	mi := &file_currency_currency_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExchangeRateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExchangeRateResponse) ProtoMessage() {}
+
+func (x *ListExchangeRateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_currency_currency_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExchangeRateResponse.ProtoReflect.Descriptor instead.
+func (*ListExchangeRateResponse) Descriptor() ([]byte, []int) {
+	return file_currency_currency_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListExchangeRateResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListExchangeRateResponse) GetData() []*ListExchangeRateResponseItem {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DeleteExchangeRateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExchangeRateRequest) Reset() {
+	*x = DeleteExchangeRateRequest{}
+	mi := &file_currency_currency_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExchangeRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExchangeRateRequest) ProtoMessage() {}
+
+func (x *DeleteExchangeRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_currency_currency_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExchangeRateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteExchangeRateRequest) Descriptor() ([]byte, []int) {
+	return file_currency_currency_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteExchangeRateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteExchangeRateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteExchangeRateResponse) Reset() {
+	*x = DeleteExchangeRateResponse{}
+	mi := &file_currency_currency_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteExchangeRateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteExchangeRateResponse) ProtoMessage() {}
+
+func (x *DeleteExchangeRateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_currency_currency_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteExchangeRateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteExchangeRateResponse) Descriptor() ([]byte, []int) {
+	return file_currency_currency_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteExchangeRateResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+var File_currency_currency_proto protoreflect.FileDescriptor
+
+const file_currency_currency_proto_rawDesc = "" +
+	"\n" +
+	"\x17currency/currency.proto\x12\bcurrency\x1a\x1acommon/base_response.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb4\x01\n" +
+	"\x19CreateExchangeRateRequest\x124\n" +
+	"\rcurrency_code\x18\x01 \x01(\tB\x0f\xbaH\fr\n" +
+	"R\x03MYRR\x03SGDR\fcurrencyCode\x12\"\n" +
+	"\x04rate\x18\x02 \x01(\x01B\x0e\xbaH\v\x12\t!\x00\x00\x00\x00\x00\x00\x00\x00R\x04rate\x12=\n" +
+	"\feffective_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\veffectiveAt\"V\n" +
+	"\x1aCreateExchangeRateResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"R\n" +
+	"\x17ListExchangeRateRequest\x127\n" +
+	"\rcurrency_code\x18\x01 \x01(\tB\x12\xbaH\x0f\xd8\x01\x02r\n" +
+	"R\x03MYRR\x03SGDR\fcurrencyCode\"\x9f\x02\n" +
+	"\x1cListExchangeRateResponseItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rcurrency_code\x18\x02 \x01(\tR\fcurrencyCode\x12\x12\n" +
+	"\x04rate\x18\x03 \x01(\x01R\x04rate\x12=\n" +
+	"\feffective_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\veffectiveAt\x12\x1d\n" +
+	"\n" +
+	"is_current\x18\x05 \x01(\bR\tisCurrent\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\a \x01(\tR\tcreatedBy\"\x80\x01\n" +
+	"\x18ListExchangeRateResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12:\n" +
+	"\x04data\x18\x02 \x03(\v2&.currency.ListExchangeRateResponseItemR\x04data\"7\n" +
+	"\x19DeleteExchangeRateRequest\x12\x1a\n" +
+	"\x02id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\"F\n" +
+	"\x1aDeleteExchangeRateResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base2\xae\x02\n" +
+	"\x0fCurrencyService\x12_\n" +
+	"\x12CreateExchangeRate\x12#.currency.CreateExchangeRateRequest\x1a$.currency.CreateExchangeRateResponse\x12Y\n" +
+	"\x10ListExchangeRate\x12!.currency.ListExchangeRateRequest\x1a\".currency.ListExchangeRateResponse\x12_\n" +
+	"\x12DeleteExchangeRate\x12#.currency.DeleteExchangeRateRequest\x1a$.currency.DeleteExchangeRateResponseB4Z2github.com/xryar/golang-grpc-ecommerce/pb/currencyb\x06proto3"
+
+var (
+	file_currency_currency_proto_rawDescOnce sync.Once
+	file_currency_currency_proto_rawDescData []byte
+)
+
+func file_currency_currency_proto_rawDescGZIP() []byte {
+	file_currency_currency_proto_rawDescOnce.Do(func() {
+		file_currency_currency_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_currency_currency_proto_rawDesc), len(file_currency_currency_proto_rawDesc)))
+	})
+	return file_currency_currency_proto_rawDescData
+}
+
+var file_currency_currency_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_currency_currency_proto_goTypes = []any{
+	(*CreateExchangeRateRequest)(nil),    // 0: currency.CreateExchangeRateRequest
+	(*CreateExchangeRateResponse)(nil),   // 1: currency.CreateExchangeRateResponse
+	(*ListExchangeRateRequest)(nil),      // 2: currency.ListExchangeRateRequest
+	(*ListExchangeRateResponseItem)(nil), // 3: currency.ListExchangeRateResponseItem
+	(*ListExchangeRateResponse)(nil),     // 4: currency.ListExchangeRateResponse
+	(*DeleteExchangeRateRequest)(nil),    // 5: currency.DeleteExchangeRateRequest
+	(*DeleteExchangeRateResponse)(nil),   // 6: currency.DeleteExchangeRateResponse
+	(*timestamppb.Timestamp)(nil),        // 7: google.protobuf.Timestamp
+	(*common.BaseResponse)(nil),          // 8: common.BaseResponse
+}
+var file_currency_currency_proto_depIdxs = []int32{
+	7,  // 0: currency.CreateExchangeRateRequest.effective_at:type_name -> google.protobuf.Timestamp
+	8,  // 1: currency.CreateExchangeRateResponse.base:type_name -> common.BaseResponse
+	7,  // 2: currency.ListExchangeRateResponseItem.effective_at:type_name -> google.protobuf.Timestamp
+	7,  // 3: currency.ListExchangeRateResponseItem.created_at:type_name -> google.protobuf.Timestamp
+	8,  // 4: currency.ListExchangeRateResponse.base:type_name -> common.BaseResponse
+	3,  // 5: currency.ListExchangeRateResponse.data:type_name -> currency.ListExchangeRateResponseItem
+	8,  // 6: currency.DeleteExchangeRateResponse.base:type_name -> common.BaseResponse
+	0,  // 7: currency.CurrencyService.CreateExchangeRate:input_type -> currency.CreateExchangeRateRequest
+	2,  // 8: currency.CurrencyService.ListExchangeRate:input_type -> currency.ListExchangeRateRequest
+	5,  // 9: currency.CurrencyService.DeleteExchangeRate:input_type -> currency.DeleteExchangeRateRequest
+	1,  // 10: currency.CurrencyService.CreateExchangeRate:output_type -> currency.CreateExchangeRateResponse
+	4,  // 11: currency.CurrencyService.ListExchangeRate:output_type -> currency.ListExchangeRateResponse
+	6,  // 12: currency.CurrencyService.DeleteExchangeRate:output_type -> currency.DeleteExchangeRateResponse
+	10, // [10:13] is the sub-list for method output_type
+	7,  // [7:10] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_currency_currency_proto_init() }
+func file_currency_currency_proto_init() {
+	if File_currency_currency_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_currency_currency_proto_rawDesc), len(file_currency_currency_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_currency_currency_proto_goTypes,
+		DependencyIndexes: file_currency_currency_proto_depIdxs,
+		MessageInfos:      file_currency_currency_proto_msgTypes,
+	}.Build()
+	File_currency_currency_proto = out.File
+	file_currency_currency_proto_goTypes = nil
+	file_currency_currency_proto_depIdxs = nil
+}
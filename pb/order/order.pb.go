@@ -28,6 +28,7 @@ type CreateOrderRequestProductItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	VariantId     string                 `protobuf:"bytes,3,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -76,13 +77,23 @@ func (x *CreateOrderRequestProductItem) GetQuantity() int64 {
 	return 0
 }
 
+func (x *CreateOrderRequestProductItem) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
 type CreateOrderRequest struct {
-	state         protoimpl.MessageState           `protogen:"open.v1"`
-	FullName      string                           `protobuf:"bytes,1,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
-	Address       string                           `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	PhoneNumber   string                           `protobuf:"bytes,3,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
-	Notes         string                           `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
-	Products      []*CreateOrderRequestProductItem `protobuf:"bytes,5,rep,name=products,proto3" json:"products,omitempty"`
+	state       protoimpl.MessageState           `protogen:"open.v1"`
+	FullName    string                           `protobuf:"bytes,1,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Address     string                           `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	PhoneNumber string                           `protobuf:"bytes,3,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	Notes       string                           `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	Products    []*CreateOrderRequestProductItem `protobuf:"bytes,5,rep,name=products,proto3" json:"products,omitempty"`
+	// the currency the customer saw the prices in, left empty the x-currency
+	// metadata or else IDR is used. the order is always paid in IDR
+	Currency      string `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -152,6 +163,13 @@ func (x *CreateOrderRequest) GetProducts() []*CreateOrderRequestProductItem {
 	return nil
 }
 
+func (x *CreateOrderRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 type CreateOrderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -250,13 +268,17 @@ func (x *ListOrderAdminRequest) GetPagination() *common.PaginationRequest {
 }
 
 type ListOrderAdminResponseItemProducts struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
-	Quantity      int64                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Price             float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity          int64                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	VariantId         string                 `protobuf:"bytes,5,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	VariantSku        string                 `protobuf:"bytes,6,opt,name=variant_sku,json=variantSku,proto3" json:"variant_sku,omitempty"`
+	VariantAttributes map[string]string      `protobuf:"bytes,7,rep,name=variant_attributes,json=variantAttributes,proto3" json:"variant_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	OriginalPrice     float64                `protobuf:"fixed64,8,opt,name=original_price,json=originalPrice,proto3" json:"original_price,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *ListOrderAdminResponseItemProducts) Reset() {
@@ -317,6 +339,34 @@ func (x *ListOrderAdminResponseItemProducts) GetQuantity() int64 {
 	return 0
 }
 
+func (x *ListOrderAdminResponseItemProducts) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *ListOrderAdminResponseItemProducts) GetVariantSku() string {
+	if x != nil {
+		return x.VariantSku
+	}
+	return ""
+}
+
+func (x *ListOrderAdminResponseItemProducts) GetVariantAttributes() map[string]string {
+	if x != nil {
+		return x.VariantAttributes
+	}
+	return nil
+}
+
+func (x *ListOrderAdminResponseItemProducts) GetOriginalPrice() float64 {
+	if x != nil {
+		return x.OriginalPrice
+	}
+	return 0
+}
+
 type ListOrderAdminResponseItem struct {
 	state         protoimpl.MessageState                `protogen:"open.v1"`
 	Id            string                                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -515,13 +565,17 @@ func (x *ListOrderRequest) GetPagination() *common.PaginationRequest {
 }
 
 type ListOrderResponseItemProducts struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
-	Quantity      int64                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Price             float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity          int64                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	VariantId         string                 `protobuf:"bytes,5,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	VariantSku        string                 `protobuf:"bytes,6,opt,name=variant_sku,json=variantSku,proto3" json:"variant_sku,omitempty"`
+	VariantAttributes map[string]string      `protobuf:"bytes,7,rep,name=variant_attributes,json=variantAttributes,proto3" json:"variant_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	OriginalPrice     float64                `protobuf:"fixed64,8,opt,name=original_price,json=originalPrice,proto3" json:"original_price,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *ListOrderResponseItemProducts) Reset() {
@@ -582,6 +636,34 @@ func (x *ListOrderResponseItemProducts) GetQuantity() int64 {
 	return 0
 }
 
+func (x *ListOrderResponseItemProducts) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *ListOrderResponseItemProducts) GetVariantSku() string {
+	if x != nil {
+		return x.VariantSku
+	}
+	return ""
+}
+
+func (x *ListOrderResponseItemProducts) GetVariantAttributes() map[string]string {
+	if x != nil {
+		return x.VariantAttributes
+	}
+	return nil
+}
+
+func (x *ListOrderResponseItemProducts) GetOriginalPrice() float64 {
+	if x != nil {
+		return x.OriginalPrice
+	}
+	return 0
+}
+
 type ListOrderResponseItem struct {
 	state            protoimpl.MessageState           `protogen:"open.v1"`
 	Id               string                           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -787,11 +869,22 @@ func (x *DetailOrderRequest) GetId() string {
 }
 
 type DetailOrderResponseItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
-	Quantity      int64                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Price             float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity          int64                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	VariantId         string                 `protobuf:"bytes,5,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	VariantSku        string                 `protobuf:"bytes,6,opt,name=variant_sku,json=variantSku,proto3" json:"variant_sku,omitempty"`
+	VariantAttributes map[string]string      `protobuf:"bytes,7,rep,name=variant_attributes,json=variantAttributes,proto3" json:"variant_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	OriginalPrice     float64                `protobuf:"fixed64,8,opt,name=original_price,json=originalPrice,proto3" json:"original_price,omitempty"`
+	// set on the components of a bundle, price is the component's share of
+	// the bundle price
+	BundleId   string `protobuf:"bytes,9,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
+	BundleName string `protobuf:"bytes,10,opt,name=bundle_name,json=bundleName,proto3" json:"bundle_name,omitempty"`
+	// digital items are downloaded through ListOrderDownload once the order
+	// is paid
+	IsDigital     bool `protobuf:"varint,11,opt,name=is_digital,json=isDigital,proto3" json:"is_digital,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -854,6 +947,55 @@ func (x *DetailOrderResponseItem) GetQuantity() int64 {
 	return 0
 }
 
+func (x *DetailOrderResponseItem) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *DetailOrderResponseItem) GetVariantSku() string {
+	if x != nil {
+		return x.VariantSku
+	}
+	return ""
+}
+
+func (x *DetailOrderResponseItem) GetVariantAttributes() map[string]string {
+	if x != nil {
+		return x.VariantAttributes
+	}
+	return nil
+}
+
+func (x *DetailOrderResponseItem) GetOriginalPrice() float64 {
+	if x != nil {
+		return x.OriginalPrice
+	}
+	return 0
+}
+
+func (x *DetailOrderResponseItem) GetBundleId() string {
+	if x != nil {
+		return x.BundleId
+	}
+	return ""
+}
+
+func (x *DetailOrderResponseItem) GetBundleName() string {
+	if x != nil {
+		return x.BundleName
+	}
+	return ""
+}
+
+func (x *DetailOrderResponseItem) GetIsDigital() bool {
+	if x != nil {
+		return x.IsDigital
+	}
+	return false
+}
+
 type DetailOrderResponse struct {
 	state            protoimpl.MessageState     `protogen:"open.v1"`
 	Base             *common.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
@@ -869,8 +1011,14 @@ type DetailOrderResponse struct {
 	Items            []*DetailOrderResponseItem `protobuf:"bytes,11,rep,name=items,proto3" json:"items,omitempty"`
 	Total            float64                    `protobuf:"fixed64,12,opt,name=total,proto3" json:"total,omitempty"`
 	ExpiredAt        *timestamppb.Timestamp     `protobuf:"bytes,13,opt,name=expired_at,json=expiredAt,proto3" json:"expired_at,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// prices and total are in currency, the one the order is paid in.
+	// exchange_rate is how many of it one unit of display_currency was worth
+	// when the order was created
+	Currency        string  `protobuf:"bytes,14,opt,name=currency,proto3" json:"currency,omitempty"`
+	DisplayCurrency string  `protobuf:"bytes,15,opt,name=display_currency,json=displayCurrency,proto3" json:"display_currency,omitempty"`
+	ExchangeRate    float64 `protobuf:"fixed64,16,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *DetailOrderResponse) Reset() {
@@ -994,6 +1142,27 @@ func (x *DetailOrderResponse) GetExpiredAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *DetailOrderResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *DetailOrderResponse) GetDisplayCurrency() string {
+	if x != nil {
+		return x.DisplayCurrency
+	}
+	return ""
+}
+
+func (x *DetailOrderResponse) GetExchangeRate() float64 {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return 0
+}
+
 type UpdateOrderStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
@@ -1090,14 +1259,207 @@ func (x *UpdateOrderStatusResponse) GetBase() *common.BaseResponse {
 	return nil
 }
 
+type ListOrderDownloadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrderDownloadRequest) Reset() {
+	*x = ListOrderDownloadRequest{}
+	mi := &file_order_order_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrderDownloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrderDownloadRequest) ProtoMessage() {}
+
+func (x *ListOrderDownloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrderDownloadRequest.ProtoReflect.Descriptor instead.
+func (*ListOrderDownloadRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListOrderDownloadRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type ListOrderDownloadResponseItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName   string                 `protobuf:"bytes,3,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	MaxDownloads  int64                  `protobuf:"varint,4,opt,name=max_downloads,json=maxDownloads,proto3" json:"max_downloads,omitempty"`
+	DownloadCount int64                  `protobuf:"varint,5,opt,name=download_count,json=downloadCount,proto3" json:"download_count,omitempty"`
+	// a fresh link is made on every call, it is empty once no downloads are
+	// left. each download through it uses one of max_downloads
+	DownloadUrl          string                 `protobuf:"bytes,6,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
+	DownloadUrlExpiredAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=download_url_expired_at,json=downloadUrlExpiredAt,proto3" json:"download_url_expired_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ListOrderDownloadResponseItem) Reset() {
+	*x = ListOrderDownloadResponseItem{}
+	mi := &file_order_order_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrderDownloadResponseItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrderDownloadResponseItem) ProtoMessage() {}
+
+func (x *ListOrderDownloadResponseItem) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrderDownloadResponseItem.ProtoReflect.Descriptor instead.
+func (*ListOrderDownloadResponseItem) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListOrderDownloadResponseItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListOrderDownloadResponseItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ListOrderDownloadResponseItem) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *ListOrderDownloadResponseItem) GetMaxDownloads() int64 {
+	if x != nil {
+		return x.MaxDownloads
+	}
+	return 0
+}
+
+func (x *ListOrderDownloadResponseItem) GetDownloadCount() int64 {
+	if x != nil {
+		return x.DownloadCount
+	}
+	return 0
+}
+
+func (x *ListOrderDownloadResponseItem) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+func (x *ListOrderDownloadResponseItem) GetDownloadUrlExpiredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DownloadUrlExpiredAt
+	}
+	return nil
+}
+
+type ListOrderDownloadResponse struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Base          *common.BaseResponse             `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Items         []*ListOrderDownloadResponseItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrderDownloadResponse) Reset() {
+	*x = ListOrderDownloadResponse{}
+	mi := &file_order_order_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrderDownloadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrderDownloadResponse) ProtoMessage() {}
+
+func (x *ListOrderDownloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrderDownloadResponse.ProtoReflect.Descriptor instead.
+func (*ListOrderDownloadResponse) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListOrderDownloadResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListOrderDownloadResponse) GetItems() []*ListOrderDownloadResponseItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
 var File_order_order_proto protoreflect.FileDescriptor
 
 const file_order_order_proto_rawDesc = "" +
 	"\n" +
-	"\x11order/order.proto\x12\x05order\x1a\x1acommon/base_response.proto\x1a\x17common/pagination.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"K\n" +
-	"\x1dCreateOrderRequestProductItem\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
-	"\bquantity\x18\x02 \x01(\x03R\bquantity\"\xf4\x01\n" +
+	"\x11order/order.proto\x12\x05order\x1a\x1acommon/base_response.proto\x1a\x17common/pagination.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x89\x01\n" +
+	"\x1dCreateOrderRequestProductItem\x12\x1a\n" +
+	"\x02id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\x12#\n" +
+	"\bquantity\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\bquantity\x12'\n" +
+	"\n" +
+	"variant_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\tvariantId\"\xa9\x02\n" +
 	"\x12CreateOrderRequest\x12'\n" +
 	"\tfull_name\x18\x01 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\bfullName\x12$\n" +
@@ -1106,19 +1468,29 @@ const file_order_order_proto_rawDesc = "" +
 	"\fphone_number\x18\x03 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\vphoneNumber\x12\x1e\n" +
 	"\x05notes\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\x05notes\x12@\n" +
-	"\bproducts\x18\x05 \x03(\v2$.order.CreateOrderRequestProductItemR\bproducts\"O\n" +
+	"\bproducts\x18\x05 \x03(\v2$.order.CreateOrderRequestProductItemR\bproducts\x123\n" +
+	"\bcurrency\x18\x06 \x01(\tB\x17\xbaH\x14\xd8\x01\x02r\x0fR\x03IDRR\x03MYRR\x03SGDR\bcurrency\"O\n" +
 	"\x13CreateOrderResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\tR\x02id\"R\n" +
 	"\x15ListOrderAdminRequest\x129\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2\x19.common.PaginationRequestR\n" +
-	"pagination\"z\n" +
+	"pagination\"\x98\x03\n" +
 	"\"ListOrderAdminResponseItemProducts\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
 	"\x05price\x18\x03 \x01(\x01R\x05price\x12\x1a\n" +
-	"\bquantity\x18\x04 \x01(\x03R\bquantity\"\x99\x02\n" +
+	"\bquantity\x18\x04 \x01(\x03R\bquantity\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x05 \x01(\tR\tvariantId\x12\x1f\n" +
+	"\vvariant_sku\x18\x06 \x01(\tR\n" +
+	"variantSku\x12o\n" +
+	"\x12variant_attributes\x18\a \x03(\v2@.order.ListOrderAdminResponseItemProducts.VariantAttributesEntryR\x11variantAttributes\x12%\n" +
+	"\x0eoriginal_price\x18\b \x01(\x01R\roriginalPrice\x1aD\n" +
+	"\x16VariantAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x99\x02\n" +
 	"\x1aListOrderAdminResponseItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
 	"\x06number\x18\x02 \x01(\tR\x06number\x12\x1a\n" +
@@ -1138,12 +1510,21 @@ const file_order_order_proto_rawDesc = "" +
 	"\x10ListOrderRequest\x129\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2\x19.common.PaginationRequestR\n" +
-	"pagination\"u\n" +
+	"pagination\"\x8e\x03\n" +
 	"\x1dListOrderResponseItemProducts\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
 	"\x05price\x18\x03 \x01(\x01R\x05price\x12\x1a\n" +
-	"\bquantity\x18\x04 \x01(\x03R\bquantity\"\xbd\x02\n" +
+	"\bquantity\x18\x04 \x01(\x03R\bquantity\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x05 \x01(\tR\tvariantId\x12\x1f\n" +
+	"\vvariant_sku\x18\x06 \x01(\tR\n" +
+	"variantSku\x12j\n" +
+	"\x12variant_attributes\x18\a \x03(\v2;.order.ListOrderResponseItemProducts.VariantAttributesEntryR\x11variantAttributes\x12%\n" +
+	"\x0eoriginal_price\x18\b \x01(\x01R\roriginalPrice\x1aD\n" +
+	"\x16VariantAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xbd\x02\n" +
 	"\x15ListOrderResponseItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
 	"\x06number\x18\x02 \x01(\tR\x06number\x12\x1a\n" +
@@ -1163,12 +1544,27 @@ const file_order_order_proto_rawDesc = "" +
 	"\x05items\x18\x03 \x03(\v2\x1c.order.ListOrderResponseItemR\x05items\"0\n" +
 	"\x12DetailOrderRequest\x12\x1a\n" +
 	"\x02id\x18\x01 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\"o\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\"\xdf\x03\n" +
 	"\x17DetailOrderResponseItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
 	"\x05price\x18\x03 \x01(\x01R\x05price\x12\x1a\n" +
-	"\bquantity\x18\x04 \x01(\x03R\bquantity\"\xfc\x03\n" +
+	"\bquantity\x18\x04 \x01(\x03R\bquantity\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x05 \x01(\tR\tvariantId\x12\x1f\n" +
+	"\vvariant_sku\x18\x06 \x01(\tR\n" +
+	"variantSku\x12d\n" +
+	"\x12variant_attributes\x18\a \x03(\v25.order.DetailOrderResponseItem.VariantAttributesEntryR\x11variantAttributes\x12%\n" +
+	"\x0eoriginal_price\x18\b \x01(\x01R\roriginalPrice\x12\x1b\n" +
+	"\tbundle_id\x18\t \x01(\tR\bbundleId\x12\x1f\n" +
+	"\vbundle_name\x18\n" +
+	" \x01(\tR\n" +
+	"bundleName\x12\x1d\n" +
+	"\n" +
+	"is_digital\x18\v \x01(\bR\tisDigital\x1aD\n" +
+	"\x16VariantAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe8\x04\n" +
 	"\x13DetailOrderResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\tR\x02id\x12\x16\n" +
@@ -1185,20 +1581,39 @@ const file_order_order_proto_rawDesc = "" +
 	"\x05items\x18\v \x03(\v2\x1e.order.DetailOrderResponseItemR\x05items\x12\x14\n" +
 	"\x05total\x18\f \x01(\x01R\x05total\x129\n" +
 	"\n" +
-	"expired_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\texpiredAt\"u\n" +
+	"expired_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\texpiredAt\x12\x1a\n" +
+	"\bcurrency\x18\x0e \x01(\tR\bcurrency\x12)\n" +
+	"\x10display_currency\x18\x0f \x01(\tR\x0fdisplayCurrency\x12#\n" +
+	"\rexchange_rate\x18\x10 \x01(\x01R\fexchangeRate\"u\n" +
 	"\x18UpdateOrderStatusRequest\x12%\n" +
 	"\border_id\x18\x01 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\aorderId\x122\n" +
 	"\x0fnew_status_code\x18\x02 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\rnewStatusCode\"E\n" +
 	"\x19UpdateOrderStatusResponse\x12(\n" +
-	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base2\x81\x03\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"A\n" +
+	"\x18ListOrderDownloadRequest\x12%\n" +
+	"\border_id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\aorderId\"\xb3\x02\n" +
+	"\x1dListOrderDownloadResponseItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12!\n" +
+	"\fproduct_name\x18\x03 \x01(\tR\vproductName\x12#\n" +
+	"\rmax_downloads\x18\x04 \x01(\x03R\fmaxDownloads\x12%\n" +
+	"\x0edownload_count\x18\x05 \x01(\x03R\rdownloadCount\x12!\n" +
+	"\fdownload_url\x18\x06 \x01(\tR\vdownloadUrl\x12Q\n" +
+	"\x17download_url_expired_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x14downloadUrlExpiredAt\"\x81\x01\n" +
+	"\x19ListOrderDownloadResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12:\n" +
+	"\x05items\x18\x02 \x03(\v2$.order.ListOrderDownloadResponseItemR\x05items2\xd9\x03\n" +
 	"\fOrderService\x12D\n" +
 	"\vCreateOrder\x12\x19.order.CreateOrderRequest\x1a\x1a.order.CreateOrderResponse\x12M\n" +
 	"\x0eListOrderAdmin\x12\x1c.order.ListOrderAdminRequest\x1a\x1d.order.ListOrderAdminResponse\x12>\n" +
 	"\tListOrder\x12\x17.order.ListOrderRequest\x1a\x18.order.ListOrderResponse\x12D\n" +
 	"\vDetailOrder\x12\x19.order.DetailOrderRequest\x1a\x1a.order.DetailOrderResponse\x12V\n" +
-	"\x11UpdateOrderStatus\x12\x1f.order.UpdateOrderStatusRequest\x1a .order.UpdateOrderStatusResponseB1Z/github.com/xryar/golang-grpc-ecommerce/pb/orderb\x06proto3"
+	"\x11UpdateOrderStatus\x12\x1f.order.UpdateOrderStatusRequest\x1a .order.UpdateOrderStatusResponse\x12V\n" +
+	"\x11ListOrderDownload\x12\x1f.order.ListOrderDownloadRequest\x1a .order.ListOrderDownloadResponseB1Z/github.com/xryar/golang-grpc-ecommerce/pb/orderb\x06proto3"
 
 var (
 	file_order_order_proto_rawDescOnce sync.Once
@@ -1212,7 +1627,7 @@ func file_order_order_proto_rawDescGZIP() []byte {
 	return file_order_order_proto_rawDescData
 }
 
-var file_order_order_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_order_order_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_order_order_proto_goTypes = []any{
 	(*CreateOrderRequestProductItem)(nil),      // 0: order.CreateOrderRequestProductItem
 	(*CreateOrderRequest)(nil),                 // 1: order.CreateOrderRequest
@@ -1230,46 +1645,60 @@ var file_order_order_proto_goTypes = []any{
 	(*DetailOrderResponse)(nil),                // 13: order.DetailOrderResponse
 	(*UpdateOrderStatusRequest)(nil),           // 14: order.UpdateOrderStatusRequest
 	(*UpdateOrderStatusResponse)(nil),          // 15: order.UpdateOrderStatusResponse
-	(*common.BaseResponse)(nil),                // 16: common.BaseResponse
-	(*common.PaginationRequest)(nil),           // 17: common.PaginationRequest
-	(*timestamppb.Timestamp)(nil),              // 18: google.protobuf.Timestamp
-	(*common.PaginationResponse)(nil),          // 19: common.PaginationResponse
+	(*ListOrderDownloadRequest)(nil),           // 16: order.ListOrderDownloadRequest
+	(*ListOrderDownloadResponseItem)(nil),      // 17: order.ListOrderDownloadResponseItem
+	(*ListOrderDownloadResponse)(nil),          // 18: order.ListOrderDownloadResponse
+	nil,                                        // 19: order.ListOrderAdminResponseItemProducts.VariantAttributesEntry
+	nil,                                        // 20: order.ListOrderResponseItemProducts.VariantAttributesEntry
+	nil,                                        // 21: order.DetailOrderResponseItem.VariantAttributesEntry
+	(*common.BaseResponse)(nil),                // 22: common.BaseResponse
+	(*common.PaginationRequest)(nil),           // 23: common.PaginationRequest
+	(*timestamppb.Timestamp)(nil),              // 24: google.protobuf.Timestamp
+	(*common.PaginationResponse)(nil),          // 25: common.PaginationResponse
 }
 var file_order_order_proto_depIdxs = []int32{
 	0,  // 0: order.CreateOrderRequest.products:type_name -> order.CreateOrderRequestProductItem
-	16, // 1: order.CreateOrderResponse.base:type_name -> common.BaseResponse
-	17, // 2: order.ListOrderAdminRequest.pagination:type_name -> common.PaginationRequest
-	18, // 3: order.ListOrderAdminResponseItem.created_at:type_name -> google.protobuf.Timestamp
-	4,  // 4: order.ListOrderAdminResponseItem.products:type_name -> order.ListOrderAdminResponseItemProducts
-	16, // 5: order.ListOrderAdminResponse.base:type_name -> common.BaseResponse
-	19, // 6: order.ListOrderAdminResponse.pagination:type_name -> common.PaginationResponse
-	5,  // 7: order.ListOrderAdminResponse.items:type_name -> order.ListOrderAdminResponseItem
-	17, // 8: order.ListOrderRequest.pagination:type_name -> common.PaginationRequest
-	18, // 9: order.ListOrderResponseItem.created_at:type_name -> google.protobuf.Timestamp
-	8,  // 10: order.ListOrderResponseItem.products:type_name -> order.ListOrderResponseItemProducts
-	16, // 11: order.ListOrderResponse.base:type_name -> common.BaseResponse
-	19, // 12: order.ListOrderResponse.pagination:type_name -> common.PaginationResponse
-	9,  // 13: order.ListOrderResponse.items:type_name -> order.ListOrderResponseItem
-	16, // 14: order.DetailOrderResponse.base:type_name -> common.BaseResponse
-	18, // 15: order.DetailOrderResponse.created_at:type_name -> google.protobuf.Timestamp
-	12, // 16: order.DetailOrderResponse.items:type_name -> order.DetailOrderResponseItem
-	18, // 17: order.DetailOrderResponse.expired_at:type_name -> google.protobuf.Timestamp
-	16, // 18: order.UpdateOrderStatusResponse.base:type_name -> common.BaseResponse
-	1,  // 19: order.OrderService.CreateOrder:input_type -> order.CreateOrderRequest
-	3,  // 20: order.OrderService.ListOrderAdmin:input_type -> order.ListOrderAdminRequest
-	7,  // 21: order.OrderService.ListOrder:input_type -> order.ListOrderRequest
-	11, // 22: order.OrderService.DetailOrder:input_type -> order.DetailOrderRequest
-	14, // 23: order.OrderService.UpdateOrderStatus:input_type -> order.UpdateOrderStatusRequest
-	2,  // 24: order.OrderService.CreateOrder:output_type -> order.CreateOrderResponse
-	6,  // 25: order.OrderService.ListOrderAdmin:output_type -> order.ListOrderAdminResponse
-	10, // 26: order.OrderService.ListOrder:output_type -> order.ListOrderResponse
-	13, // 27: order.OrderService.DetailOrder:output_type -> order.DetailOrderResponse
-	15, // 28: order.OrderService.UpdateOrderStatus:output_type -> order.UpdateOrderStatusResponse
-	24, // [24:29] is the sub-list for method output_type
-	19, // [19:24] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	22, // 1: order.CreateOrderResponse.base:type_name -> common.BaseResponse
+	23, // 2: order.ListOrderAdminRequest.pagination:type_name -> common.PaginationRequest
+	19, // 3: order.ListOrderAdminResponseItemProducts.variant_attributes:type_name -> order.ListOrderAdminResponseItemProducts.VariantAttributesEntry
+	24, // 4: order.ListOrderAdminResponseItem.created_at:type_name -> google.protobuf.Timestamp
+	4,  // 5: order.ListOrderAdminResponseItem.products:type_name -> order.ListOrderAdminResponseItemProducts
+	22, // 6: order.ListOrderAdminResponse.base:type_name -> common.BaseResponse
+	25, // 7: order.ListOrderAdminResponse.pagination:type_name -> common.PaginationResponse
+	5,  // 8: order.ListOrderAdminResponse.items:type_name -> order.ListOrderAdminResponseItem
+	23, // 9: order.ListOrderRequest.pagination:type_name -> common.PaginationRequest
+	20, // 10: order.ListOrderResponseItemProducts.variant_attributes:type_name -> order.ListOrderResponseItemProducts.VariantAttributesEntry
+	24, // 11: order.ListOrderResponseItem.created_at:type_name -> google.protobuf.Timestamp
+	8,  // 12: order.ListOrderResponseItem.products:type_name -> order.ListOrderResponseItemProducts
+	22, // 13: order.ListOrderResponse.base:type_name -> common.BaseResponse
+	25, // 14: order.ListOrderResponse.pagination:type_name -> common.PaginationResponse
+	9,  // 15: order.ListOrderResponse.items:type_name -> order.ListOrderResponseItem
+	21, // 16: order.DetailOrderResponseItem.variant_attributes:type_name -> order.DetailOrderResponseItem.VariantAttributesEntry
+	22, // 17: order.DetailOrderResponse.base:type_name -> common.BaseResponse
+	24, // 18: order.DetailOrderResponse.created_at:type_name -> google.protobuf.Timestamp
+	12, // 19: order.DetailOrderResponse.items:type_name -> order.DetailOrderResponseItem
+	24, // 20: order.DetailOrderResponse.expired_at:type_name -> google.protobuf.Timestamp
+	22, // 21: order.UpdateOrderStatusResponse.base:type_name -> common.BaseResponse
+	24, // 22: order.ListOrderDownloadResponseItem.download_url_expired_at:type_name -> google.protobuf.Timestamp
+	22, // 23: order.ListOrderDownloadResponse.base:type_name -> common.BaseResponse
+	17, // 24: order.ListOrderDownloadResponse.items:type_name -> order.ListOrderDownloadResponseItem
+	1,  // 25: order.OrderService.CreateOrder:input_type -> order.CreateOrderRequest
+	3,  // 26: order.OrderService.ListOrderAdmin:input_type -> order.ListOrderAdminRequest
+	7,  // 27: order.OrderService.ListOrder:input_type -> order.ListOrderRequest
+	11, // 28: order.OrderService.DetailOrder:input_type -> order.DetailOrderRequest
+	14, // 29: order.OrderService.UpdateOrderStatus:input_type -> order.UpdateOrderStatusRequest
+	16, // 30: order.OrderService.ListOrderDownload:input_type -> order.ListOrderDownloadRequest
+	2,  // 31: order.OrderService.CreateOrder:output_type -> order.CreateOrderResponse
+	6,  // 32: order.OrderService.ListOrderAdmin:output_type -> order.ListOrderAdminResponse
+	10, // 33: order.OrderService.ListOrder:output_type -> order.ListOrderResponse
+	13, // 34: order.OrderService.DetailOrder:output_type -> order.DetailOrderResponse
+	15, // 35: order.OrderService.UpdateOrderStatus:output_type -> order.UpdateOrderStatusResponse
+	18, // 36: order.OrderService.ListOrderDownload:output_type -> order.ListOrderDownloadResponse
+	31, // [31:37] is the sub-list for method output_type
+	25, // [25:31] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_order_order_proto_init() }
@@ -1283,7 +1712,7 @@ func file_order_order_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_order_order_proto_rawDesc), len(file_order_order_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -11,6 +11,7 @@ import (
 	common "github.com/xryar/golang-grpc-ecommerce/pb/common"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -29,8 +30,39 @@ type CreateProductRequest struct {
 	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
 	ImageFileName string                 `protobuf:"bytes,4,opt,name=image_file_name,json=imageFileName,proto3" json:"image_file_name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Stock         int64                  `protobuf:"varint,5,opt,name=stock,proto3" json:"stock,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Sku           string                 `protobuf:"bytes,7,opt,name=sku,proto3" json:"sku,omitempty"`
+	// the sale price applies between sale_starts_at and sale_ends_at, an
+	// unset bound leaves that side open
+	SalePrice    *float64               `protobuf:"fixed64,8,opt,name=sale_price,json=salePrice,proto3,oneof" json:"sale_price,omitempty"`
+	SaleStartsAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=sale_starts_at,json=saleStartsAt,proto3" json:"sale_starts_at,omitempty"`
+	SaleEndsAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=sale_ends_at,json=saleEndsAt,proto3" json:"sale_ends_at,omitempty"`
+	// left empty the slug is generated from the name on create and kept as
+	// it is on edit
+	Slug           string `protobuf:"bytes,11,opt,name=slug,proto3" json:"slug,omitempty"`
+	SeoTitle       string `protobuf:"bytes,12,opt,name=seo_title,json=seoTitle,proto3" json:"seo_title,omitempty"`
+	SeoDescription string `protobuf:"bytes,13,opt,name=seo_description,json=seoDescription,proto3" json:"seo_description,omitempty"`
+	// a product is only shown to customers once it is published and its
+	// publish_at, when set, has passed. left empty the product is a draft
+	Status    string                 `protobuf:"bytes,14,opt,name=status,proto3" json:"status,omitempty"`
+	PublishAt *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	// a bundle is made of bundle_items and has no stock or variants of its
+	// own. a digital product is downloaded after payment instead of shipped.
+	// left empty the product is simple
+	Type        string                      `protobuf:"bytes,16,opt,name=type,proto3" json:"type,omitempty"`
+	BundleItems []*ProductBundleItemRequest `protobuf:"bytes,17,rep,name=bundle_items,json=bundleItems,proto3" json:"bundle_items,omitempty"`
+	// the file uploaded to /product/digital/upload, required for digital
+	// products
+	DigitalFileName string `protobuf:"bytes,18,opt,name=digital_file_name,json=digitalFileName,proto3" json:"digital_file_name,omitempty"`
+	// values for the attributes of the product's category and its parents
+	Attributes []*ProductAttributeValueRequest `protobuf:"bytes,19,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	// how many units one order may contain, counting all variants. left
+	// unset that side is unlimited
+	MinOrderQuantity *int64 `protobuf:"varint,20,opt,name=min_order_quantity,json=minOrderQuantity,proto3,oneof" json:"min_order_quantity,omitempty"`
+	MaxOrderQuantity *int64 `protobuf:"varint,21,opt,name=max_order_quantity,json=maxOrderQuantity,proto3,oneof" json:"max_order_quantity,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *CreateProductRequest) Reset() {
@@ -67,53 +99,3040 @@ func (x *CreateProductRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
-	return ""
+	return ""
+}
+
+func (x *CreateProductRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetImageFileName() string {
+	if x != nil {
+		return x.ImageFileName
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetStock() int64 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetSalePrice() float64 {
+	if x != nil && x.SalePrice != nil {
+		return *x.SalePrice
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetSaleStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SaleStartsAt
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetSaleEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SaleEndsAt
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetSeoTitle() string {
+	if x != nil {
+		return x.SeoTitle
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetSeoDescription() string {
+	if x != nil {
+		return x.SeoDescription
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetBundleItems() []*ProductBundleItemRequest {
+	if x != nil {
+		return x.BundleItems
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetDigitalFileName() string {
+	if x != nil {
+		return x.DigitalFileName
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetAttributes() []*ProductAttributeValueRequest {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetMinOrderQuantity() int64 {
+	if x != nil && x.MinOrderQuantity != nil {
+		return *x.MinOrderQuantity
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetMaxOrderQuantity() int64 {
+	if x != nil && x.MaxOrderQuantity != nil {
+		return *x.MaxOrderQuantity
+	}
+	return 0
+}
+
+type ProductAttributeValueRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AttributeId string                 `protobuf:"bytes,1,opt,name=attribute_id,json=attributeId,proto3" json:"attribute_id,omitempty"`
+	// numbers are given as e.g. 1.5 and booleans as true or false
+	Value         string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductAttributeValueRequest) Reset() {
+	*x = ProductAttributeValueRequest{}
+	mi := &file_product_product_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductAttributeValueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductAttributeValueRequest) ProtoMessage() {}
+
+func (x *ProductAttributeValueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductAttributeValueRequest.ProtoReflect.Descriptor instead.
+func (*ProductAttributeValueRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ProductAttributeValueRequest) GetAttributeId() string {
+	if x != nil {
+		return x.AttributeId
+	}
+	return ""
+}
+
+func (x *ProductAttributeValueRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type ProductBundleItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductBundleItemRequest) Reset() {
+	*x = ProductBundleItemRequest{}
+	mi := &file_product_product_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductBundleItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductBundleItemRequest) ProtoMessage() {}
+
+func (x *ProductBundleItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductBundleItemRequest.ProtoReflect.Descriptor instead.
+func (*ProductBundleItemRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProductBundleItemRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ProductBundleItemRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type CreateProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductResponse) Reset() {
+	*x = CreateProductResponse{}
+	mi := &file_product_product_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductResponse) ProtoMessage() {}
+
+func (x *CreateProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductResponse.ProtoReflect.Descriptor instead.
+func (*CreateProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateProductResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CreateProductResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DetailProductRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the product id or any slug the product has ever had
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the currency prices are shown in, left empty the x-currency metadata or
+	// else IDR is used
+	Currency      string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetailProductRequest) Reset() {
+	*x = DetailProductRequest{}
+	mi := &file_product_product_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetailProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetailProductRequest) ProtoMessage() {}
+
+func (x *DetailProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetailProductRequest.ProtoReflect.Descriptor instead.
+func (*DetailProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DetailProductRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DetailProductRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type DetailProductResponseCategory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetailProductResponseCategory) Reset() {
+	*x = DetailProductResponseCategory{}
+	mi := &file_product_product_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetailProductResponseCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetailProductResponseCategory) ProtoMessage() {}
+
+func (x *DetailProductResponseCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetailProductResponseCategory.ProtoReflect.Descriptor instead.
+func (*DetailProductResponseCategory) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DetailProductResponseCategory) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DetailProductResponseCategory) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DetailProductResponseVariant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sku           string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Attributes    map[string]string      `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Stock         int64                  `protobuf:"varint,6,opt,name=stock,proto3" json:"stock,omitempty"`
+	OriginalPrice float64                `protobuf:"fixed64,7,opt,name=original_price,json=originalPrice,proto3" json:"original_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetailProductResponseVariant) Reset() {
+	*x = DetailProductResponseVariant{}
+	mi := &file_product_product_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetailProductResponseVariant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetailProductResponseVariant) ProtoMessage() {}
+
+func (x *DetailProductResponseVariant) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetailProductResponseVariant.ProtoReflect.Descriptor instead.
+func (*DetailProductResponseVariant) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DetailProductResponseVariant) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DetailProductResponseVariant) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *DetailProductResponseVariant) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *DetailProductResponseVariant) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *DetailProductResponseVariant) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *DetailProductResponseVariant) GetStock() int64 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *DetailProductResponseVariant) GetOriginalPrice() float64 {
+	if x != nil {
+		return x.OriginalPrice
+	}
+	return 0
+}
+
+type DetailProductResponseBundleItem struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Slug      string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Name      string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Quantity  int64                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// what the component costs on its own
+	Price         float64 `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
+	ImageUrl      string  `protobuf:"bytes,6,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetailProductResponseBundleItem) Reset() {
+	*x = DetailProductResponseBundleItem{}
+	mi := &file_product_product_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetailProductResponseBundleItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetailProductResponseBundleItem) ProtoMessage() {}
+
+func (x *DetailProductResponseBundleItem) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetailProductResponseBundleItem.ProtoReflect.Descriptor instead.
+func (*DetailProductResponseBundleItem) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DetailProductResponseBundleItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *DetailProductResponseBundleItem) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *DetailProductResponseBundleItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DetailProductResponseBundleItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *DetailProductResponseBundleItem) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *DetailProductResponseBundleItem) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+type DetailProductResponseImage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,2,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	IsPrimary     bool                   `protobuf:"varint,3,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetailProductResponseImage) Reset() {
+	*x = DetailProductResponseImage{}
+	mi := &file_product_product_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetailProductResponseImage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetailProductResponseImage) ProtoMessage() {}
+
+func (x *DetailProductResponseImage) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetailProductResponseImage.ProtoReflect.Descriptor instead.
+func (*DetailProductResponseImage) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DetailProductResponseImage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DetailProductResponseImage) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *DetailProductResponseImage) GetIsPrimary() bool {
+	if x != nil {
+		return x.IsPrimary
+	}
+	return false
+}
+
+type DetailProductResponse struct {
+	state              protoimpl.MessageState           `protogen:"open.v1"`
+	Base               *common.BaseResponse             `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id                 string                           `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name               string                           `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description        string                           `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price              float64                          `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
+	ImageUrl           string                           `protobuf:"bytes,6,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Stock              int64                            `protobuf:"varint,7,opt,name=stock,proto3" json:"stock,omitempty"`
+	CategoryBreadcrumb []*DetailProductResponseCategory `protobuf:"bytes,8,rep,name=category_breadcrumb,json=categoryBreadcrumb,proto3" json:"category_breadcrumb,omitempty"`
+	Variants           []*DetailProductResponseVariant  `protobuf:"bytes,9,rep,name=variants,proto3" json:"variants,omitempty"`
+	Images             []*DetailProductResponseImage    `protobuf:"bytes,10,rep,name=images,proto3" json:"images,omitempty"`
+	RatingAverage      float64                          `protobuf:"fixed64,12,opt,name=rating_average,json=ratingAverage,proto3" json:"rating_average,omitempty"`
+	ReviewCount        int64                            `protobuf:"varint,13,opt,name=review_count,json=reviewCount,proto3" json:"review_count,omitempty"`
+	Sku                string                           `protobuf:"bytes,14,opt,name=sku,proto3" json:"sku,omitempty"`
+	// price is what the product costs right now, original_price is the price
+	// before any running sale
+	OriginalPrice float64                `protobuf:"fixed64,15,opt,name=original_price,json=originalPrice,proto3" json:"original_price,omitempty"`
+	IsOnSale      bool                   `protobuf:"varint,16,opt,name=is_on_sale,json=isOnSale,proto3" json:"is_on_sale,omitempty"`
+	SaleEndsAt    *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=sale_ends_at,json=saleEndsAt,proto3" json:"sale_ends_at,omitempty"`
+	// the current slug, clients opened through an old one should redirect to it
+	Slug           string                 `protobuf:"bytes,18,opt,name=slug,proto3" json:"slug,omitempty"`
+	SeoTitle       string                 `protobuf:"bytes,19,opt,name=seo_title,json=seoTitle,proto3" json:"seo_title,omitempty"`
+	SeoDescription string                 `protobuf:"bytes,20,opt,name=seo_description,json=seoDescription,proto3" json:"seo_description,omitempty"`
+	Status         string                 `protobuf:"bytes,21,opt,name=status,proto3" json:"status,omitempty"`
+	PublishAt      *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	// the currency every price is in
+	Currency string `protobuf:"bytes,23,opt,name=currency,proto3" json:"currency,omitempty"`
+	Type     string `protobuf:"bytes,24,opt,name=type,proto3" json:"type,omitempty"`
+	// what one bundle contains, empty for simple products
+	BundleItems []*DetailProductResponseBundleItem `protobuf:"bytes,25,rep,name=bundle_items,json=bundleItems,proto3" json:"bundle_items,omitempty"`
+	Attributes  []*DetailProductResponseAttribute  `protobuf:"bytes,26,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	// how many units one order may contain, unset when unlimited
+	MinOrderQuantity *int64 `protobuf:"varint,27,opt,name=min_order_quantity,json=minOrderQuantity,proto3,oneof" json:"min_order_quantity,omitempty"`
+	MaxOrderQuantity *int64 `protobuf:"varint,28,opt,name=max_order_quantity,json=maxOrderQuantity,proto3,oneof" json:"max_order_quantity,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DetailProductResponse) Reset() {
+	*x = DetailProductResponse{}
+	mi := &file_product_product_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetailProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetailProductResponse) ProtoMessage() {}
+
+func (x *DetailProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetailProductResponse.ProtoReflect.Descriptor instead.
+func (*DetailProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DetailProductResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *DetailProductResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DetailProductResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DetailProductResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DetailProductResponse) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *DetailProductResponse) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *DetailProductResponse) GetStock() int64 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *DetailProductResponse) GetCategoryBreadcrumb() []*DetailProductResponseCategory {
+	if x != nil {
+		return x.CategoryBreadcrumb
+	}
+	return nil
+}
+
+func (x *DetailProductResponse) GetVariants() []*DetailProductResponseVariant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *DetailProductResponse) GetImages() []*DetailProductResponseImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *DetailProductResponse) GetRatingAverage() float64 {
+	if x != nil {
+		return x.RatingAverage
+	}
+	return 0
+}
+
+func (x *DetailProductResponse) GetReviewCount() int64 {
+	if x != nil {
+		return x.ReviewCount
+	}
+	return 0
+}
+
+func (x *DetailProductResponse) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *DetailProductResponse) GetOriginalPrice() float64 {
+	if x != nil {
+		return x.OriginalPrice
+	}
+	return 0
+}
+
+func (x *DetailProductResponse) GetIsOnSale() bool {
+	if x != nil {
+		return x.IsOnSale
+	}
+	return false
+}
+
+func (x *DetailProductResponse) GetSaleEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SaleEndsAt
+	}
+	return nil
+}
+
+func (x *DetailProductResponse) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *DetailProductResponse) GetSeoTitle() string {
+	if x != nil {
+		return x.SeoTitle
+	}
+	return ""
+}
+
+func (x *DetailProductResponse) GetSeoDescription() string {
+	if x != nil {
+		return x.SeoDescription
+	}
+	return ""
+}
+
+func (x *DetailProductResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DetailProductResponse) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *DetailProductResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *DetailProductResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DetailProductResponse) GetBundleItems() []*DetailProductResponseBundleItem {
+	if x != nil {
+		return x.BundleItems
+	}
+	return nil
+}
+
+func (x *DetailProductResponse) GetAttributes() []*DetailProductResponseAttribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *DetailProductResponse) GetMinOrderQuantity() int64 {
+	if x != nil && x.MinOrderQuantity != nil {
+		return *x.MinOrderQuantity
+	}
+	return 0
+}
+
+func (x *DetailProductResponse) GetMaxOrderQuantity() int64 {
+	if x != nil && x.MaxOrderQuantity != nil {
+		return *x.MaxOrderQuantity
+	}
+	return 0
+}
+
+type DetailProductResponseAttribute struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttributeId   string                 `protobuf:"bytes,1,opt,name=attribute_id,json=attributeId,proto3" json:"attribute_id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Unit          string                 `protobuf:"bytes,5,opt,name=unit,proto3" json:"unit,omitempty"`
+	Value         string                 `protobuf:"bytes,6,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetailProductResponseAttribute) Reset() {
+	*x = DetailProductResponseAttribute{}
+	mi := &file_product_product_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetailProductResponseAttribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetailProductResponseAttribute) ProtoMessage() {}
+
+func (x *DetailProductResponseAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetailProductResponseAttribute.ProtoReflect.Descriptor instead.
+func (*DetailProductResponseAttribute) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DetailProductResponseAttribute) GetAttributeId() string {
+	if x != nil {
+		return x.AttributeId
+	}
+	return ""
+}
+
+func (x *DetailProductResponseAttribute) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *DetailProductResponseAttribute) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DetailProductResponseAttribute) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DetailProductResponseAttribute) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *DetailProductResponseAttribute) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type EditProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	ImageFileName string                 `protobuf:"bytes,5,opt,name=image_file_name,json=imageFileName,proto3" json:"image_file_name,omitempty"`
+	Stock         int64                  `protobuf:"varint,6,opt,name=stock,proto3" json:"stock,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,7,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Sku           string                 `protobuf:"bytes,8,opt,name=sku,proto3" json:"sku,omitempty"`
+	SalePrice     *float64               `protobuf:"fixed64,9,opt,name=sale_price,json=salePrice,proto3,oneof" json:"sale_price,omitempty"`
+	SaleStartsAt  *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=sale_starts_at,json=saleStartsAt,proto3" json:"sale_starts_at,omitempty"`
+	SaleEndsAt    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=sale_ends_at,json=saleEndsAt,proto3" json:"sale_ends_at,omitempty"`
+	// left empty the slug is generated from the name on create and kept as
+	// it is on edit
+	Slug           string `protobuf:"bytes,12,opt,name=slug,proto3" json:"slug,omitempty"`
+	SeoTitle       string `protobuf:"bytes,13,opt,name=seo_title,json=seoTitle,proto3" json:"seo_title,omitempty"`
+	SeoDescription string `protobuf:"bytes,14,opt,name=seo_description,json=seoDescription,proto3" json:"seo_description,omitempty"`
+	// left empty the status is kept as it is
+	Status    string                 `protobuf:"bytes,15,opt,name=status,proto3" json:"status,omitempty"`
+	PublishAt *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	// replaces the components of a bundle, ignored for simple products. the
+	// type of a product can not be changed
+	BundleItems []*ProductBundleItemRequest `protobuf:"bytes,17,rep,name=bundle_items,json=bundleItems,proto3" json:"bundle_items,omitempty"`
+	// replaces the file of a digital product, left empty the file is kept.
+	// orders placed before keep downloading the file they bought
+	DigitalFileName string `protobuf:"bytes,18,opt,name=digital_file_name,json=digitalFileName,proto3" json:"digital_file_name,omitempty"`
+	// replaces the attribute values of the product
+	Attributes       []*ProductAttributeValueRequest `protobuf:"bytes,19,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	MinOrderQuantity *int64                          `protobuf:"varint,20,opt,name=min_order_quantity,json=minOrderQuantity,proto3,oneof" json:"min_order_quantity,omitempty"`
+	MaxOrderQuantity *int64                          `protobuf:"varint,21,opt,name=max_order_quantity,json=maxOrderQuantity,proto3,oneof" json:"max_order_quantity,omitempty"`
+	// the stock the product had when it was loaded for editing, the edit
+	// fails when orders changed it since. left unset the stock at the time
+	// of the edit is used. ignored for bundles
+	PreviousStock *int64 `protobuf:"varint,22,opt,name=previous_stock,json=previousStock,proto3,oneof" json:"previous_stock,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditProductRequest) Reset() {
+	*x = EditProductRequest{}
+	mi := &file_product_product_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditProductRequest) ProtoMessage() {}
+
+func (x *EditProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditProductRequest.ProtoReflect.Descriptor instead.
+func (*EditProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *EditProductRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EditProductRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EditProductRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *EditProductRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *EditProductRequest) GetImageFileName() string {
+	if x != nil {
+		return x.ImageFileName
+	}
+	return ""
+}
+
+func (x *EditProductRequest) GetStock() int64 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *EditProductRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *EditProductRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *EditProductRequest) GetSalePrice() float64 {
+	if x != nil && x.SalePrice != nil {
+		return *x.SalePrice
+	}
+	return 0
+}
+
+func (x *EditProductRequest) GetSaleStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SaleStartsAt
+	}
+	return nil
+}
+
+func (x *EditProductRequest) GetSaleEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SaleEndsAt
+	}
+	return nil
+}
+
+func (x *EditProductRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *EditProductRequest) GetSeoTitle() string {
+	if x != nil {
+		return x.SeoTitle
+	}
+	return ""
+}
+
+func (x *EditProductRequest) GetSeoDescription() string {
+	if x != nil {
+		return x.SeoDescription
+	}
+	return ""
+}
+
+func (x *EditProductRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *EditProductRequest) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *EditProductRequest) GetBundleItems() []*ProductBundleItemRequest {
+	if x != nil {
+		return x.BundleItems
+	}
+	return nil
+}
+
+func (x *EditProductRequest) GetDigitalFileName() string {
+	if x != nil {
+		return x.DigitalFileName
+	}
+	return ""
+}
+
+func (x *EditProductRequest) GetAttributes() []*ProductAttributeValueRequest {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *EditProductRequest) GetMinOrderQuantity() int64 {
+	if x != nil && x.MinOrderQuantity != nil {
+		return *x.MinOrderQuantity
+	}
+	return 0
+}
+
+func (x *EditProductRequest) GetMaxOrderQuantity() int64 {
+	if x != nil && x.MaxOrderQuantity != nil {
+		return *x.MaxOrderQuantity
+	}
+	return 0
+}
+
+func (x *EditProductRequest) GetPreviousStock() int64 {
+	if x != nil && x.PreviousStock != nil {
+		return *x.PreviousStock
+	}
+	return 0
+}
+
+type EditProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditProductResponse) Reset() {
+	*x = EditProductResponse{}
+	mi := &file_product_product_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditProductResponse) ProtoMessage() {}
+
+func (x *EditProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditProductResponse.ProtoReflect.Descriptor instead.
+func (*EditProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EditProductResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *EditProductResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductRequest) Reset() {
+	*x = DeleteProductRequest{}
+	mi := &file_product_product_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductRequest) ProtoMessage() {}
+
+func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteProductRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductResponse) Reset() {
+	*x = DeleteProductResponse{}
+	mi := &file_product_product_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductResponse) ProtoMessage() {}
+
+func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeleteProductResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type ListProductRequest struct {
+	state      protoimpl.MessageState    `protogen:"open.v1"`
+	Pagination *common.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	CategoryId string                    `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Search     string                    `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
+	// the currency prices are shown in, left empty the x-currency metadata or
+	// else IDR is used
+	Currency string `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	// every attribute filter must match
+	AttributeFilters []*ProductAttributeFilter `protobuf:"bytes,5,rep,name=attribute_filters,json=attributeFilters,proto3" json:"attribute_filters,omitempty"`
+	// bounds the price products sell for now, in currency
+	MinPrice      *float64 `protobuf:"fixed64,6,opt,name=min_price,json=minPrice,proto3,oneof" json:"min_price,omitempty"`
+	MaxPrice      *float64 `protobuf:"fixed64,7,opt,name=max_price,json=maxPrice,proto3,oneof" json:"max_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductRequest) Reset() {
+	*x = ListProductRequest{}
+	mi := &file_product_product_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductRequest) ProtoMessage() {}
+
+func (x *ListProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductRequest.ProtoReflect.Descriptor instead.
+func (*ListProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListProductRequest) GetPagination() *common.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListProductRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *ListProductRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *ListProductRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ListProductRequest) GetAttributeFilters() []*ProductAttributeFilter {
+	if x != nil {
+		return x.AttributeFilters
+	}
+	return nil
+}
+
+func (x *ListProductRequest) GetMinPrice() float64 {
+	if x != nil && x.MinPrice != nil {
+		return *x.MinPrice
+	}
+	return 0
+}
+
+func (x *ListProductRequest) GetMaxPrice() float64 {
+	if x != nil && x.MaxPrice != nil {
+		return *x.MaxPrice
+	}
+	return 0
+}
+
+// matches products whose attribute named code has one of values and, for
+// number attributes, lies between min and max
+type ProductAttributeFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Values        []string               `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	Min           *float64               `protobuf:"fixed64,3,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max           *float64               `protobuf:"fixed64,4,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductAttributeFilter) Reset() {
+	*x = ProductAttributeFilter{}
+	mi := &file_product_product_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductAttributeFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductAttributeFilter) ProtoMessage() {}
+
+func (x *ProductAttributeFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductAttributeFilter.ProtoReflect.Descriptor instead.
+func (*ProductAttributeFilter) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ProductAttributeFilter) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ProductAttributeFilter) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *ProductAttributeFilter) GetMin() float64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *ProductAttributeFilter) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+type ListProductResponseItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Stock         int64                  `protobuf:"varint,6,opt,name=stock,proto3" json:"stock,omitempty"`
+	RatingAverage float64                `protobuf:"fixed64,7,opt,name=rating_average,json=ratingAverage,proto3" json:"rating_average,omitempty"`
+	ReviewCount   int64                  `protobuf:"varint,8,opt,name=review_count,json=reviewCount,proto3" json:"review_count,omitempty"`
+	OriginalPrice float64                `protobuf:"fixed64,9,opt,name=original_price,json=originalPrice,proto3" json:"original_price,omitempty"`
+	IsOnSale      bool                   `protobuf:"varint,10,opt,name=is_on_sale,json=isOnSale,proto3" json:"is_on_sale,omitempty"`
+	Slug          string                 `protobuf:"bytes,11,opt,name=slug,proto3" json:"slug,omitempty"`
+	Type          string                 `protobuf:"bytes,12,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductResponseItem) Reset() {
+	*x = ListProductResponseItem{}
+	mi := &file_product_product_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductResponseItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductResponseItem) ProtoMessage() {}
+
+func (x *ListProductResponseItem) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductResponseItem.ProtoReflect.Descriptor instead.
+func (*ListProductResponseItem) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListProductResponseItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListProductResponseItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListProductResponseItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ListProductResponseItem) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ListProductResponseItem) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *ListProductResponseItem) GetStock() int64 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *ListProductResponseItem) GetRatingAverage() float64 {
+	if x != nil {
+		return x.RatingAverage
+	}
+	return 0
+}
+
+func (x *ListProductResponseItem) GetReviewCount() int64 {
+	if x != nil {
+		return x.ReviewCount
+	}
+	return 0
+}
+
+func (x *ListProductResponseItem) GetOriginalPrice() float64 {
+	if x != nil {
+		return x.OriginalPrice
+	}
+	return 0
+}
+
+func (x *ListProductResponseItem) GetIsOnSale() bool {
+	if x != nil {
+		return x.IsOnSale
+	}
+	return false
+}
+
+func (x *ListProductResponseItem) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *ListProductResponseItem) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type ListProductResponse struct {
+	state      protoimpl.MessageState     `protogen:"open.v1"`
+	Base       *common.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Pagination *common.PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Data       []*ListProductResponseItem `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	// the currency every price is in
+	Currency string `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	// sum up every product matching the request, not only this page
+	Facets        []*ListProductResponseFacet    `protobuf:"bytes,5,rep,name=facets,proto3" json:"facets,omitempty"`
+	PriceRange    *ListProductResponsePriceRange `protobuf:"bytes,6,opt,name=price_range,json=priceRange,proto3" json:"price_range,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductResponse) Reset() {
+	*x = ListProductResponse{}
+	mi := &file_product_product_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductResponse) ProtoMessage() {}
+
+func (x *ListProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductResponse.ProtoReflect.Descriptor instead.
+func (*ListProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListProductResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListProductResponse) GetPagination() *common.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListProductResponse) GetData() []*ListProductResponseItem {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListProductResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ListProductResponse) GetFacets() []*ListProductResponseFacet {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+func (x *ListProductResponse) GetPriceRange() *ListProductResponsePriceRange {
+	if x != nil {
+		return x.PriceRange
+	}
+	return nil
+}
+
+type ListProductResponseFacet struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Code  string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type  string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Unit  string                 `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`
+	// how many products have a value for the attribute
+	Count int64 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	// the values of text and boolean attributes, the most common first
+	Values []*ListProductResponseFacetValue `protobuf:"bytes,6,rep,name=values,proto3" json:"values,omitempty"`
+	// the range of number attributes
+	Min           *float64 `protobuf:"fixed64,7,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max           *float64 `protobuf:"fixed64,8,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductResponseFacet) Reset() {
+	*x = ListProductResponseFacet{}
+	mi := &file_product_product_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductResponseFacet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductResponseFacet) ProtoMessage() {}
+
+func (x *ListProductResponseFacet) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductResponseFacet.ProtoReflect.Descriptor instead.
+func (*ListProductResponseFacet) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListProductResponseFacet) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ListProductResponseFacet) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListProductResponseFacet) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListProductResponseFacet) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *ListProductResponseFacet) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ListProductResponseFacet) GetValues() []*ListProductResponseFacetValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *ListProductResponseFacet) GetMin() float64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *ListProductResponseFacet) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+type ListProductResponseFacetValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductResponseFacetValue) Reset() {
+	*x = ListProductResponseFacetValue{}
+	mi := &file_product_product_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductResponseFacetValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductResponseFacetValue) ProtoMessage() {}
+
+func (x *ListProductResponseFacetValue) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductResponseFacetValue.ProtoReflect.Descriptor instead.
+func (*ListProductResponseFacetValue) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListProductResponseFacetValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ListProductResponseFacetValue) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// not set when no product matches
+type ListProductResponsePriceRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Min           float64                `protobuf:"fixed64,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max           float64                `protobuf:"fixed64,2,opt,name=max,proto3" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductResponsePriceRange) Reset() {
+	*x = ListProductResponsePriceRange{}
+	mi := &file_product_product_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductResponsePriceRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductResponsePriceRange) ProtoMessage() {}
+
+func (x *ListProductResponsePriceRange) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductResponsePriceRange.ProtoReflect.Descriptor instead.
+func (*ListProductResponsePriceRange) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListProductResponsePriceRange) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *ListProductResponsePriceRange) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+type ListProductAdminRequest struct {
+	state      protoimpl.MessageState    `protogen:"open.v1"`
+	Pagination *common.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	CategoryId string                    `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	// left empty products of every status are listed
+	Status        string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductAdminRequest) Reset() {
+	*x = ListProductAdminRequest{}
+	mi := &file_product_product_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductAdminRequest) ProtoMessage() {}
+
+func (x *ListProductAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductAdminRequest.ProtoReflect.Descriptor instead.
+func (*ListProductAdminRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListProductAdminRequest) GetPagination() *common.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListProductAdminRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *ListProductAdminRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListProductAdminResponseItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Stock         int64                  `protobuf:"varint,6,opt,name=stock,proto3" json:"stock,omitempty"`
+	Sku           string                 `protobuf:"bytes,7,opt,name=sku,proto3" json:"sku,omitempty"`
+	SalePrice     *float64               `protobuf:"fixed64,8,opt,name=sale_price,json=salePrice,proto3,oneof" json:"sale_price,omitempty"`
+	SaleStartsAt  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=sale_starts_at,json=saleStartsAt,proto3" json:"sale_starts_at,omitempty"`
+	SaleEndsAt    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=sale_ends_at,json=saleEndsAt,proto3" json:"sale_ends_at,omitempty"`
+	Slug          string                 `protobuf:"bytes,11,opt,name=slug,proto3" json:"slug,omitempty"`
+	Status        string                 `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
+	PublishAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	Type          string                 `protobuf:"bytes,14,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductAdminResponseItem) Reset() {
+	*x = ListProductAdminResponseItem{}
+	mi := &file_product_product_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductAdminResponseItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductAdminResponseItem) ProtoMessage() {}
+
+func (x *ListProductAdminResponseItem) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductAdminResponseItem.ProtoReflect.Descriptor instead.
+func (*ListProductAdminResponseItem) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListProductAdminResponseItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListProductAdminResponseItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListProductAdminResponseItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ListProductAdminResponseItem) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ListProductAdminResponseItem) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *ListProductAdminResponseItem) GetStock() int64 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *ListProductAdminResponseItem) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *ListProductAdminResponseItem) GetSalePrice() float64 {
+	if x != nil && x.SalePrice != nil {
+		return *x.SalePrice
+	}
+	return 0
+}
+
+func (x *ListProductAdminResponseItem) GetSaleStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SaleStartsAt
+	}
+	return nil
+}
+
+func (x *ListProductAdminResponseItem) GetSaleEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SaleEndsAt
+	}
+	return nil
+}
+
+func (x *ListProductAdminResponseItem) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *ListProductAdminResponseItem) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListProductAdminResponseItem) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *ListProductAdminResponseItem) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type ListProductAdminResponse struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Base          *common.BaseResponse            `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Pagination    *common.PaginationResponse      `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Data          []*ListProductAdminResponseItem `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductAdminResponse) Reset() {
+	*x = ListProductAdminResponse{}
+	mi := &file_product_product_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductAdminResponse) ProtoMessage() {}
+
+func (x *ListProductAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductAdminResponse.ProtoReflect.Descriptor instead.
+func (*ListProductAdminResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListProductAdminResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListProductAdminResponse) GetPagination() *common.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListProductAdminResponse) GetData() []*ListProductAdminResponseItem {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type HighlightProductRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the collection to show, the best sellers are shown when it is empty or
+	// the collection is not running
+	CollectionKey string `protobuf:"bytes,1,opt,name=collection_key,json=collectionKey,proto3" json:"collection_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HighlightProductRequest) Reset() {
+	*x = HighlightProductRequest{}
+	mi := &file_product_product_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HighlightProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HighlightProductRequest) ProtoMessage() {}
+
+func (x *HighlightProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HighlightProductRequest.ProtoReflect.Descriptor instead.
+func (*HighlightProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *HighlightProductRequest) GetCollectionKey() string {
+	if x != nil {
+		return x.CollectionKey
+	}
+	return ""
+}
+
+type HighlightProductResponseItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	OriginalPrice float64                `protobuf:"fixed64,6,opt,name=original_price,json=originalPrice,proto3" json:"original_price,omitempty"`
+	Slug          string                 `protobuf:"bytes,7,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HighlightProductResponseItem) Reset() {
+	*x = HighlightProductResponseItem{}
+	mi := &file_product_product_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HighlightProductResponseItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HighlightProductResponseItem) ProtoMessage() {}
+
+func (x *HighlightProductResponseItem) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HighlightProductResponseItem.ProtoReflect.Descriptor instead.
+func (*HighlightProductResponseItem) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *HighlightProductResponseItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HighlightProductResponseItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HighlightProductResponseItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *HighlightProductResponseItem) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *HighlightProductResponseItem) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *HighlightProductResponseItem) GetOriginalPrice() float64 {
+	if x != nil {
+		return x.OriginalPrice
+	}
+	return 0
+}
+
+func (x *HighlightProductResponseItem) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type HighlightProductResponse struct {
+	state          protoimpl.MessageState          `protogen:"open.v1"`
+	Base           *common.BaseResponse            `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data           []*HighlightProductResponseItem `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	CollectionName string                          `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *HighlightProductResponse) Reset() {
+	*x = HighlightProductResponse{}
+	mi := &file_product_product_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HighlightProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HighlightProductResponse) ProtoMessage() {}
+
+func (x *HighlightProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HighlightProductResponse.ProtoReflect.Descriptor instead.
+func (*HighlightProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *HighlightProductResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *HighlightProductResponse) GetData() []*HighlightProductResponseItem {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *HighlightProductResponse) GetCollectionName() string {
+	if x != nil {
+		return x.CollectionName
+	}
+	return ""
+}
+
+type CreateProductVariantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Sku           string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Attributes    map[string]string      `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Price         *float64               `protobuf:"fixed64,4,opt,name=price,proto3,oneof" json:"price,omitempty"`
+	ImageFileName string                 `protobuf:"bytes,5,opt,name=image_file_name,json=imageFileName,proto3" json:"image_file_name,omitempty"`
+	Stock         int64                  `protobuf:"varint,6,opt,name=stock,proto3" json:"stock,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductVariantRequest) Reset() {
+	*x = CreateProductVariantRequest{}
+	mi := &file_product_product_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductVariantRequest) ProtoMessage() {}
+
+func (x *CreateProductVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductVariantRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductVariantRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreateProductVariantRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CreateProductVariantRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *CreateProductVariantRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *CreateProductVariantRequest) GetPrice() float64 {
+	if x != nil && x.Price != nil {
+		return *x.Price
+	}
+	return 0
+}
+
+func (x *CreateProductVariantRequest) GetImageFileName() string {
+	if x != nil {
+		return x.ImageFileName
+	}
+	return ""
+}
+
+func (x *CreateProductVariantRequest) GetStock() int64 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+type CreateProductVariantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductVariantResponse) Reset() {
+	*x = CreateProductVariantResponse{}
+	mi := &file_product_product_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductVariantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductVariantResponse) ProtoMessage() {}
+
+func (x *CreateProductVariantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductVariantResponse.ProtoReflect.Descriptor instead.
+func (*CreateProductVariantResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CreateProductVariantResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CreateProductVariantResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type EditProductVariantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sku           string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Attributes    map[string]string      `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Price         *float64               `protobuf:"fixed64,4,opt,name=price,proto3,oneof" json:"price,omitempty"`
+	ImageFileName string                 `protobuf:"bytes,5,opt,name=image_file_name,json=imageFileName,proto3" json:"image_file_name,omitempty"`
+	Stock         int64                  `protobuf:"varint,6,opt,name=stock,proto3" json:"stock,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditProductVariantRequest) Reset() {
+	*x = EditProductVariantRequest{}
+	mi := &file_product_product_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditProductVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditProductVariantRequest) ProtoMessage() {}
+
+func (x *EditProductVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditProductVariantRequest.ProtoReflect.Descriptor instead.
+func (*EditProductVariantRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *EditProductVariantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EditProductVariantRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *EditProductVariantRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *EditProductVariantRequest) GetPrice() float64 {
+	if x != nil && x.Price != nil {
+		return *x.Price
+	}
+	return 0
+}
+
+func (x *EditProductVariantRequest) GetImageFileName() string {
+	if x != nil {
+		return x.ImageFileName
+	}
+	return ""
+}
+
+func (x *EditProductVariantRequest) GetStock() int64 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+type EditProductVariantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditProductVariantResponse) Reset() {
+	*x = EditProductVariantResponse{}
+	mi := &file_product_product_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditProductVariantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditProductVariantResponse) ProtoMessage() {}
+
+func (x *EditProductVariantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditProductVariantResponse.ProtoReflect.Descriptor instead.
+func (*EditProductVariantResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *EditProductVariantResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *EditProductVariantResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteProductVariantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductVariantRequest) Reset() {
+	*x = DeleteProductVariantRequest{}
+	mi := &file_product_product_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductVariantRequest) ProtoMessage() {}
+
+func (x *DeleteProductVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductVariantRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductVariantRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DeleteProductVariantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteProductVariantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductVariantResponse) Reset() {
+	*x = DeleteProductVariantResponse{}
+	mi := &file_product_product_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductVariantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductVariantResponse) ProtoMessage() {}
+
+func (x *DeleteProductVariantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductVariantResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductVariantResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteProductVariantResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type AddProductImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ImageFileName string                 `protobuf:"bytes,2,opt,name=image_file_name,json=imageFileName,proto3" json:"image_file_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProductImageRequest) Reset() {
+	*x = AddProductImageRequest{}
+	mi := &file_product_product_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProductImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProductImageRequest) ProtoMessage() {}
+
+func (x *AddProductImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProductImageRequest.ProtoReflect.Descriptor instead.
+func (*AddProductImageRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AddProductImageRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *AddProductImageRequest) GetImageFileName() string {
+	if x != nil {
+		return x.ImageFileName
+	}
+	return ""
+}
+
+type AddProductImageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProductImageResponse) Reset() {
+	*x = AddProductImageResponse{}
+	mi := &file_product_product_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProductImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProductImageResponse) ProtoMessage() {}
+
+func (x *AddProductImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProductImageResponse.ProtoReflect.Descriptor instead.
+func (*AddProductImageResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AddProductImageResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *AddProductImageResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RemoveProductImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveProductImageRequest) Reset() {
+	*x = RemoveProductImageRequest{}
+	mi := &file_product_product_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveProductImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveProductImageRequest) ProtoMessage() {}
+
+func (x *RemoveProductImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveProductImageRequest.ProtoReflect.Descriptor instead.
+func (*RemoveProductImageRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RemoveProductImageRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RemoveProductImageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveProductImageResponse) Reset() {
+	*x = RemoveProductImageResponse{}
+	mi := &file_product_product_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveProductImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveProductImageResponse) ProtoMessage() {}
+
+func (x *RemoveProductImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveProductImageResponse.ProtoReflect.Descriptor instead.
+func (*RemoveProductImageResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RemoveProductImageResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type ReorderProductImagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ImageIds      []string               `protobuf:"bytes,2,rep,name=image_ids,json=imageIds,proto3" json:"image_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderProductImagesRequest) Reset() {
+	*x = ReorderProductImagesRequest{}
+	mi := &file_product_product_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderProductImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderProductImagesRequest) ProtoMessage() {}
+
+func (x *ReorderProductImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *CreateProductRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+// Deprecated: Use ReorderProductImagesRequest.ProtoReflect.Descriptor instead.
+func (*ReorderProductImagesRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *CreateProductRequest) GetPrice() float64 {
+func (x *ReorderProductImagesRequest) GetProductId() string {
 	if x != nil {
-		return x.Price
+		return x.ProductId
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateProductRequest) GetImageFileName() string {
+func (x *ReorderProductImagesRequest) GetImageIds() []string {
 	if x != nil {
-		return x.ImageFileName
+		return x.ImageIds
 	}
-	return ""
+	return nil
 }
 
-type CreateProductResponse struct {
+type ReorderProductImagesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateProductResponse) Reset() {
-	*x = CreateProductResponse{}
-	mi := &file_product_product_proto_msgTypes[1]
+func (x *ReorderProductImagesResponse) Reset() {
+	*x = ReorderProductImagesResponse{}
+	mi := &file_product_product_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProductResponse) String() string {
+func (x *ReorderProductImagesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProductResponse) ProtoMessage() {}
+func (*ReorderProductImagesResponse) ProtoMessage() {}
 
-func (x *CreateProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[1]
+func (x *ReorderProductImagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -124,47 +3143,40 @@ func (x *CreateProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProductResponse.ProtoReflect.Descriptor instead.
-func (*CreateProductResponse) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use ReorderProductImagesResponse.ProtoReflect.Descriptor instead.
+func (*ReorderProductImagesResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *CreateProductResponse) GetBase() *common.BaseResponse {
+func (x *ReorderProductImagesResponse) GetBase() *common.BaseResponse {
 	if x != nil {
 		return x.Base
 	}
 	return nil
 }
 
-func (x *CreateProductResponse) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-type DetailProductRequest struct {
+type SetPrimaryProductImageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DetailProductRequest) Reset() {
-	*x = DetailProductRequest{}
-	mi := &file_product_product_proto_msgTypes[2]
+func (x *SetPrimaryProductImageRequest) Reset() {
+	*x = SetPrimaryProductImageRequest{}
+	mi := &file_product_product_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DetailProductRequest) String() string {
+func (x *SetPrimaryProductImageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DetailProductRequest) ProtoMessage() {}
+func (*SetPrimaryProductImageRequest) ProtoMessage() {}
 
-func (x *DetailProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[2]
+func (x *SetPrimaryProductImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -175,45 +3187,40 @@ func (x *DetailProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DetailProductRequest.ProtoReflect.Descriptor instead.
-func (*DetailProductRequest) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use SetPrimaryProductImageRequest.ProtoReflect.Descriptor instead.
+func (*SetPrimaryProductImageRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *DetailProductRequest) GetId() string {
+func (x *SetPrimaryProductImageRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type DetailProductResponse struct {
+type SetPrimaryProductImageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
-	ImageUrl      string                 `protobuf:"bytes,6,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DetailProductResponse) Reset() {
-	*x = DetailProductResponse{}
-	mi := &file_product_product_proto_msgTypes[3]
+func (x *SetPrimaryProductImageResponse) Reset() {
+	*x = SetPrimaryProductImageResponse{}
+	mi := &file_product_product_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DetailProductResponse) String() string {
+func (x *SetPrimaryProductImageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DetailProductResponse) ProtoMessage() {}
+func (*SetPrimaryProductImageResponse) ProtoMessage() {}
 
-func (x *DetailProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[3]
+func (x *SetPrimaryProductImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -224,79 +3231,95 @@ func (x *DetailProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DetailProductResponse.ProtoReflect.Descriptor instead.
-func (*DetailProductResponse) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use SetPrimaryProductImageResponse.ProtoReflect.Descriptor instead.
+func (*SetPrimaryProductImageResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *DetailProductResponse) GetBase() *common.BaseResponse {
+func (x *SetPrimaryProductImageResponse) GetBase() *common.BaseResponse {
 	if x != nil {
 		return x.Base
 	}
 	return nil
 }
 
-func (x *DetailProductResponse) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+type ImportProductRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// a piece of the csv file, the chunks are joined in the order they arrive
+	Chunk []byte `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	// only read from the first message
+	DryRun        bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DetailProductResponse) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+func (x *ImportProductRequest) Reset() {
+	*x = ImportProductRequest{}
+	mi := &file_product_product_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *DetailProductResponse) GetDescription() string {
+func (x *ImportProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportProductRequest) ProtoMessage() {}
+
+func (x *ImportProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[42]
 	if x != nil {
-		return x.Description
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *DetailProductResponse) GetPrice() float64 {
+// Deprecated: Use ImportProductRequest.ProtoReflect.Descriptor instead.
+func (*ImportProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ImportProductRequest) GetChunk() []byte {
 	if x != nil {
-		return x.Price
+		return x.Chunk
 	}
-	return 0
+	return nil
 }
 
-func (x *DetailProductResponse) GetImageUrl() string {
+func (x *ImportProductRequest) GetDryRun() bool {
 	if x != nil {
-		return x.ImageUrl
+		return x.DryRun
 	}
-	return ""
+	return false
 }
 
-type EditProductRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
-	ImageFileName string                 `protobuf:"bytes,5,opt,name=image_file_name,json=imageFileName,proto3" json:"image_file_name,omitempty"`
+type ImportProductRowError struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Row           int32                     `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Errors        []*common.ValidationError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EditProductRequest) Reset() {
-	*x = EditProductRequest{}
-	mi := &file_product_product_proto_msgTypes[4]
+func (x *ImportProductRowError) Reset() {
+	*x = ImportProductRowError{}
+	mi := &file_product_product_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EditProductRequest) String() string {
+func (x *ImportProductRowError) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EditProductRequest) ProtoMessage() {}
+func (*ImportProductRowError) ProtoMessage() {}
 
-func (x *EditProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[4]
+func (x *ImportProductRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -307,69 +3330,52 @@ func (x *EditProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EditProductRequest.ProtoReflect.Descriptor instead.
-func (*EditProductRequest) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *EditProductRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *EditProductRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *EditProductRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+// Deprecated: Use ImportProductRowError.ProtoReflect.Descriptor instead.
+func (*ImportProductRowError) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *EditProductRequest) GetPrice() float64 {
+func (x *ImportProductRowError) GetRow() int32 {
 	if x != nil {
-		return x.Price
+		return x.Row
 	}
 	return 0
 }
 
-func (x *EditProductRequest) GetImageFileName() string {
+func (x *ImportProductRowError) GetErrors() []*common.ValidationError {
 	if x != nil {
-		return x.ImageFileName
+		return x.Errors
 	}
-	return ""
+	return nil
 }
 
-type EditProductResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+type ImportProductResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Base          *common.BaseResponse     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DryRun        bool                     `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	TotalRows     int32                    `protobuf:"varint,3,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	CreatedCount  int32                    `protobuf:"varint,4,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"`
+	UpdatedCount  int32                    `protobuf:"varint,5,opt,name=updated_count,json=updatedCount,proto3" json:"updated_count,omitempty"`
+	RowErrors     []*ImportProductRowError `protobuf:"bytes,6,rep,name=row_errors,json=rowErrors,proto3" json:"row_errors,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EditProductResponse) Reset() {
-	*x = EditProductResponse{}
-	mi := &file_product_product_proto_msgTypes[5]
+func (x *ImportProductResponse) Reset() {
+	*x = ImportProductResponse{}
+	mi := &file_product_product_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EditProductResponse) String() string {
+func (x *ImportProductResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EditProductResponse) ProtoMessage() {}
+func (*ImportProductResponse) ProtoMessage() {}
 
-func (x *EditProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[5]
+func (x *ImportProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -380,47 +3386,74 @@ func (x *EditProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EditProductResponse.ProtoReflect.Descriptor instead.
-func (*EditProductResponse) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ImportProductResponse.ProtoReflect.Descriptor instead.
+func (*ImportProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *EditProductResponse) GetBase() *common.BaseResponse {
+func (x *ImportProductResponse) GetBase() *common.BaseResponse {
 	if x != nil {
 		return x.Base
 	}
 	return nil
 }
 
-func (x *EditProductResponse) GetId() string {
+func (x *ImportProductResponse) GetDryRun() bool {
 	if x != nil {
-		return x.Id
+		return x.DryRun
 	}
-	return ""
+	return false
 }
 
-type DeleteProductRequest struct {
+func (x *ImportProductResponse) GetTotalRows() int32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *ImportProductResponse) GetCreatedCount() int32 {
+	if x != nil {
+		return x.CreatedCount
+	}
+	return 0
+}
+
+func (x *ImportProductResponse) GetUpdatedCount() int32 {
+	if x != nil {
+		return x.UpdatedCount
+	}
+	return 0
+}
+
+func (x *ImportProductResponse) GetRowErrors() []*ImportProductRowError {
+	if x != nil {
+		return x.RowErrors
+	}
+	return nil
+}
+
+type ExportProductRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteProductRequest) Reset() {
-	*x = DeleteProductRequest{}
-	mi := &file_product_product_proto_msgTypes[6]
+func (x *ExportProductRequest) Reset() {
+	*x = ExportProductRequest{}
+	mi := &file_product_product_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProductRequest) String() string {
+func (x *ExportProductRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProductRequest) ProtoMessage() {}
+func (*ExportProductRequest) ProtoMessage() {}
 
-func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[6]
+func (x *ExportProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -431,40 +3464,33 @@ func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
-func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *DeleteProductRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use ExportProductRequest.ProtoReflect.Descriptor instead.
+func (*ExportProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{45}
 }
 
-type DeleteProductResponse struct {
+type ExportProductResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Chunk         []byte                 `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteProductResponse) Reset() {
-	*x = DeleteProductResponse{}
-	mi := &file_product_product_proto_msgTypes[7]
+func (x *ExportProductResponse) Reset() {
+	*x = ExportProductResponse{}
+	mi := &file_product_product_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProductResponse) String() string {
+func (x *ExportProductResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProductResponse) ProtoMessage() {}
+func (*ExportProductResponse) ProtoMessage() {}
 
-func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[7]
+func (x *ExportProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -475,40 +3501,41 @@ func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProductResponse.ProtoReflect.Descriptor instead.
-func (*DeleteProductResponse) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ExportProductResponse.ProtoReflect.Descriptor instead.
+func (*ExportProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *DeleteProductResponse) GetBase() *common.BaseResponse {
+func (x *ExportProductResponse) GetChunk() []byte {
 	if x != nil {
-		return x.Base
+		return x.Chunk
 	}
 	return nil
 }
 
-type ListProductRequest struct {
+type ListProductPriceHistoryRequest struct {
 	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Pagination    *common.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	ProductId     string                    `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Pagination    *common.PaginationRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProductRequest) Reset() {
-	*x = ListProductRequest{}
-	mi := &file_product_product_proto_msgTypes[8]
+func (x *ListProductPriceHistoryRequest) Reset() {
+	*x = ListProductPriceHistoryRequest{}
+	mi := &file_product_product_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductRequest) String() string {
+func (x *ListProductPriceHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductRequest) ProtoMessage() {}
+func (*ListProductPriceHistoryRequest) ProtoMessage() {}
 
-func (x *ListProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[8]
+func (x *ListProductPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -519,44 +3546,53 @@ func (x *ListProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductRequest.ProtoReflect.Descriptor instead.
-func (*ListProductRequest) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ListProductPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListProductPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *ListProductRequest) GetPagination() *common.PaginationRequest {
+func (x *ListProductPriceHistoryRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ListProductPriceHistoryRequest) GetPagination() *common.PaginationRequest {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-type ListProductResponseItem struct {
+type ListProductPriceHistoryResponseItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
-	ImageUrl      string                 `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Price         float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	SalePrice     *float64               `protobuf:"fixed64,3,opt,name=sale_price,json=salePrice,proto3,oneof" json:"sale_price,omitempty"`
+	SaleStartsAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=sale_starts_at,json=saleStartsAt,proto3" json:"sale_starts_at,omitempty"`
+	SaleEndsAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=sale_ends_at,json=saleEndsAt,proto3" json:"sale_ends_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProductResponseItem) Reset() {
-	*x = ListProductResponseItem{}
-	mi := &file_product_product_proto_msgTypes[9]
+func (x *ListProductPriceHistoryResponseItem) Reset() {
+	*x = ListProductPriceHistoryResponseItem{}
+	mi := &file_product_product_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductResponseItem) String() string {
+func (x *ListProductPriceHistoryResponseItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductResponseItem) ProtoMessage() {}
+func (*ListProductPriceHistoryResponseItem) ProtoMessage() {}
 
-func (x *ListProductResponseItem) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[9]
+func (x *ListProductPriceHistoryResponseItem) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -567,70 +3603,84 @@ func (x *ListProductResponseItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductResponseItem.ProtoReflect.Descriptor instead.
-func (*ListProductResponseItem) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ListProductPriceHistoryResponseItem.ProtoReflect.Descriptor instead.
+func (*ListProductPriceHistoryResponseItem) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListProductPriceHistoryResponseItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListProductPriceHistoryResponseItem) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
 }
 
-func (x *ListProductResponseItem) GetId() string {
-	if x != nil {
-		return x.Id
+func (x *ListProductPriceHistoryResponseItem) GetSalePrice() float64 {
+	if x != nil && x.SalePrice != nil {
+		return *x.SalePrice
 	}
-	return ""
+	return 0
 }
 
-func (x *ListProductResponseItem) GetName() string {
+func (x *ListProductPriceHistoryResponseItem) GetSaleStartsAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Name
+		return x.SaleStartsAt
 	}
-	return ""
+	return nil
 }
 
-func (x *ListProductResponseItem) GetDescription() string {
+func (x *ListProductPriceHistoryResponseItem) GetSaleEndsAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Description
+		return x.SaleEndsAt
 	}
-	return ""
+	return nil
 }
 
-func (x *ListProductResponseItem) GetPrice() float64 {
+func (x *ListProductPriceHistoryResponseItem) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Price
+		return x.CreatedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *ListProductResponseItem) GetImageUrl() string {
+func (x *ListProductPriceHistoryResponseItem) GetCreatedBy() string {
 	if x != nil {
-		return x.ImageUrl
+		return x.CreatedBy
 	}
 	return ""
 }
 
-type ListProductResponse struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	Base          *common.BaseResponse       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Pagination    *common.PaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	Data          []*ListProductResponseItem `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+type ListProductPriceHistoryResponse struct {
+	state         protoimpl.MessageState                 `protogen:"open.v1"`
+	Base          *common.BaseResponse                   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Pagination    *common.PaginationResponse             `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Items         []*ListProductPriceHistoryResponseItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProductResponse) Reset() {
-	*x = ListProductResponse{}
-	mi := &file_product_product_proto_msgTypes[10]
+func (x *ListProductPriceHistoryResponse) Reset() {
+	*x = ListProductPriceHistoryResponse{}
+	mi := &file_product_product_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductResponse) String() string {
+func (x *ListProductPriceHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductResponse) ProtoMessage() {}
+func (*ListProductPriceHistoryResponse) ProtoMessage() {}
 
-func (x *ListProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[10]
+func (x *ListProductPriceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -641,54 +3691,54 @@ func (x *ListProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductResponse.ProtoReflect.Descriptor instead.
-func (*ListProductResponse) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ListProductPriceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListProductPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *ListProductResponse) GetBase() *common.BaseResponse {
+func (x *ListProductPriceHistoryResponse) GetBase() *common.BaseResponse {
 	if x != nil {
 		return x.Base
 	}
 	return nil
 }
 
-func (x *ListProductResponse) GetPagination() *common.PaginationResponse {
+func (x *ListProductPriceHistoryResponse) GetPagination() *common.PaginationResponse {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-func (x *ListProductResponse) GetData() []*ListProductResponseItem {
+func (x *ListProductPriceHistoryResponse) GetItems() []*ListProductPriceHistoryResponseItem {
 	if x != nil {
-		return x.Data
+		return x.Items
 	}
 	return nil
 }
 
-type ListProductAdminRequest struct {
+type ListDeletedProductRequest struct {
 	state         protoimpl.MessageState    `protogen:"open.v1"`
 	Pagination    *common.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProductAdminRequest) Reset() {
-	*x = ListProductAdminRequest{}
-	mi := &file_product_product_proto_msgTypes[11]
+func (x *ListDeletedProductRequest) Reset() {
+	*x = ListDeletedProductRequest{}
+	mi := &file_product_product_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductAdminRequest) String() string {
+func (x *ListDeletedProductRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductAdminRequest) ProtoMessage() {}
+func (*ListDeletedProductRequest) ProtoMessage() {}
 
-func (x *ListProductAdminRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[11]
+func (x *ListDeletedProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -699,44 +3749,48 @@ func (x *ListProductAdminRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductAdminRequest.ProtoReflect.Descriptor instead.
-func (*ListProductAdminRequest) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ListDeletedProductRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *ListProductAdminRequest) GetPagination() *common.PaginationRequest {
+func (x *ListDeletedProductRequest) GetPagination() *common.PaginationRequest {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-type ListProductAdminResponseItem struct {
+type ListDeletedProductResponseItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
 	ImageUrl      string                 `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Stock         int64                  `protobuf:"varint,6,opt,name=stock,proto3" json:"stock,omitempty"`
+	Sku           string                 `protobuf:"bytes,7,opt,name=sku,proto3" json:"sku,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	DeletedBy     string                 `protobuf:"bytes,9,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProductAdminResponseItem) Reset() {
-	*x = ListProductAdminResponseItem{}
-	mi := &file_product_product_proto_msgTypes[12]
+func (x *ListDeletedProductResponseItem) Reset() {
+	*x = ListDeletedProductResponseItem{}
+	mi := &file_product_product_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductAdminResponseItem) String() string {
+func (x *ListDeletedProductResponseItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductAdminResponseItem) ProtoMessage() {}
+func (*ListDeletedProductResponseItem) ProtoMessage() {}
 
-func (x *ListProductAdminResponseItem) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[12]
+func (x *ListDeletedProductResponseItem) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -747,70 +3801,98 @@ func (x *ListProductAdminResponseItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductAdminResponseItem.ProtoReflect.Descriptor instead.
-func (*ListProductAdminResponseItem) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ListDeletedProductResponseItem.ProtoReflect.Descriptor instead.
+func (*ListDeletedProductResponseItem) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *ListProductAdminResponseItem) GetId() string {
+func (x *ListDeletedProductResponseItem) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *ListProductAdminResponseItem) GetName() string {
+func (x *ListDeletedProductResponseItem) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *ListProductAdminResponseItem) GetDescription() string {
+func (x *ListDeletedProductResponseItem) GetDescription() string {
 	if x != nil {
 		return x.Description
 	}
 	return ""
 }
 
-func (x *ListProductAdminResponseItem) GetPrice() float64 {
+func (x *ListDeletedProductResponseItem) GetPrice() float64 {
 	if x != nil {
 		return x.Price
 	}
 	return 0
 }
 
-func (x *ListProductAdminResponseItem) GetImageUrl() string {
+func (x *ListDeletedProductResponseItem) GetImageUrl() string {
 	if x != nil {
 		return x.ImageUrl
 	}
 	return ""
 }
 
-type ListProductAdminResponse struct {
-	state         protoimpl.MessageState          `protogen:"open.v1"`
-	Base          *common.BaseResponse            `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Pagination    *common.PaginationResponse      `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	Data          []*ListProductAdminResponseItem `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
+func (x *ListDeletedProductResponseItem) GetStock() int64 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *ListDeletedProductResponseItem) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *ListDeletedProductResponseItem) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *ListDeletedProductResponseItem) GetDeletedBy() string {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return ""
+}
+
+type ListDeletedProductResponse struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Base          *common.BaseResponse              `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Pagination    *common.PaginationResponse        `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Data          []*ListDeletedProductResponseItem `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProductAdminResponse) Reset() {
-	*x = ListProductAdminResponse{}
-	mi := &file_product_product_proto_msgTypes[13]
+func (x *ListDeletedProductResponse) Reset() {
+	*x = ListDeletedProductResponse{}
+	mi := &file_product_product_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductAdminResponse) String() string {
+func (x *ListDeletedProductResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductAdminResponse) ProtoMessage() {}
+func (*ListDeletedProductResponse) ProtoMessage() {}
 
-func (x *ListProductAdminResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[13]
+func (x *ListDeletedProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -821,53 +3903,54 @@ func (x *ListProductAdminResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductAdminResponse.ProtoReflect.Descriptor instead.
-func (*ListProductAdminResponse) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ListDeletedProductResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *ListProductAdminResponse) GetBase() *common.BaseResponse {
+func (x *ListDeletedProductResponse) GetBase() *common.BaseResponse {
 	if x != nil {
 		return x.Base
 	}
 	return nil
 }
 
-func (x *ListProductAdminResponse) GetPagination() *common.PaginationResponse {
+func (x *ListDeletedProductResponse) GetPagination() *common.PaginationResponse {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-func (x *ListProductAdminResponse) GetData() []*ListProductAdminResponseItem {
+func (x *ListDeletedProductResponse) GetData() []*ListDeletedProductResponseItem {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type HighlightProductRequest struct {
+type RestoreProductRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HighlightProductRequest) Reset() {
-	*x = HighlightProductRequest{}
-	mi := &file_product_product_proto_msgTypes[14]
+func (x *RestoreProductRequest) Reset() {
+	*x = RestoreProductRequest{}
+	mi := &file_product_product_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HighlightProductRequest) String() string {
+func (x *RestoreProductRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HighlightProductRequest) ProtoMessage() {}
+func (*RestoreProductRequest) ProtoMessage() {}
 
-func (x *HighlightProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[14]
+func (x *RestoreProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -878,37 +3961,40 @@ func (x *HighlightProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HighlightProductRequest.ProtoReflect.Descriptor instead.
-func (*HighlightProductRequest) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use RestoreProductRequest.ProtoReflect.Descriptor instead.
+func (*RestoreProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{53}
 }
 
-type HighlightProductResponseItem struct {
+func (x *RestoreProductRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RestoreProductResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
-	ImageUrl      string                 `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HighlightProductResponseItem) Reset() {
-	*x = HighlightProductResponseItem{}
-	mi := &file_product_product_proto_msgTypes[15]
+func (x *RestoreProductResponse) Reset() {
+	*x = RestoreProductResponse{}
+	mi := &file_product_product_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HighlightProductResponseItem) String() string {
+func (x *RestoreProductResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HighlightProductResponseItem) ProtoMessage() {}
+func (*RestoreProductResponse) ProtoMessage() {}
 
-func (x *HighlightProductResponseItem) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[15]
+func (x *RestoreProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -919,69 +4005,84 @@ func (x *HighlightProductResponseItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HighlightProductResponseItem.ProtoReflect.Descriptor instead.
-func (*HighlightProductResponseItem) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use RestoreProductResponse.ProtoReflect.Descriptor instead.
+func (*RestoreProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *HighlightProductResponseItem) GetId() string {
+func (x *RestoreProductResponse) GetBase() *common.BaseResponse {
 	if x != nil {
-		return x.Id
+		return x.Base
 	}
-	return ""
+	return nil
 }
 
-func (x *HighlightProductResponseItem) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type PurgeProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HighlightProductResponseItem) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+func (x *PurgeProductRequest) Reset() {
+	*x = PurgeProductRequest{}
+	mi := &file_product_product_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *HighlightProductResponseItem) GetPrice() float64 {
+func (x *PurgeProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeProductRequest) ProtoMessage() {}
+
+func (x *PurgeProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[55]
 	if x != nil {
-		return x.Price
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *HighlightProductResponseItem) GetImageUrl() string {
+// Deprecated: Use PurgeProductRequest.ProtoReflect.Descriptor instead.
+func (*PurgeProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *PurgeProductRequest) GetId() string {
 	if x != nil {
-		return x.ImageUrl
+		return x.Id
 	}
 	return ""
 }
 
-type HighlightProductResponse struct {
-	state         protoimpl.MessageState          `protogen:"open.v1"`
-	Base          *common.BaseResponse            `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Data          []*HighlightProductResponseItem `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+type PurgeProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HighlightProductResponse) Reset() {
-	*x = HighlightProductResponse{}
-	mi := &file_product_product_proto_msgTypes[16]
+func (x *PurgeProductResponse) Reset() {
+	*x = PurgeProductResponse{}
+	mi := &file_product_product_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HighlightProductResponse) String() string {
+func (x *PurgeProductResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HighlightProductResponse) ProtoMessage() {}
+func (*PurgeProductResponse) ProtoMessage() {}
 
-func (x *HighlightProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_product_proto_msgTypes[16]
+func (x *PurgeProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_product_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -992,30 +4093,23 @@ func (x *HighlightProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HighlightProductResponse.ProtoReflect.Descriptor instead.
-func (*HighlightProductResponse) Descriptor() ([]byte, []int) {
-	return file_product_product_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use PurgeProductResponse.ProtoReflect.Descriptor instead.
+func (*PurgeProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_product_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *HighlightProductResponse) GetBase() *common.BaseResponse {
+func (x *PurgeProductResponse) GetBase() *common.BaseResponse {
 	if x != nil {
 		return x.Base
 	}
 	return nil
 }
 
-func (x *HighlightProductResponse) GetData() []*HighlightProductResponseItem {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
 var File_product_product_proto protoreflect.FileDescriptor
 
 const file_product_product_proto_rawDesc = "" +
 	"\n" +
-	"\x15product/product.proto\x12\aproduct\x1a\x1acommon/base_response.proto\x1a\x17common/pagination.proto\x1a\x1bbuf/validate/validate.proto\"\xbe\x01\n" +
+	"\x15product/product.proto\x12\aproduct\x1a\x1acommon/base_response.proto\x1a\x17common/pagination.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa8\t\n" +
 	"\x14CreateProductRequest\x12\x1e\n" +
 	"\x04name\x18\x01 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12,\n" +
@@ -1023,20 +4117,124 @@ const file_product_product_proto_rawDesc = "" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\vdescription\x12$\n" +
 	"\x05price\x18\x03 \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\x05price\x122\n" +
 	"\x0fimage_file_name\x18\x04 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\rimageFileName\"Q\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\rimageFileName\x12\x1d\n" +
+	"\x05stock\x18\x05 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x05stock\x12)\n" +
+	"\vcategory_id\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\n" +
+	"categoryId\x12\x19\n" +
+	"\x03sku\x18\a \x01(\tB\a\xbaH\x04r\x02\x18dR\x03sku\x122\n" +
+	"\n" +
+	"sale_price\x18\b \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00H\x00R\tsalePrice\x88\x01\x01\x12@\n" +
+	"\x0esale_starts_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\fsaleStartsAt\x12<\n" +
+	"\fsale_ends_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"saleEndsAt\x129\n" +
+	"\x04slug\x18\v \x01(\tB%\xbaH\"r \x18\xff\x012\x1b^([a-z0-9]+(-[a-z0-9]+)*)?$R\x04slug\x12%\n" +
+	"\tseo_title\x18\f \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\bseoTitle\x121\n" +
+	"\x0fseo_description\x18\r \x01(\tB\b\xbaH\x05r\x03\x18\xf4\x03R\x0eseoDescription\x12<\n" +
+	"\x06status\x18\x0e \x01(\tB$\xbaH!\xd8\x01\x02r\x1cR\x05draftR\tpublishedR\barchivedR\x06status\x129\n" +
+	"\n" +
+	"publish_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\x125\n" +
+	"\x04type\x18\x10 \x01(\tB!\xbaH\x1e\xd8\x01\x02r\x19R\x06simpleR\x06bundleR\adigitalR\x04type\x12N\n" +
+	"\fbundle_items\x18\x11 \x03(\v2!.product.ProductBundleItemRequestB\b\xbaH\x05\x92\x01\x02\x102R\vbundleItems\x124\n" +
+	"\x11digital_file_name\x18\x12 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\x0fdigitalFileName\x12O\n" +
+	"\n" +
+	"attributes\x18\x13 \x03(\v2%.product.ProductAttributeValueRequestB\b\xbaH\x05\x92\x01\x02\x10dR\n" +
+	"attributes\x12:\n" +
+	"\x12min_order_quantity\x18\x14 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x01R\x10minOrderQuantity\x88\x01\x01\x12:\n" +
+	"\x12max_order_quantity\x18\x15 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x02R\x10maxOrderQuantity\x88\x01\x01B\r\n" +
+	"\v_sale_priceB\x15\n" +
+	"\x13_min_order_quantityB\x15\n" +
+	"\x13_max_order_quantity\"o\n" +
+	"\x1cProductAttributeValueRequest\x12-\n" +
+	"\fattribute_id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\vattributeId\x12 \n" +
+	"\x05value\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x05value\"m\n" +
+	"\x18ProductBundleItemRequest\x12)\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\tproductId\x12&\n" +
+	"\bquantity\x18\x02 \x01(\x03B\n" +
+	"\xbaH\a\"\x05\x18\xe8\a \x00R\bquantity\"Q\n" +
 	"\x15CreateProductResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
-	"\x02id\x18\x02 \x01(\tR\x02id\"2\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"g\n" +
 	"\x14DetailProductRequest\x12\x1a\n" +
 	"\x02id\x18\x01 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\"\xba\x01\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\x123\n" +
+	"\bcurrency\x18\x02 \x01(\tB\x17\xbaH\x14\xd8\x01\x02r\x0fR\x03IDRR\x03MYRR\x03SGDR\bcurrency\"C\n" +
+	"\x1dDetailProductResponseCategory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xc6\x02\n" +
+	"\x1cDetailProductResponseVariant\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12U\n" +
+	"\n" +
+	"attributes\x18\x03 \x03(\v25.product.DetailProductResponseVariant.AttributesEntryR\n" +
+	"attributes\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x1b\n" +
+	"\timage_url\x18\x05 \x01(\tR\bimageUrl\x12\x14\n" +
+	"\x05stock\x18\x06 \x01(\x03R\x05stock\x12%\n" +
+	"\x0eoriginal_price\x18\a \x01(\x01R\roriginalPrice\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb7\x01\n" +
+	"\x1fDetailProductResponseBundleItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x03R\bquantity\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\x01R\x05price\x12\x1b\n" +
+	"\timage_url\x18\x06 \x01(\tR\bimageUrl\"n\n" +
+	"\x1aDetailProductResponseImage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\timage_url\x18\x02 \x01(\tR\bimageUrl\x12\x1d\n" +
+	"\n" +
+	"is_primary\x18\x03 \x01(\bR\tisPrimaryJ\x04\b\x04\x10\x05\"\x95\t\n" +
 	"\x15DetailProductResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x14\n" +
 	"\x05price\x18\x05 \x01(\x01R\x05price\x12\x1b\n" +
-	"\timage_url\x18\x06 \x01(\tR\bimageUrl\"\xd8\x01\n" +
+	"\timage_url\x18\x06 \x01(\tR\bimageUrl\x12\x14\n" +
+	"\x05stock\x18\a \x01(\x03R\x05stock\x12W\n" +
+	"\x13category_breadcrumb\x18\b \x03(\v2&.product.DetailProductResponseCategoryR\x12categoryBreadcrumb\x12A\n" +
+	"\bvariants\x18\t \x03(\v2%.product.DetailProductResponseVariantR\bvariants\x12;\n" +
+	"\x06images\x18\n" +
+	" \x03(\v2#.product.DetailProductResponseImageR\x06images\x12%\n" +
+	"\x0erating_average\x18\f \x01(\x01R\rratingAverage\x12!\n" +
+	"\freview_count\x18\r \x01(\x03R\vreviewCount\x12\x10\n" +
+	"\x03sku\x18\x0e \x01(\tR\x03sku\x12%\n" +
+	"\x0eoriginal_price\x18\x0f \x01(\x01R\roriginalPrice\x12\x1c\n" +
+	"\n" +
+	"is_on_sale\x18\x10 \x01(\bR\bisOnSale\x12<\n" +
+	"\fsale_ends_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"saleEndsAt\x12\x12\n" +
+	"\x04slug\x18\x12 \x01(\tR\x04slug\x12\x1b\n" +
+	"\tseo_title\x18\x13 \x01(\tR\bseoTitle\x12'\n" +
+	"\x0fseo_description\x18\x14 \x01(\tR\x0eseoDescription\x12\x16\n" +
+	"\x06status\x18\x15 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"publish_at\x18\x16 \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\x12\x1a\n" +
+	"\bcurrency\x18\x17 \x01(\tR\bcurrency\x12\x12\n" +
+	"\x04type\x18\x18 \x01(\tR\x04type\x12K\n" +
+	"\fbundle_items\x18\x19 \x03(\v2(.product.DetailProductResponseBundleItemR\vbundleItems\x12G\n" +
+	"\n" +
+	"attributes\x18\x1a \x03(\v2'.product.DetailProductResponseAttributeR\n" +
+	"attributes\x121\n" +
+	"\x12min_order_quantity\x18\x1b \x01(\x03H\x00R\x10minOrderQuantity\x88\x01\x01\x121\n" +
+	"\x12max_order_quantity\x18\x1c \x01(\x03H\x01R\x10maxOrderQuantity\x88\x01\x01B\x15\n" +
+	"\x13_min_order_quantityB\x15\n" +
+	"\x13_max_order_quantityJ\x04\b\v\x10\f\"\xa9\x01\n" +
+	"\x1eDetailProductResponseAttribute\x12!\n" +
+	"\fattribute_id\x18\x01 \x01(\tR\vattributeId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x12\n" +
+	"\x04unit\x18\x05 \x01(\tR\x04unit\x12\x14\n" +
+	"\x05value\x18\x06 \x01(\tR\x05value\"\xd3\t\n" +
 	"\x12EditProductRequest\x12\x1a\n" +
 	"\x02id\x18\x01 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\x12\x1e\n" +
@@ -1046,7 +4244,35 @@ const file_product_product_proto_rawDesc = "" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\vdescription\x12$\n" +
 	"\x05price\x18\x04 \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\x05price\x122\n" +
 	"\x0fimage_file_name\x18\x05 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\rimageFileName\"O\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\rimageFileName\x12\x1d\n" +
+	"\x05stock\x18\x06 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x05stock\x12)\n" +
+	"\vcategory_id\x18\a \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\n" +
+	"categoryId\x12\x19\n" +
+	"\x03sku\x18\b \x01(\tB\a\xbaH\x04r\x02\x18dR\x03sku\x122\n" +
+	"\n" +
+	"sale_price\x18\t \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00H\x00R\tsalePrice\x88\x01\x01\x12@\n" +
+	"\x0esale_starts_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\fsaleStartsAt\x12<\n" +
+	"\fsale_ends_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"saleEndsAt\x129\n" +
+	"\x04slug\x18\f \x01(\tB%\xbaH\"r \x18\xff\x012\x1b^([a-z0-9]+(-[a-z0-9]+)*)?$R\x04slug\x12%\n" +
+	"\tseo_title\x18\r \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\bseoTitle\x121\n" +
+	"\x0fseo_description\x18\x0e \x01(\tB\b\xbaH\x05r\x03\x18\xf4\x03R\x0eseoDescription\x12<\n" +
+	"\x06status\x18\x0f \x01(\tB$\xbaH!\xd8\x01\x02r\x1cR\x05draftR\tpublishedR\barchivedR\x06status\x129\n" +
+	"\n" +
+	"publish_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\x12N\n" +
+	"\fbundle_items\x18\x11 \x03(\v2!.product.ProductBundleItemRequestB\b\xbaH\x05\x92\x01\x02\x102R\vbundleItems\x124\n" +
+	"\x11digital_file_name\x18\x12 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\x0fdigitalFileName\x12O\n" +
+	"\n" +
+	"attributes\x18\x13 \x03(\v2%.product.ProductAttributeValueRequestB\b\xbaH\x05\x92\x01\x02\x10dR\n" +
+	"attributes\x12:\n" +
+	"\x12min_order_quantity\x18\x14 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x01R\x10minOrderQuantity\x88\x01\x01\x12:\n" +
+	"\x12max_order_quantity\x18\x15 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x02R\x10maxOrderQuantity\x88\x01\x01\x123\n" +
+	"\x0eprevious_stock\x18\x16 \x01(\x03B\a\xbaH\x04\"\x02(\x00H\x03R\rpreviousStock\x88\x01\x01B\r\n" +
+	"\v_sale_priceB\x15\n" +
+	"\x13_min_order_quantityB\x15\n" +
+	"\x13_max_order_quantityB\x11\n" +
+	"\x0f_previous_stock\"O\n" +
 	"\x13EditProductResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\tR\x02id\"2\n" +
@@ -1054,49 +4280,261 @@ const file_product_product_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\"A\n" +
 	"\x15DeleteProductResponse\x12(\n" +
-	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"O\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"\xa9\x03\n" +
 	"\x12ListProductRequest\x129\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2\x19.common.PaginationRequestR\n" +
-	"pagination\"\x92\x01\n" +
+	"pagination\x12)\n" +
+	"\vcategory_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\n" +
+	"categoryId\x12 \n" +
+	"\x06search\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\x06search\x123\n" +
+	"\bcurrency\x18\x04 \x01(\tB\x17\xbaH\x14\xd8\x01\x02r\x0fR\x03IDRR\x03MYRR\x03SGDR\bcurrency\x12V\n" +
+	"\x11attribute_filters\x18\x05 \x03(\v2\x1f.product.ProductAttributeFilterB\b\xbaH\x05\x92\x01\x02\x10\x14R\x10attributeFilters\x120\n" +
+	"\tmin_price\x18\x06 \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00H\x00R\bminPrice\x88\x01\x01\x120\n" +
+	"\tmax_price\x18\a \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00H\x01R\bmaxPrice\x88\x01\x01B\f\n" +
+	"\n" +
+	"_min_priceB\f\n" +
+	"\n" +
+	"_max_price\"\xa0\x01\n" +
+	"\x16ProductAttributeFilter\x12\x1d\n" +
+	"\x04code\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x04code\x12)\n" +
+	"\x06values\x18\x02 \x03(\tB\x11\xbaH\x0e\x92\x01\v\x102\"\ar\x05\x10\x01\x18\xff\x01R\x06values\x12\x15\n" +
+	"\x03min\x18\x03 \x01(\x01H\x00R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\x04 \x01(\x01H\x01R\x03max\x88\x01\x01B\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_max\"\xdf\x02\n" +
 	"\x17ListProductResponseItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
 	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x1b\n" +
-	"\timage_url\x18\x05 \x01(\tR\bimageUrl\"\xb1\x01\n" +
+	"\timage_url\x18\x05 \x01(\tR\bimageUrl\x12\x14\n" +
+	"\x05stock\x18\x06 \x01(\x03R\x05stock\x12%\n" +
+	"\x0erating_average\x18\a \x01(\x01R\rratingAverage\x12!\n" +
+	"\freview_count\x18\b \x01(\x03R\vreviewCount\x12%\n" +
+	"\x0eoriginal_price\x18\t \x01(\x01R\roriginalPrice\x12\x1c\n" +
+	"\n" +
+	"is_on_sale\x18\n" +
+	" \x01(\bR\bisOnSale\x12\x12\n" +
+	"\x04slug\x18\v \x01(\tR\x04slug\x12\x12\n" +
+	"\x04type\x18\f \x01(\tR\x04type\"\xd1\x02\n" +
 	"\x13ListProductResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12:\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2\x1a.common.PaginationResponseR\n" +
 	"pagination\x124\n" +
-	"\x04data\x18\x03 \x03(\v2 .product.ListProductResponseItemR\x04data\"T\n" +
+	"\x04data\x18\x03 \x03(\v2 .product.ListProductResponseItemR\x04data\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x129\n" +
+	"\x06facets\x18\x05 \x03(\v2!.product.ListProductResponseFacetR\x06facets\x12G\n" +
+	"\vprice_range\x18\x06 \x01(\v2&.product.ListProductResponsePriceRangeR\n" +
+	"priceRange\"\xfe\x01\n" +
+	"\x18ListProductResponseFacet\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x12\n" +
+	"\x04unit\x18\x04 \x01(\tR\x04unit\x12\x14\n" +
+	"\x05count\x18\x05 \x01(\x03R\x05count\x12>\n" +
+	"\x06values\x18\x06 \x03(\v2&.product.ListProductResponseFacetValueR\x06values\x12\x15\n" +
+	"\x03min\x18\a \x01(\x01H\x00R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\b \x01(\x01H\x01R\x03max\x88\x01\x01B\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_max\"K\n" +
+	"\x1dListProductResponseFacetValue\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"C\n" +
+	"\x1dListProductResponsePriceRange\x12\x10\n" +
+	"\x03min\x18\x01 \x01(\x01R\x03min\x12\x10\n" +
+	"\x03max\x18\x02 \x01(\x01R\x03max\"\xbd\x01\n" +
 	"\x17ListProductAdminRequest\x129\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2\x19.common.PaginationRequestR\n" +
-	"pagination\"\x97\x01\n" +
+	"pagination\x12)\n" +
+	"\vcategory_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\n" +
+	"categoryId\x12<\n" +
+	"\x06status\x18\x03 \x01(\tB$\xbaH!\xd8\x01\x02r\x1cR\x05draftR\tpublishedR\barchivedR\x06status\"\xed\x03\n" +
 	"\x1cListProductAdminResponseItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
 	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x1b\n" +
-	"\timage_url\x18\x05 \x01(\tR\bimageUrl\"\xbb\x01\n" +
+	"\timage_url\x18\x05 \x01(\tR\bimageUrl\x12\x14\n" +
+	"\x05stock\x18\x06 \x01(\x03R\x05stock\x12\x10\n" +
+	"\x03sku\x18\a \x01(\tR\x03sku\x12\"\n" +
+	"\n" +
+	"sale_price\x18\b \x01(\x01H\x00R\tsalePrice\x88\x01\x01\x12@\n" +
+	"\x0esale_starts_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\fsaleStartsAt\x12<\n" +
+	"\fsale_ends_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"saleEndsAt\x12\x12\n" +
+	"\x04slug\x18\v \x01(\tR\x04slug\x12\x16\n" +
+	"\x06status\x18\f \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"publish_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\x12\x12\n" +
+	"\x04type\x18\x0e \x01(\tR\x04typeB\r\n" +
+	"\v_sale_price\"\xbb\x01\n" +
 	"\x18ListProductAdminResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12:\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2\x1a.common.PaginationResponseR\n" +
 	"pagination\x129\n" +
-	"\x04data\x18\x03 \x03(\v2%.product.ListProductAdminResponseItemR\x04data\"\x19\n" +
-	"\x17HighlightProductRequest\"\x97\x01\n" +
+	"\x04data\x18\x03 \x03(\v2%.product.ListProductAdminResponseItemR\x04data\"I\n" +
+	"\x17HighlightProductRequest\x12.\n" +
+	"\x0ecollection_key\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x18dR\rcollectionKey\"\xd2\x01\n" +
 	"\x1cHighlightProductResponseItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
 	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x1b\n" +
-	"\timage_url\x18\x05 \x01(\tR\bimageUrl\"\x7f\n" +
+	"\timage_url\x18\x05 \x01(\tR\bimageUrl\x12%\n" +
+	"\x0eoriginal_price\x18\x06 \x01(\x01R\roriginalPrice\x12\x12\n" +
+	"\x04slug\x18\a \x01(\tR\x04slug\"\xa8\x01\n" +
 	"\x18HighlightProductResponse\x12(\n" +
 	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x129\n" +
-	"\x04data\x18\x02 \x03(\v2%.product.HighlightProductResponseItemR\x04data2\xc7\x04\n" +
+	"\x04data\x18\x02 \x03(\v2%.product.HighlightProductResponseItemR\x04data\x12'\n" +
+	"\x0fcollection_name\x18\x03 \x01(\tR\x0ecollectionName\"\x8a\x03\n" +
+	"\x1bCreateProductVariantRequest\x12)\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\tproductId\x12\x1b\n" +
+	"\x03sku\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x03sku\x12^\n" +
+	"\n" +
+	"attributes\x18\x03 \x03(\v24.product.CreateProductVariantRequest.AttributesEntryB\b\xbaH\x05\x9a\x01\x02\b\x01R\n" +
+	"attributes\x12)\n" +
+	"\x05price\x18\x04 \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00H\x00R\x05price\x88\x01\x01\x120\n" +
+	"\x0fimage_file_name\x18\x05 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\rimageFileName\x12\x1d\n" +
+	"\x05stock\x18\x06 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x05stock\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\b\n" +
+	"\x06_price\"X\n" +
+	"\x1cCreateProductVariantResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\xf7\x02\n" +
+	"\x19EditProductVariantRequest\x12\x1a\n" +
+	"\x02id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\x12\x1b\n" +
+	"\x03sku\x18\x02 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18dR\x03sku\x12\\\n" +
+	"\n" +
+	"attributes\x18\x03 \x03(\v22.product.EditProductVariantRequest.AttributesEntryB\b\xbaH\x05\x9a\x01\x02\b\x01R\n" +
+	"attributes\x12)\n" +
+	"\x05price\x18\x04 \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00H\x00R\x05price\x88\x01\x01\x120\n" +
+	"\x0fimage_file_name\x18\x05 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\rimageFileName\x12\x1d\n" +
+	"\x05stock\x18\x06 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x05stock\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\b\n" +
+	"\x06_price\"V\n" +
+	"\x1aEditProductVariantResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"9\n" +
+	"\x1bDeleteProductVariantRequest\x12\x1a\n" +
+	"\x02id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\"H\n" +
+	"\x1cDeleteProductVariantResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"w\n" +
+	"\x16AddProductImageRequest\x12)\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\tproductId\x122\n" +
+	"\x0fimage_file_name\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\rimageFileName\"S\n" +
+	"\x17AddProductImageResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"7\n" +
+	"\x19RemoveProductImageRequest\x12\x1a\n" +
+	"\x02id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\"F\n" +
+	"\x1aRemoveProductImageResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"q\n" +
+	"\x1bReorderProductImagesRequest\x12)\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\tproductId\x12'\n" +
+	"\timage_ids\x18\x02 \x03(\tB\n" +
+	"\xbaH\a\x92\x01\x04\b\x01\x18\x01R\bimageIds\"H\n" +
+	"\x1cReorderProductImagesResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\";\n" +
+	"\x1dSetPrimaryProductImageRequest\x12\x1a\n" +
+	"\x02id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\"J\n" +
+	"\x1eSetPrimaryProductImageResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"E\n" +
+	"\x14ImportProductRequest\x12\x14\n" +
+	"\x05chunk\x18\x01 \x01(\fR\x05chunk\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"Z\n" +
+	"\x15ImportProductRowError\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12/\n" +
+	"\x06errors\x18\x02 \x03(\v2\x17.common.ValidationErrorR\x06errors\"\x82\x02\n" +
+	"\x15ImportProductResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\x03 \x01(\x05R\ttotalRows\x12#\n" +
+	"\rcreated_count\x18\x04 \x01(\x05R\fcreatedCount\x12#\n" +
+	"\rupdated_count\x18\x05 \x01(\x05R\fupdatedCount\x12=\n" +
+	"\n" +
+	"row_errors\x18\x06 \x03(\v2\x1e.product.ImportProductRowErrorR\trowErrors\"\x16\n" +
+	"\x14ExportProductRequest\"-\n" +
+	"\x15ExportProductResponse\x12\x14\n" +
+	"\x05chunk\x18\x01 \x01(\fR\x05chunk\"\x86\x01\n" +
+	"\x1eListProductPriceHistoryRequest\x12)\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\tproductId\x129\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x19.common.PaginationRequestR\n" +
+	"pagination\"\xd8\x02\n" +
+	"#ListProductPriceHistoryResponseItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\x01R\x05price\x12\"\n" +
+	"\n" +
+	"sale_price\x18\x03 \x01(\x01H\x00R\tsalePrice\x88\x01\x01\x12@\n" +
+	"\x0esale_starts_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\fsaleStartsAt\x12<\n" +
+	"\fsale_ends_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"saleEndsAt\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\a \x01(\tR\tcreatedByB\r\n" +
+	"\v_sale_price\"\xcb\x01\n" +
+	"\x1fListProductPriceHistoryResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12:\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1a.common.PaginationResponseR\n" +
+	"pagination\x12B\n" +
+	"\x05items\x18\x03 \x03(\v2,.product.ListProductPriceHistoryResponseItemR\x05items\"V\n" +
+	"\x19ListDeletedProductRequest\x129\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x19.common.PaginationRequestR\n" +
+	"pagination\"\x9b\x02\n" +
+	"\x1eListDeletedProductResponseItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x1b\n" +
+	"\timage_url\x18\x05 \x01(\tR\bimageUrl\x12\x14\n" +
+	"\x05stock\x18\x06 \x01(\x03R\x05stock\x12\x10\n" +
+	"\x03sku\x18\a \x01(\tR\x03sku\x129\n" +
+	"\n" +
+	"deleted_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\t \x01(\tR\tdeletedBy\"\xbf\x01\n" +
+	"\x1aListDeletedProductResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12:\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1a.common.PaginationResponseR\n" +
+	"pagination\x12;\n" +
+	"\x04data\x18\x03 \x03(\v2'.product.ListDeletedProductResponseItemR\x04data\"3\n" +
+	"\x15RestoreProductRequest\x12\x1a\n" +
+	"\x02id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\"B\n" +
+	"\x16RestoreProductResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"1\n" +
+	"\x13PurgeProductRequest\x12\x1a\n" +
+	"\x02id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\"@\n" +
+	"\x14PurgeProductResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base2\x86\x0e\n" +
 	"\x0eProductService\x12N\n" +
 	"\rCreateProduct\x12\x1d.product.CreateProductRequest\x1a\x1e.product.CreateProductResponse\x12N\n" +
 	"\rDetailProduct\x12\x1d.product.DetailProductRequest\x1a\x1e.product.DetailProductResponse\x12H\n" +
@@ -1104,7 +4542,20 @@ const file_product_product_proto_rawDesc = "" +
 	"\rDeleteProduct\x12\x1d.product.DeleteProductRequest\x1a\x1e.product.DeleteProductResponse\x12H\n" +
 	"\vListProduct\x12\x1b.product.ListProductRequest\x1a\x1c.product.ListProductResponse\x12W\n" +
 	"\x10ListProductAdmin\x12 .product.ListProductAdminRequest\x1a!.product.ListProductAdminResponse\x12X\n" +
-	"\x11HighlightProducts\x12 .product.HighlightProductRequest\x1a!.product.HighlightProductResponseB3Z1github.com/xryar/golang-grpc-ecommerce/pb/productb\x06proto3"
+	"\x11HighlightProducts\x12 .product.HighlightProductRequest\x1a!.product.HighlightProductResponse\x12c\n" +
+	"\x14CreateProductVariant\x12$.product.CreateProductVariantRequest\x1a%.product.CreateProductVariantResponse\x12]\n" +
+	"\x12EditProductVariant\x12\".product.EditProductVariantRequest\x1a#.product.EditProductVariantResponse\x12c\n" +
+	"\x14DeleteProductVariant\x12$.product.DeleteProductVariantRequest\x1a%.product.DeleteProductVariantResponse\x12T\n" +
+	"\x0fAddProductImage\x12\x1f.product.AddProductImageRequest\x1a .product.AddProductImageResponse\x12]\n" +
+	"\x12RemoveProductImage\x12\".product.RemoveProductImageRequest\x1a#.product.RemoveProductImageResponse\x12c\n" +
+	"\x14ReorderProductImages\x12$.product.ReorderProductImagesRequest\x1a%.product.ReorderProductImagesResponse\x12i\n" +
+	"\x16SetPrimaryProductImage\x12&.product.SetPrimaryProductImageRequest\x1a'.product.SetPrimaryProductImageResponse\x12P\n" +
+	"\rImportProduct\x12\x1d.product.ImportProductRequest\x1a\x1e.product.ImportProductResponse(\x01\x12P\n" +
+	"\rExportProduct\x12\x1d.product.ExportProductRequest\x1a\x1e.product.ExportProductResponse0\x01\x12l\n" +
+	"\x17ListProductPriceHistory\x12'.product.ListProductPriceHistoryRequest\x1a(.product.ListProductPriceHistoryResponse\x12]\n" +
+	"\x12ListDeletedProduct\x12\".product.ListDeletedProductRequest\x1a#.product.ListDeletedProductResponse\x12Q\n" +
+	"\x0eRestoreProduct\x12\x1e.product.RestoreProductRequest\x1a\x1f.product.RestoreProductResponse\x12K\n" +
+	"\fPurgeProduct\x12\x1c.product.PurgeProductRequest\x1a\x1d.product.PurgeProductResponseB3Z1github.com/xryar/golang-grpc-ecommerce/pb/productb\x06proto3"
 
 var (
 	file_product_product_proto_rawDescOnce sync.Once
@@ -1118,63 +4569,185 @@ func file_product_product_proto_rawDescGZIP() []byte {
 	return file_product_product_proto_rawDescData
 }
 
-var file_product_product_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_product_product_proto_msgTypes = make([]protoimpl.MessageInfo, 60)
 var file_product_product_proto_goTypes = []any{
-	(*CreateProductRequest)(nil),         // 0: product.CreateProductRequest
-	(*CreateProductResponse)(nil),        // 1: product.CreateProductResponse
-	(*DetailProductRequest)(nil),         // 2: product.DetailProductRequest
-	(*DetailProductResponse)(nil),        // 3: product.DetailProductResponse
-	(*EditProductRequest)(nil),           // 4: product.EditProductRequest
-	(*EditProductResponse)(nil),          // 5: product.EditProductResponse
-	(*DeleteProductRequest)(nil),         // 6: product.DeleteProductRequest
-	(*DeleteProductResponse)(nil),        // 7: product.DeleteProductResponse
-	(*ListProductRequest)(nil),           // 8: product.ListProductRequest
-	(*ListProductResponseItem)(nil),      // 9: product.ListProductResponseItem
-	(*ListProductResponse)(nil),          // 10: product.ListProductResponse
-	(*ListProductAdminRequest)(nil),      // 11: product.ListProductAdminRequest
-	(*ListProductAdminResponseItem)(nil), // 12: product.ListProductAdminResponseItem
-	(*ListProductAdminResponse)(nil),     // 13: product.ListProductAdminResponse
-	(*HighlightProductRequest)(nil),      // 14: product.HighlightProductRequest
-	(*HighlightProductResponseItem)(nil), // 15: product.HighlightProductResponseItem
-	(*HighlightProductResponse)(nil),     // 16: product.HighlightProductResponse
-	(*common.BaseResponse)(nil),          // 17: common.BaseResponse
-	(*common.PaginationRequest)(nil),     // 18: common.PaginationRequest
-	(*common.PaginationResponse)(nil),    // 19: common.PaginationResponse
+	(*CreateProductRequest)(nil),                // 0: product.CreateProductRequest
+	(*ProductAttributeValueRequest)(nil),        // 1: product.ProductAttributeValueRequest
+	(*ProductBundleItemRequest)(nil),            // 2: product.ProductBundleItemRequest
+	(*CreateProductResponse)(nil),               // 3: product.CreateProductResponse
+	(*DetailProductRequest)(nil),                // 4: product.DetailProductRequest
+	(*DetailProductResponseCategory)(nil),       // 5: product.DetailProductResponseCategory
+	(*DetailProductResponseVariant)(nil),        // 6: product.DetailProductResponseVariant
+	(*DetailProductResponseBundleItem)(nil),     // 7: product.DetailProductResponseBundleItem
+	(*DetailProductResponseImage)(nil),          // 8: product.DetailProductResponseImage
+	(*DetailProductResponse)(nil),               // 9: product.DetailProductResponse
+	(*DetailProductResponseAttribute)(nil),      // 10: product.DetailProductResponseAttribute
+	(*EditProductRequest)(nil),                  // 11: product.EditProductRequest
+	(*EditProductResponse)(nil),                 // 12: product.EditProductResponse
+	(*DeleteProductRequest)(nil),                // 13: product.DeleteProductRequest
+	(*DeleteProductResponse)(nil),               // 14: product.DeleteProductResponse
+	(*ListProductRequest)(nil),                  // 15: product.ListProductRequest
+	(*ProductAttributeFilter)(nil),              // 16: product.ProductAttributeFilter
+	(*ListProductResponseItem)(nil),             // 17: product.ListProductResponseItem
+	(*ListProductResponse)(nil),                 // 18: product.ListProductResponse
+	(*ListProductResponseFacet)(nil),            // 19: product.ListProductResponseFacet
+	(*ListProductResponseFacetValue)(nil),       // 20: product.ListProductResponseFacetValue
+	(*ListProductResponsePriceRange)(nil),       // 21: product.ListProductResponsePriceRange
+	(*ListProductAdminRequest)(nil),             // 22: product.ListProductAdminRequest
+	(*ListProductAdminResponseItem)(nil),        // 23: product.ListProductAdminResponseItem
+	(*ListProductAdminResponse)(nil),            // 24: product.ListProductAdminResponse
+	(*HighlightProductRequest)(nil),             // 25: product.HighlightProductRequest
+	(*HighlightProductResponseItem)(nil),        // 26: product.HighlightProductResponseItem
+	(*HighlightProductResponse)(nil),            // 27: product.HighlightProductResponse
+	(*CreateProductVariantRequest)(nil),         // 28: product.CreateProductVariantRequest
+	(*CreateProductVariantResponse)(nil),        // 29: product.CreateProductVariantResponse
+	(*EditProductVariantRequest)(nil),           // 30: product.EditProductVariantRequest
+	(*EditProductVariantResponse)(nil),          // 31: product.EditProductVariantResponse
+	(*DeleteProductVariantRequest)(nil),         // 32: product.DeleteProductVariantRequest
+	(*DeleteProductVariantResponse)(nil),        // 33: product.DeleteProductVariantResponse
+	(*AddProductImageRequest)(nil),              // 34: product.AddProductImageRequest
+	(*AddProductImageResponse)(nil),             // 35: product.AddProductImageResponse
+	(*RemoveProductImageRequest)(nil),           // 36: product.RemoveProductImageRequest
+	(*RemoveProductImageResponse)(nil),          // 37: product.RemoveProductImageResponse
+	(*ReorderProductImagesRequest)(nil),         // 38: product.ReorderProductImagesRequest
+	(*ReorderProductImagesResponse)(nil),        // 39: product.ReorderProductImagesResponse
+	(*SetPrimaryProductImageRequest)(nil),       // 40: product.SetPrimaryProductImageRequest
+	(*SetPrimaryProductImageResponse)(nil),      // 41: product.SetPrimaryProductImageResponse
+	(*ImportProductRequest)(nil),                // 42: product.ImportProductRequest
+	(*ImportProductRowError)(nil),               // 43: product.ImportProductRowError
+	(*ImportProductResponse)(nil),               // 44: product.ImportProductResponse
+	(*ExportProductRequest)(nil),                // 45: product.ExportProductRequest
+	(*ExportProductResponse)(nil),               // 46: product.ExportProductResponse
+	(*ListProductPriceHistoryRequest)(nil),      // 47: product.ListProductPriceHistoryRequest
+	(*ListProductPriceHistoryResponseItem)(nil), // 48: product.ListProductPriceHistoryResponseItem
+	(*ListProductPriceHistoryResponse)(nil),     // 49: product.ListProductPriceHistoryResponse
+	(*ListDeletedProductRequest)(nil),           // 50: product.ListDeletedProductRequest
+	(*ListDeletedProductResponseItem)(nil),      // 51: product.ListDeletedProductResponseItem
+	(*ListDeletedProductResponse)(nil),          // 52: product.ListDeletedProductResponse
+	(*RestoreProductRequest)(nil),               // 53: product.RestoreProductRequest
+	(*RestoreProductResponse)(nil),              // 54: product.RestoreProductResponse
+	(*PurgeProductRequest)(nil),                 // 55: product.PurgeProductRequest
+	(*PurgeProductResponse)(nil),                // 56: product.PurgeProductResponse
+	nil,                                         // 57: product.DetailProductResponseVariant.AttributesEntry
+	nil,                                         // 58: product.CreateProductVariantRequest.AttributesEntry
+	nil,                                         // 59: product.EditProductVariantRequest.AttributesEntry
+	(*timestamppb.Timestamp)(nil),               // 60: google.protobuf.Timestamp
+	(*common.BaseResponse)(nil),                 // 61: common.BaseResponse
+	(*common.PaginationRequest)(nil),            // 62: common.PaginationRequest
+	(*common.PaginationResponse)(nil),           // 63: common.PaginationResponse
+	(*common.ValidationError)(nil),              // 64: common.ValidationError
 }
 var file_product_product_proto_depIdxs = []int32{
-	17, // 0: product.CreateProductResponse.base:type_name -> common.BaseResponse
-	17, // 1: product.DetailProductResponse.base:type_name -> common.BaseResponse
-	17, // 2: product.EditProductResponse.base:type_name -> common.BaseResponse
-	17, // 3: product.DeleteProductResponse.base:type_name -> common.BaseResponse
-	18, // 4: product.ListProductRequest.pagination:type_name -> common.PaginationRequest
-	17, // 5: product.ListProductResponse.base:type_name -> common.BaseResponse
-	19, // 6: product.ListProductResponse.pagination:type_name -> common.PaginationResponse
-	9,  // 7: product.ListProductResponse.data:type_name -> product.ListProductResponseItem
-	18, // 8: product.ListProductAdminRequest.pagination:type_name -> common.PaginationRequest
-	17, // 9: product.ListProductAdminResponse.base:type_name -> common.BaseResponse
-	19, // 10: product.ListProductAdminResponse.pagination:type_name -> common.PaginationResponse
-	12, // 11: product.ListProductAdminResponse.data:type_name -> product.ListProductAdminResponseItem
-	17, // 12: product.HighlightProductResponse.base:type_name -> common.BaseResponse
-	15, // 13: product.HighlightProductResponse.data:type_name -> product.HighlightProductResponseItem
-	0,  // 14: product.ProductService.CreateProduct:input_type -> product.CreateProductRequest
-	2,  // 15: product.ProductService.DetailProduct:input_type -> product.DetailProductRequest
-	4,  // 16: product.ProductService.EditProduct:input_type -> product.EditProductRequest
-	6,  // 17: product.ProductService.DeleteProduct:input_type -> product.DeleteProductRequest
-	8,  // 18: product.ProductService.ListProduct:input_type -> product.ListProductRequest
-	11, // 19: product.ProductService.ListProductAdmin:input_type -> product.ListProductAdminRequest
-	14, // 20: product.ProductService.HighlightProducts:input_type -> product.HighlightProductRequest
-	1,  // 21: product.ProductService.CreateProduct:output_type -> product.CreateProductResponse
-	3,  // 22: product.ProductService.DetailProduct:output_type -> product.DetailProductResponse
-	5,  // 23: product.ProductService.EditProduct:output_type -> product.EditProductResponse
-	7,  // 24: product.ProductService.DeleteProduct:output_type -> product.DeleteProductResponse
-	10, // 25: product.ProductService.ListProduct:output_type -> product.ListProductResponse
-	13, // 26: product.ProductService.ListProductAdmin:output_type -> product.ListProductAdminResponse
-	16, // 27: product.ProductService.HighlightProducts:output_type -> product.HighlightProductResponse
-	21, // [21:28] is the sub-list for method output_type
-	14, // [14:21] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	60, // 0: product.CreateProductRequest.sale_starts_at:type_name -> google.protobuf.Timestamp
+	60, // 1: product.CreateProductRequest.sale_ends_at:type_name -> google.protobuf.Timestamp
+	60, // 2: product.CreateProductRequest.publish_at:type_name -> google.protobuf.Timestamp
+	2,  // 3: product.CreateProductRequest.bundle_items:type_name -> product.ProductBundleItemRequest
+	1,  // 4: product.CreateProductRequest.attributes:type_name -> product.ProductAttributeValueRequest
+	61, // 5: product.CreateProductResponse.base:type_name -> common.BaseResponse
+	57, // 6: product.DetailProductResponseVariant.attributes:type_name -> product.DetailProductResponseVariant.AttributesEntry
+	61, // 7: product.DetailProductResponse.base:type_name -> common.BaseResponse
+	5,  // 8: product.DetailProductResponse.category_breadcrumb:type_name -> product.DetailProductResponseCategory
+	6,  // 9: product.DetailProductResponse.variants:type_name -> product.DetailProductResponseVariant
+	8,  // 10: product.DetailProductResponse.images:type_name -> product.DetailProductResponseImage
+	60, // 11: product.DetailProductResponse.sale_ends_at:type_name -> google.protobuf.Timestamp
+	60, // 12: product.DetailProductResponse.publish_at:type_name -> google.protobuf.Timestamp
+	7,  // 13: product.DetailProductResponse.bundle_items:type_name -> product.DetailProductResponseBundleItem
+	10, // 14: product.DetailProductResponse.attributes:type_name -> product.DetailProductResponseAttribute
+	60, // 15: product.EditProductRequest.sale_starts_at:type_name -> google.protobuf.Timestamp
+	60, // 16: product.EditProductRequest.sale_ends_at:type_name -> google.protobuf.Timestamp
+	60, // 17: product.EditProductRequest.publish_at:type_name -> google.protobuf.Timestamp
+	2,  // 18: product.EditProductRequest.bundle_items:type_name -> product.ProductBundleItemRequest
+	1,  // 19: product.EditProductRequest.attributes:type_name -> product.ProductAttributeValueRequest
+	61, // 20: product.EditProductResponse.base:type_name -> common.BaseResponse
+	61, // 21: product.DeleteProductResponse.base:type_name -> common.BaseResponse
+	62, // 22: product.ListProductRequest.pagination:type_name -> common.PaginationRequest
+	16, // 23: product.ListProductRequest.attribute_filters:type_name -> product.ProductAttributeFilter
+	61, // 24: product.ListProductResponse.base:type_name -> common.BaseResponse
+	63, // 25: product.ListProductResponse.pagination:type_name -> common.PaginationResponse
+	17, // 26: product.ListProductResponse.data:type_name -> product.ListProductResponseItem
+	19, // 27: product.ListProductResponse.facets:type_name -> product.ListProductResponseFacet
+	21, // 28: product.ListProductResponse.price_range:type_name -> product.ListProductResponsePriceRange
+	20, // 29: product.ListProductResponseFacet.values:type_name -> product.ListProductResponseFacetValue
+	62, // 30: product.ListProductAdminRequest.pagination:type_name -> common.PaginationRequest
+	60, // 31: product.ListProductAdminResponseItem.sale_starts_at:type_name -> google.protobuf.Timestamp
+	60, // 32: product.ListProductAdminResponseItem.sale_ends_at:type_name -> google.protobuf.Timestamp
+	60, // 33: product.ListProductAdminResponseItem.publish_at:type_name -> google.protobuf.Timestamp
+	61, // 34: product.ListProductAdminResponse.base:type_name -> common.BaseResponse
+	63, // 35: product.ListProductAdminResponse.pagination:type_name -> common.PaginationResponse
+	23, // 36: product.ListProductAdminResponse.data:type_name -> product.ListProductAdminResponseItem
+	61, // 37: product.HighlightProductResponse.base:type_name -> common.BaseResponse
+	26, // 38: product.HighlightProductResponse.data:type_name -> product.HighlightProductResponseItem
+	58, // 39: product.CreateProductVariantRequest.attributes:type_name -> product.CreateProductVariantRequest.AttributesEntry
+	61, // 40: product.CreateProductVariantResponse.base:type_name -> common.BaseResponse
+	59, // 41: product.EditProductVariantRequest.attributes:type_name -> product.EditProductVariantRequest.AttributesEntry
+	61, // 42: product.EditProductVariantResponse.base:type_name -> common.BaseResponse
+	61, // 43: product.DeleteProductVariantResponse.base:type_name -> common.BaseResponse
+	61, // 44: product.AddProductImageResponse.base:type_name -> common.BaseResponse
+	61, // 45: product.RemoveProductImageResponse.base:type_name -> common.BaseResponse
+	61, // 46: product.ReorderProductImagesResponse.base:type_name -> common.BaseResponse
+	61, // 47: product.SetPrimaryProductImageResponse.base:type_name -> common.BaseResponse
+	64, // 48: product.ImportProductRowError.errors:type_name -> common.ValidationError
+	61, // 49: product.ImportProductResponse.base:type_name -> common.BaseResponse
+	43, // 50: product.ImportProductResponse.row_errors:type_name -> product.ImportProductRowError
+	62, // 51: product.ListProductPriceHistoryRequest.pagination:type_name -> common.PaginationRequest
+	60, // 52: product.ListProductPriceHistoryResponseItem.sale_starts_at:type_name -> google.protobuf.Timestamp
+	60, // 53: product.ListProductPriceHistoryResponseItem.sale_ends_at:type_name -> google.protobuf.Timestamp
+	60, // 54: product.ListProductPriceHistoryResponseItem.created_at:type_name -> google.protobuf.Timestamp
+	61, // 55: product.ListProductPriceHistoryResponse.base:type_name -> common.BaseResponse
+	63, // 56: product.ListProductPriceHistoryResponse.pagination:type_name -> common.PaginationResponse
+	48, // 57: product.ListProductPriceHistoryResponse.items:type_name -> product.ListProductPriceHistoryResponseItem
+	62, // 58: product.ListDeletedProductRequest.pagination:type_name -> common.PaginationRequest
+	60, // 59: product.ListDeletedProductResponseItem.deleted_at:type_name -> google.protobuf.Timestamp
+	61, // 60: product.ListDeletedProductResponse.base:type_name -> common.BaseResponse
+	63, // 61: product.ListDeletedProductResponse.pagination:type_name -> common.PaginationResponse
+	51, // 62: product.ListDeletedProductResponse.data:type_name -> product.ListDeletedProductResponseItem
+	61, // 63: product.RestoreProductResponse.base:type_name -> common.BaseResponse
+	61, // 64: product.PurgeProductResponse.base:type_name -> common.BaseResponse
+	0,  // 65: product.ProductService.CreateProduct:input_type -> product.CreateProductRequest
+	4,  // 66: product.ProductService.DetailProduct:input_type -> product.DetailProductRequest
+	11, // 67: product.ProductService.EditProduct:input_type -> product.EditProductRequest
+	13, // 68: product.ProductService.DeleteProduct:input_type -> product.DeleteProductRequest
+	15, // 69: product.ProductService.ListProduct:input_type -> product.ListProductRequest
+	22, // 70: product.ProductService.ListProductAdmin:input_type -> product.ListProductAdminRequest
+	25, // 71: product.ProductService.HighlightProducts:input_type -> product.HighlightProductRequest
+	28, // 72: product.ProductService.CreateProductVariant:input_type -> product.CreateProductVariantRequest
+	30, // 73: product.ProductService.EditProductVariant:input_type -> product.EditProductVariantRequest
+	32, // 74: product.ProductService.DeleteProductVariant:input_type -> product.DeleteProductVariantRequest
+	34, // 75: product.ProductService.AddProductImage:input_type -> product.AddProductImageRequest
+	36, // 76: product.ProductService.RemoveProductImage:input_type -> product.RemoveProductImageRequest
+	38, // 77: product.ProductService.ReorderProductImages:input_type -> product.ReorderProductImagesRequest
+	40, // 78: product.ProductService.SetPrimaryProductImage:input_type -> product.SetPrimaryProductImageRequest
+	42, // 79: product.ProductService.ImportProduct:input_type -> product.ImportProductRequest
+	45, // 80: product.ProductService.ExportProduct:input_type -> product.ExportProductRequest
+	47, // 81: product.ProductService.ListProductPriceHistory:input_type -> product.ListProductPriceHistoryRequest
+	50, // 82: product.ProductService.ListDeletedProduct:input_type -> product.ListDeletedProductRequest
+	53, // 83: product.ProductService.RestoreProduct:input_type -> product.RestoreProductRequest
+	55, // 84: product.ProductService.PurgeProduct:input_type -> product.PurgeProductRequest
+	3,  // 85: product.ProductService.CreateProduct:output_type -> product.CreateProductResponse
+	9,  // 86: product.ProductService.DetailProduct:output_type -> product.DetailProductResponse
+	12, // 87: product.ProductService.EditProduct:output_type -> product.EditProductResponse
+	14, // 88: product.ProductService.DeleteProduct:output_type -> product.DeleteProductResponse
+	18, // 89: product.ProductService.ListProduct:output_type -> product.ListProductResponse
+	24, // 90: product.ProductService.ListProductAdmin:output_type -> product.ListProductAdminResponse
+	27, // 91: product.ProductService.HighlightProducts:output_type -> product.HighlightProductResponse
+	29, // 92: product.ProductService.CreateProductVariant:output_type -> product.CreateProductVariantResponse
+	31, // 93: product.ProductService.EditProductVariant:output_type -> product.EditProductVariantResponse
+	33, // 94: product.ProductService.DeleteProductVariant:output_type -> product.DeleteProductVariantResponse
+	35, // 95: product.ProductService.AddProductImage:output_type -> product.AddProductImageResponse
+	37, // 96: product.ProductService.RemoveProductImage:output_type -> product.RemoveProductImageResponse
+	39, // 97: product.ProductService.ReorderProductImages:output_type -> product.ReorderProductImagesResponse
+	41, // 98: product.ProductService.SetPrimaryProductImage:output_type -> product.SetPrimaryProductImageResponse
+	44, // 99: product.ProductService.ImportProduct:output_type -> product.ImportProductResponse
+	46, // 100: product.ProductService.ExportProduct:output_type -> product.ExportProductResponse
+	49, // 101: product.ProductService.ListProductPriceHistory:output_type -> product.ListProductPriceHistoryResponse
+	52, // 102: product.ProductService.ListDeletedProduct:output_type -> product.ListDeletedProductResponse
+	54, // 103: product.ProductService.RestoreProduct:output_type -> product.RestoreProductResponse
+	56, // 104: product.ProductService.PurgeProduct:output_type -> product.PurgeProductResponse
+	85, // [85:105] is the sub-list for method output_type
+	65, // [65:85] is the sub-list for method input_type
+	65, // [65:65] is the sub-list for extension type_name
+	65, // [65:65] is the sub-list for extension extendee
+	0,  // [0:65] is the sub-list for field type_name
 }
 
 func init() { file_product_product_proto_init() }
@@ -1182,13 +4755,23 @@ func file_product_product_proto_init() {
 	if File_product_product_proto != nil {
 		return
 	}
+	file_product_product_proto_msgTypes[0].OneofWrappers = []any{}
+	file_product_product_proto_msgTypes[9].OneofWrappers = []any{}
+	file_product_product_proto_msgTypes[11].OneofWrappers = []any{}
+	file_product_product_proto_msgTypes[15].OneofWrappers = []any{}
+	file_product_product_proto_msgTypes[16].OneofWrappers = []any{}
+	file_product_product_proto_msgTypes[19].OneofWrappers = []any{}
+	file_product_product_proto_msgTypes[23].OneofWrappers = []any{}
+	file_product_product_proto_msgTypes[28].OneofWrappers = []any{}
+	file_product_product_proto_msgTypes[30].OneofWrappers = []any{}
+	file_product_product_proto_msgTypes[48].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_product_product_proto_rawDesc), len(file_product_product_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   60,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,13 +19,26 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ProductService_CreateProduct_FullMethodName     = "/product.ProductService/CreateProduct"
-	ProductService_DetailProduct_FullMethodName     = "/product.ProductService/DetailProduct"
-	ProductService_EditProduct_FullMethodName       = "/product.ProductService/EditProduct"
-	ProductService_DeleteProduct_FullMethodName     = "/product.ProductService/DeleteProduct"
-	ProductService_ListProduct_FullMethodName       = "/product.ProductService/ListProduct"
-	ProductService_ListProductAdmin_FullMethodName  = "/product.ProductService/ListProductAdmin"
-	ProductService_HighlightProducts_FullMethodName = "/product.ProductService/HighlightProducts"
+	ProductService_CreateProduct_FullMethodName           = "/product.ProductService/CreateProduct"
+	ProductService_DetailProduct_FullMethodName           = "/product.ProductService/DetailProduct"
+	ProductService_EditProduct_FullMethodName             = "/product.ProductService/EditProduct"
+	ProductService_DeleteProduct_FullMethodName           = "/product.ProductService/DeleteProduct"
+	ProductService_ListProduct_FullMethodName             = "/product.ProductService/ListProduct"
+	ProductService_ListProductAdmin_FullMethodName        = "/product.ProductService/ListProductAdmin"
+	ProductService_HighlightProducts_FullMethodName       = "/product.ProductService/HighlightProducts"
+	ProductService_CreateProductVariant_FullMethodName    = "/product.ProductService/CreateProductVariant"
+	ProductService_EditProductVariant_FullMethodName      = "/product.ProductService/EditProductVariant"
+	ProductService_DeleteProductVariant_FullMethodName    = "/product.ProductService/DeleteProductVariant"
+	ProductService_AddProductImage_FullMethodName         = "/product.ProductService/AddProductImage"
+	ProductService_RemoveProductImage_FullMethodName      = "/product.ProductService/RemoveProductImage"
+	ProductService_ReorderProductImages_FullMethodName    = "/product.ProductService/ReorderProductImages"
+	ProductService_SetPrimaryProductImage_FullMethodName  = "/product.ProductService/SetPrimaryProductImage"
+	ProductService_ImportProduct_FullMethodName           = "/product.ProductService/ImportProduct"
+	ProductService_ExportProduct_FullMethodName           = "/product.ProductService/ExportProduct"
+	ProductService_ListProductPriceHistory_FullMethodName = "/product.ProductService/ListProductPriceHistory"
+	ProductService_ListDeletedProduct_FullMethodName      = "/product.ProductService/ListDeletedProduct"
+	ProductService_RestoreProduct_FullMethodName          = "/product.ProductService/RestoreProduct"
+	ProductService_PurgeProduct_FullMethodName            = "/product.ProductService/PurgeProduct"
 )
 
 // ProductServiceClient is the client API for ProductService service.
@@ -39,6 +52,19 @@ type ProductServiceClient interface {
 	ListProduct(ctx context.Context, in *ListProductRequest, opts ...grpc.CallOption) (*ListProductResponse, error)
 	ListProductAdmin(ctx context.Context, in *ListProductAdminRequest, opts ...grpc.CallOption) (*ListProductAdminResponse, error)
 	HighlightProducts(ctx context.Context, in *HighlightProductRequest, opts ...grpc.CallOption) (*HighlightProductResponse, error)
+	CreateProductVariant(ctx context.Context, in *CreateProductVariantRequest, opts ...grpc.CallOption) (*CreateProductVariantResponse, error)
+	EditProductVariant(ctx context.Context, in *EditProductVariantRequest, opts ...grpc.CallOption) (*EditProductVariantResponse, error)
+	DeleteProductVariant(ctx context.Context, in *DeleteProductVariantRequest, opts ...grpc.CallOption) (*DeleteProductVariantResponse, error)
+	AddProductImage(ctx context.Context, in *AddProductImageRequest, opts ...grpc.CallOption) (*AddProductImageResponse, error)
+	RemoveProductImage(ctx context.Context, in *RemoveProductImageRequest, opts ...grpc.CallOption) (*RemoveProductImageResponse, error)
+	ReorderProductImages(ctx context.Context, in *ReorderProductImagesRequest, opts ...grpc.CallOption) (*ReorderProductImagesResponse, error)
+	SetPrimaryProductImage(ctx context.Context, in *SetPrimaryProductImageRequest, opts ...grpc.CallOption) (*SetPrimaryProductImageResponse, error)
+	ImportProduct(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportProductRequest, ImportProductResponse], error)
+	ExportProduct(ctx context.Context, in *ExportProductRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportProductResponse], error)
+	ListProductPriceHistory(ctx context.Context, in *ListProductPriceHistoryRequest, opts ...grpc.CallOption) (*ListProductPriceHistoryResponse, error)
+	ListDeletedProduct(ctx context.Context, in *ListDeletedProductRequest, opts ...grpc.CallOption) (*ListDeletedProductResponse, error)
+	RestoreProduct(ctx context.Context, in *RestoreProductRequest, opts ...grpc.CallOption) (*RestoreProductResponse, error)
+	PurgeProduct(ctx context.Context, in *PurgeProductRequest, opts ...grpc.CallOption) (*PurgeProductResponse, error)
 }
 
 type productServiceClient struct {
@@ -119,6 +145,148 @@ func (c *productServiceClient) HighlightProducts(ctx context.Context, in *Highli
 	return out, nil
 }
 
+func (c *productServiceClient) CreateProductVariant(ctx context.Context, in *CreateProductVariantRequest, opts ...grpc.CallOption) (*CreateProductVariantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateProductVariantResponse)
+	err := c.cc.Invoke(ctx, ProductService_CreateProductVariant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) EditProductVariant(ctx context.Context, in *EditProductVariantRequest, opts ...grpc.CallOption) (*EditProductVariantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EditProductVariantResponse)
+	err := c.cc.Invoke(ctx, ProductService_EditProductVariant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteProductVariant(ctx context.Context, in *DeleteProductVariantRequest, opts ...grpc.CallOption) (*DeleteProductVariantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProductVariantResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteProductVariant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) AddProductImage(ctx context.Context, in *AddProductImageRequest, opts ...grpc.CallOption) (*AddProductImageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddProductImageResponse)
+	err := c.cc.Invoke(ctx, ProductService_AddProductImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) RemoveProductImage(ctx context.Context, in *RemoveProductImageRequest, opts ...grpc.CallOption) (*RemoveProductImageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveProductImageResponse)
+	err := c.cc.Invoke(ctx, ProductService_RemoveProductImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ReorderProductImages(ctx context.Context, in *ReorderProductImagesRequest, opts ...grpc.CallOption) (*ReorderProductImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderProductImagesResponse)
+	err := c.cc.Invoke(ctx, ProductService_ReorderProductImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetPrimaryProductImage(ctx context.Context, in *SetPrimaryProductImageRequest, opts ...grpc.CallOption) (*SetPrimaryProductImageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPrimaryProductImageResponse)
+	err := c.cc.Invoke(ctx, ProductService_SetPrimaryProductImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ImportProduct(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportProductRequest, ImportProductResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ProductService_ServiceDesc.Streams[0], ProductService_ImportProduct_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportProductRequest, ImportProductResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductService_ImportProductClient = grpc.ClientStreamingClient[ImportProductRequest, ImportProductResponse]
+
+func (c *productServiceClient) ExportProduct(ctx context.Context, in *ExportProductRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportProductResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ProductService_ServiceDesc.Streams[1], ProductService_ExportProduct_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportProductRequest, ExportProductResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductService_ExportProductClient = grpc.ServerStreamingClient[ExportProductResponse]
+
+func (c *productServiceClient) ListProductPriceHistory(ctx context.Context, in *ListProductPriceHistoryRequest, opts ...grpc.CallOption) (*ListProductPriceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProductPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListProductPriceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListDeletedProduct(ctx context.Context, in *ListDeletedProductRequest, opts ...grpc.CallOption) (*ListDeletedProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeletedProductResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListDeletedProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) RestoreProduct(ctx context.Context, in *RestoreProductRequest, opts ...grpc.CallOption) (*RestoreProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreProductResponse)
+	err := c.cc.Invoke(ctx, ProductService_RestoreProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) PurgeProduct(ctx context.Context, in *PurgeProductRequest, opts ...grpc.CallOption) (*PurgeProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeProductResponse)
+	err := c.cc.Invoke(ctx, ProductService_PurgeProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProductServiceServer is the server API for ProductService service.
 // All implementations must embed UnimplementedProductServiceServer
 // for forward compatibility.
@@ -130,6 +298,19 @@ type ProductServiceServer interface {
 	ListProduct(context.Context, *ListProductRequest) (*ListProductResponse, error)
 	ListProductAdmin(context.Context, *ListProductAdminRequest) (*ListProductAdminResponse, error)
 	HighlightProducts(context.Context, *HighlightProductRequest) (*HighlightProductResponse, error)
+	CreateProductVariant(context.Context, *CreateProductVariantRequest) (*CreateProductVariantResponse, error)
+	EditProductVariant(context.Context, *EditProductVariantRequest) (*EditProductVariantResponse, error)
+	DeleteProductVariant(context.Context, *DeleteProductVariantRequest) (*DeleteProductVariantResponse, error)
+	AddProductImage(context.Context, *AddProductImageRequest) (*AddProductImageResponse, error)
+	RemoveProductImage(context.Context, *RemoveProductImageRequest) (*RemoveProductImageResponse, error)
+	ReorderProductImages(context.Context, *ReorderProductImagesRequest) (*ReorderProductImagesResponse, error)
+	SetPrimaryProductImage(context.Context, *SetPrimaryProductImageRequest) (*SetPrimaryProductImageResponse, error)
+	ImportProduct(grpc.ClientStreamingServer[ImportProductRequest, ImportProductResponse]) error
+	ExportProduct(*ExportProductRequest, grpc.ServerStreamingServer[ExportProductResponse]) error
+	ListProductPriceHistory(context.Context, *ListProductPriceHistoryRequest) (*ListProductPriceHistoryResponse, error)
+	ListDeletedProduct(context.Context, *ListDeletedProductRequest) (*ListDeletedProductResponse, error)
+	RestoreProduct(context.Context, *RestoreProductRequest) (*RestoreProductResponse, error)
+	PurgeProduct(context.Context, *PurgeProductRequest) (*PurgeProductResponse, error)
 	mustEmbedUnimplementedProductServiceServer()
 }
 
@@ -161,6 +342,45 @@ func (UnimplementedProductServiceServer) ListProductAdmin(context.Context, *List
 func (UnimplementedProductServiceServer) HighlightProducts(context.Context, *HighlightProductRequest) (*HighlightProductResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HighlightProducts not implemented")
 }
+func (UnimplementedProductServiceServer) CreateProductVariant(context.Context, *CreateProductVariantRequest) (*CreateProductVariantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProductVariant not implemented")
+}
+func (UnimplementedProductServiceServer) EditProductVariant(context.Context, *EditProductVariantRequest) (*EditProductVariantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditProductVariant not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteProductVariant(context.Context, *DeleteProductVariantRequest) (*DeleteProductVariantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProductVariant not implemented")
+}
+func (UnimplementedProductServiceServer) AddProductImage(context.Context, *AddProductImageRequest) (*AddProductImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddProductImage not implemented")
+}
+func (UnimplementedProductServiceServer) RemoveProductImage(context.Context, *RemoveProductImageRequest) (*RemoveProductImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveProductImage not implemented")
+}
+func (UnimplementedProductServiceServer) ReorderProductImages(context.Context, *ReorderProductImagesRequest) (*ReorderProductImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderProductImages not implemented")
+}
+func (UnimplementedProductServiceServer) SetPrimaryProductImage(context.Context, *SetPrimaryProductImageRequest) (*SetPrimaryProductImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPrimaryProductImage not implemented")
+}
+func (UnimplementedProductServiceServer) ImportProduct(grpc.ClientStreamingServer[ImportProductRequest, ImportProductResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportProduct not implemented")
+}
+func (UnimplementedProductServiceServer) ExportProduct(*ExportProductRequest, grpc.ServerStreamingServer[ExportProductResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportProduct not implemented")
+}
+func (UnimplementedProductServiceServer) ListProductPriceHistory(context.Context, *ListProductPriceHistoryRequest) (*ListProductPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProductPriceHistory not implemented")
+}
+func (UnimplementedProductServiceServer) ListDeletedProduct(context.Context, *ListDeletedProductRequest) (*ListDeletedProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedProduct not implemented")
+}
+func (UnimplementedProductServiceServer) RestoreProduct(context.Context, *RestoreProductRequest) (*RestoreProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreProduct not implemented")
+}
+func (UnimplementedProductServiceServer) PurgeProduct(context.Context, *PurgeProductRequest) (*PurgeProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeProduct not implemented")
+}
 func (UnimplementedProductServiceServer) mustEmbedUnimplementedProductServiceServer() {}
 func (UnimplementedProductServiceServer) testEmbeddedByValue()                        {}
 
@@ -308,6 +528,222 @@ func _ProductService_HighlightProducts_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_CreateProductVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProductVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateProductVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateProductVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateProductVariant(ctx, req.(*CreateProductVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_EditProductVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditProductVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).EditProductVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_EditProductVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).EditProductVariant(ctx, req.(*EditProductVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteProductVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteProductVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteProductVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteProductVariant(ctx, req.(*DeleteProductVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_AddProductImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddProductImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).AddProductImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_AddProductImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).AddProductImage(ctx, req.(*AddProductImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_RemoveProductImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveProductImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).RemoveProductImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_RemoveProductImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).RemoveProductImage(ctx, req.(*RemoveProductImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ReorderProductImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderProductImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ReorderProductImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ReorderProductImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ReorderProductImages(ctx, req.(*ReorderProductImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetPrimaryProductImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPrimaryProductImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetPrimaryProductImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetPrimaryProductImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetPrimaryProductImage(ctx, req.(*SetPrimaryProductImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ImportProduct_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ProductServiceServer).ImportProduct(&grpc.GenericServerStream[ImportProductRequest, ImportProductResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductService_ImportProductServer = grpc.ClientStreamingServer[ImportProductRequest, ImportProductResponse]
+
+func _ProductService_ExportProduct_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportProductRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProductServiceServer).ExportProduct(m, &grpc.GenericServerStream[ExportProductRequest, ExportProductResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductService_ExportProductServer = grpc.ServerStreamingServer[ExportProductResponse]
+
+func _ProductService_ListProductPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListProductPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListProductPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListProductPriceHistory(ctx, req.(*ListProductPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListDeletedProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListDeletedProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListDeletedProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListDeletedProduct(ctx, req.(*ListDeletedProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_RestoreProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).RestoreProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_RestoreProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).RestoreProduct(ctx, req.(*RestoreProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_PurgeProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).PurgeProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_PurgeProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).PurgeProduct(ctx, req.(*PurgeProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProductService_ServiceDesc is the grpc.ServiceDesc for ProductService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -343,7 +779,62 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "HighlightProducts",
 			Handler:    _ProductService_HighlightProducts_Handler,
 		},
+		{
+			MethodName: "CreateProductVariant",
+			Handler:    _ProductService_CreateProductVariant_Handler,
+		},
+		{
+			MethodName: "EditProductVariant",
+			Handler:    _ProductService_EditProductVariant_Handler,
+		},
+		{
+			MethodName: "DeleteProductVariant",
+			Handler:    _ProductService_DeleteProductVariant_Handler,
+		},
+		{
+			MethodName: "AddProductImage",
+			Handler:    _ProductService_AddProductImage_Handler,
+		},
+		{
+			MethodName: "RemoveProductImage",
+			Handler:    _ProductService_RemoveProductImage_Handler,
+		},
+		{
+			MethodName: "ReorderProductImages",
+			Handler:    _ProductService_ReorderProductImages_Handler,
+		},
+		{
+			MethodName: "SetPrimaryProductImage",
+			Handler:    _ProductService_SetPrimaryProductImage_Handler,
+		},
+		{
+			MethodName: "ListProductPriceHistory",
+			Handler:    _ProductService_ListProductPriceHistory_Handler,
+		},
+		{
+			MethodName: "ListDeletedProduct",
+			Handler:    _ProductService_ListDeletedProduct_Handler,
+		},
+		{
+			MethodName: "RestoreProduct",
+			Handler:    _ProductService_RestoreProduct_Handler,
+		},
+		{
+			MethodName: "PurgeProduct",
+			Handler:    _ProductService_PurgeProduct_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportProduct",
+			Handler:       _ProductService_ImportProduct_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportProduct",
+			Handler:       _ProductService_ExportProduct_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "product/product.proto",
 }
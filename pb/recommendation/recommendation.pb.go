@@ -0,0 +1,400 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: recommendation/recommendation.proto
+
+package recommendation
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	common "github.com/xryar/golang-grpc-ecommerce/pb/common"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RecommendationItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	OriginalPrice float64                `protobuf:"fixed64,4,opt,name=original_price,json=originalPrice,proto3" json:"original_price,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	// how many paid orders contained this product together with the
+	// requested ones
+	OrderCount    int64 `protobuf:"varint,6,opt,name=order_count,json=orderCount,proto3" json:"order_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecommendationItem) Reset() {
+	*x = RecommendationItem{}
+	mi := &file_recommendation_recommendation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecommendationItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendationItem) ProtoMessage() {}
+
+func (x *RecommendationItem) ProtoReflect() protoreflect.Message {
+	mi := &file_recommendation_recommendation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendationItem.ProtoReflect.Descriptor instead.
+func (*RecommendationItem) Descriptor() ([]byte, []int) {
+	return file_recommendation_recommendation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RecommendationItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RecommendationItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RecommendationItem) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *RecommendationItem) GetOriginalPrice() float64 {
+	if x != nil {
+		return x.OriginalPrice
+	}
+	return 0
+}
+
+func (x *RecommendationItem) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *RecommendationItem) GetOrderCount() int64 {
+	if x != nil {
+		return x.OrderCount
+	}
+	return 0
+}
+
+type FrequentlyBoughtTogetherRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FrequentlyBoughtTogetherRequest) Reset() {
+	*x = FrequentlyBoughtTogetherRequest{}
+	mi := &file_recommendation_recommendation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FrequentlyBoughtTogetherRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrequentlyBoughtTogetherRequest) ProtoMessage() {}
+
+func (x *FrequentlyBoughtTogetherRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recommendation_recommendation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrequentlyBoughtTogetherRequest.ProtoReflect.Descriptor instead.
+func (*FrequentlyBoughtTogetherRequest) Descriptor() ([]byte, []int) {
+	return file_recommendation_recommendation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FrequentlyBoughtTogetherRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *FrequentlyBoughtTogetherRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type FrequentlyBoughtTogetherResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          []*RecommendationItem  `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FrequentlyBoughtTogetherResponse) Reset() {
+	*x = FrequentlyBoughtTogetherResponse{}
+	mi := &file_recommendation_recommendation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FrequentlyBoughtTogetherResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrequentlyBoughtTogetherResponse) ProtoMessage() {}
+
+func (x *FrequentlyBoughtTogetherResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_recommendation_recommendation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrequentlyBoughtTogetherResponse.ProtoReflect.Descriptor instead.
+func (*FrequentlyBoughtTogetherResponse) Descriptor() ([]byte, []int) {
+	return file_recommendation_recommendation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FrequentlyBoughtTogetherResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *FrequentlyBoughtTogetherResponse) GetData() []*RecommendationItem {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CartRecommendationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartRecommendationRequest) Reset() {
+	*x = CartRecommendationRequest{}
+	mi := &file_recommendation_recommendation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartRecommendationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartRecommendationRequest) ProtoMessage() {}
+
+func (x *CartRecommendationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recommendation_recommendation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartRecommendationRequest.ProtoReflect.Descriptor instead.
+func (*CartRecommendationRequest) Descriptor() ([]byte, []int) {
+	return file_recommendation_recommendation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CartRecommendationRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type CartRecommendationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Data          []*RecommendationItem  `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartRecommendationResponse) Reset() {
+	*x = CartRecommendationResponse{}
+	mi := &file_recommendation_recommendation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartRecommendationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartRecommendationResponse) ProtoMessage() {}
+
+func (x *CartRecommendationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_recommendation_recommendation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartRecommendationResponse.ProtoReflect.Descriptor instead.
+func (*CartRecommendationResponse) Descriptor() ([]byte, []int) {
+	return file_recommendation_recommendation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CartRecommendationResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CartRecommendationResponse) GetData() []*RecommendationItem {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_recommendation_recommendation_proto protoreflect.FileDescriptor
+
+const file_recommendation_recommendation_proto_rawDesc = "" +
+	"\n" +
+	"#recommendation/recommendation.proto\x12\x0erecommendation\x1a\x1acommon/base_response.proto\x1a\x1bbuf/validate/validate.proto\"\xb3\x01\n" +
+	"\x12RecommendationItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x01R\x05price\x12%\n" +
+	"\x0eoriginal_price\x18\x04 \x01(\x01R\roriginalPrice\x12\x1b\n" +
+	"\timage_url\x18\x05 \x01(\tR\bimageUrl\x12\x1f\n" +
+	"\vorder_count\x18\x06 \x01(\x03R\n" +
+	"orderCount\"k\n" +
+	"\x1fFrequentlyBoughtTogetherRequest\x12'\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tproductId\x12\x1f\n" +
+	"\x05limit\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18\x14(\x00R\x05limit\"\x84\x01\n" +
+	" FrequentlyBoughtTogetherResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x126\n" +
+	"\x04data\x18\x02 \x03(\v2\".recommendation.RecommendationItemR\x04data\"<\n" +
+	"\x19CartRecommendationRequest\x12\x1f\n" +
+	"\x05limit\x18\x01 \x01(\x05B\t\xbaH\x06\x1a\x04\x18\x14(\x00R\x05limit\"~\n" +
+	"\x1aCartRecommendationResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x126\n" +
+	"\x04data\x18\x02 \x03(\v2\".recommendation.RecommendationItemR\x04data2\x83\x02\n" +
+	"\x15RecommendationService\x12}\n" +
+	"\x18FrequentlyBoughtTogether\x12/.recommendation.FrequentlyBoughtTogetherRequest\x1a0.recommendation.FrequentlyBoughtTogetherResponse\x12k\n" +
+	"\x12CartRecommendation\x12).recommendation.CartRecommendationRequest\x1a*.recommendation.CartRecommendationResponseB:Z8github.com/xryar/golang-grpc-ecommerce/pb/recommendationb\x06proto3"
+
+var (
+	file_recommendation_recommendation_proto_rawDescOnce sync.Once
+	file_recommendation_recommendation_proto_rawDescData []byte
+)
+
+func file_recommendation_recommendation_proto_rawDescGZIP() []byte {
+	file_recommendation_recommendation_proto_rawDescOnce.Do(func() {
+		file_recommendation_recommendation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_recommendation_recommendation_proto_rawDesc), len(file_recommendation_recommendation_proto_rawDesc)))
+	})
+	return file_recommendation_recommendation_proto_rawDescData
+}
+
+var file_recommendation_recommendation_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_recommendation_recommendation_proto_goTypes = []any{
+	(*RecommendationItem)(nil),               // 0: recommendation.RecommendationItem
+	(*FrequentlyBoughtTogetherRequest)(nil),  // 1: recommendation.FrequentlyBoughtTogetherRequest
+	(*FrequentlyBoughtTogetherResponse)(nil), // 2: recommendation.FrequentlyBoughtTogetherResponse
+	(*CartRecommendationRequest)(nil),        // 3: recommendation.CartRecommendationRequest
+	(*CartRecommendationResponse)(nil),       // 4: recommendation.CartRecommendationResponse
+	(*common.BaseResponse)(nil),              // 5: common.BaseResponse
+}
+var file_recommendation_recommendation_proto_depIdxs = []int32{
+	5, // 0: recommendation.FrequentlyBoughtTogetherResponse.base:type_name -> common.BaseResponse
+	0, // 1: recommendation.FrequentlyBoughtTogetherResponse.data:type_name -> recommendation.RecommendationItem
+	5, // 2: recommendation.CartRecommendationResponse.base:type_name -> common.BaseResponse
+	0, // 3: recommendation.CartRecommendationResponse.data:type_name -> recommendation.RecommendationItem
+	1, // 4: recommendation.RecommendationService.FrequentlyBoughtTogether:input_type -> recommendation.FrequentlyBoughtTogetherRequest
+	3, // 5: recommendation.RecommendationService.CartRecommendation:input_type -> recommendation.CartRecommendationRequest
+	2, // 6: recommendation.RecommendationService.FrequentlyBoughtTogether:output_type -> recommendation.FrequentlyBoughtTogetherResponse
+	4, // 7: recommendation.RecommendationService.CartRecommendation:output_type -> recommendation.CartRecommendationResponse
+	6, // [6:8] is the sub-list for method output_type
+	4, // [4:6] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_recommendation_recommendation_proto_init() }
+func file_recommendation_recommendation_proto_init() {
+	if File_recommendation_recommendation_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_recommendation_recommendation_proto_rawDesc), len(file_recommendation_recommendation_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_recommendation_recommendation_proto_goTypes,
+		DependencyIndexes: file_recommendation_recommendation_proto_depIdxs,
+		MessageInfos:      file_recommendation_recommendation_proto_msgTypes,
+	}.Build()
+	File_recommendation_recommendation_proto = out.File
+	file_recommendation_recommendation_proto_goTypes = nil
+	file_recommendation_recommendation_proto_depIdxs = nil
+}
@@ -0,0 +1,677 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: wishlist/wishlist.proto
+
+package wishlist
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	common "github.com/xryar/golang-grpc-ecommerce/pb/common"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AddProductToWishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId     string                 `protobuf:"bytes,2,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProductToWishlistRequest) Reset() {
+	*x = AddProductToWishlistRequest{}
+	mi := &file_wishlist_wishlist_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProductToWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProductToWishlistRequest) ProtoMessage() {}
+
+func (x *AddProductToWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wishlist_wishlist_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProductToWishlistRequest.ProtoReflect.Descriptor instead.
+func (*AddProductToWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_wishlist_wishlist_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AddProductToWishlistRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *AddProductToWishlistRequest) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+type AddProductToWishlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProductToWishlistResponse) Reset() {
+	*x = AddProductToWishlistResponse{}
+	mi := &file_wishlist_wishlist_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProductToWishlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProductToWishlistResponse) ProtoMessage() {}
+
+func (x *AddProductToWishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wishlist_wishlist_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProductToWishlistResponse.ProtoReflect.Descriptor instead.
+func (*AddProductToWishlistResponse) Descriptor() ([]byte, []int) {
+	return file_wishlist_wishlist_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AddProductToWishlistResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *AddProductToWishlistResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListWishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWishlistRequest) Reset() {
+	*x = ListWishlistRequest{}
+	mi := &file_wishlist_wishlist_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWishlistRequest) ProtoMessage() {}
+
+func (x *ListWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wishlist_wishlist_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWishlistRequest.ProtoReflect.Descriptor instead.
+func (*ListWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_wishlist_wishlist_proto_rawDescGZIP(), []int{2}
+}
+
+type ListWishlistResponseItem struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	WishlistId        string                 `protobuf:"bytes,1,opt,name=wishlist_id,json=wishlistId,proto3" json:"wishlist_id,omitempty"`
+	ProductId         string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName       string                 `protobuf:"bytes,3,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	ProductImageUrl   string                 `protobuf:"bytes,4,opt,name=product_image_url,json=productImageUrl,proto3" json:"product_image_url,omitempty"`
+	ProductPrice      float64                `protobuf:"fixed64,5,opt,name=product_price,json=productPrice,proto3" json:"product_price,omitempty"`
+	PriceWhenAdded    float64                `protobuf:"fixed64,6,opt,name=price_when_added,json=priceWhenAdded,proto3" json:"price_when_added,omitempty"`
+	PriceDropped      bool                   `protobuf:"varint,7,opt,name=price_dropped,json=priceDropped,proto3" json:"price_dropped,omitempty"`
+	InStock           bool                   `protobuf:"varint,8,opt,name=in_stock,json=inStock,proto3" json:"in_stock,omitempty"`
+	VariantId         string                 `protobuf:"bytes,9,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	VariantSku        string                 `protobuf:"bytes,10,opt,name=variant_sku,json=variantSku,proto3" json:"variant_sku,omitempty"`
+	VariantAttributes map[string]string      `protobuf:"bytes,11,rep,name=variant_attributes,json=variantAttributes,proto3" json:"variant_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListWishlistResponseItem) Reset() {
+	*x = ListWishlistResponseItem{}
+	mi := &file_wishlist_wishlist_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWishlistResponseItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWishlistResponseItem) ProtoMessage() {}
+
+func (x *ListWishlistResponseItem) ProtoReflect() protoreflect.Message {
+	mi := &file_wishlist_wishlist_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWishlistResponseItem.ProtoReflect.Descriptor instead.
+func (*ListWishlistResponseItem) Descriptor() ([]byte, []int) {
+	return file_wishlist_wishlist_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListWishlistResponseItem) GetWishlistId() string {
+	if x != nil {
+		return x.WishlistId
+	}
+	return ""
+}
+
+func (x *ListWishlistResponseItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ListWishlistResponseItem) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *ListWishlistResponseItem) GetProductImageUrl() string {
+	if x != nil {
+		return x.ProductImageUrl
+	}
+	return ""
+}
+
+func (x *ListWishlistResponseItem) GetProductPrice() float64 {
+	if x != nil {
+		return x.ProductPrice
+	}
+	return 0
+}
+
+func (x *ListWishlistResponseItem) GetPriceWhenAdded() float64 {
+	if x != nil {
+		return x.PriceWhenAdded
+	}
+	return 0
+}
+
+func (x *ListWishlistResponseItem) GetPriceDropped() bool {
+	if x != nil {
+		return x.PriceDropped
+	}
+	return false
+}
+
+func (x *ListWishlistResponseItem) GetInStock() bool {
+	if x != nil {
+		return x.InStock
+	}
+	return false
+}
+
+func (x *ListWishlistResponseItem) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *ListWishlistResponseItem) GetVariantSku() string {
+	if x != nil {
+		return x.VariantSku
+	}
+	return ""
+}
+
+func (x *ListWishlistResponseItem) GetVariantAttributes() map[string]string {
+	if x != nil {
+		return x.VariantAttributes
+	}
+	return nil
+}
+
+func (x *ListWishlistResponseItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListWishlistResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Base          *common.BaseResponse        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Items         []*ListWishlistResponseItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWishlistResponse) Reset() {
+	*x = ListWishlistResponse{}
+	mi := &file_wishlist_wishlist_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWishlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWishlistResponse) ProtoMessage() {}
+
+func (x *ListWishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wishlist_wishlist_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWishlistResponse.ProtoReflect.Descriptor instead.
+func (*ListWishlistResponse) Descriptor() ([]byte, []int) {
+	return file_wishlist_wishlist_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListWishlistResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ListWishlistResponse) GetItems() []*ListWishlistResponseItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type DeleteWishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WishlistId    string                 `protobuf:"bytes,1,opt,name=wishlist_id,json=wishlistId,proto3" json:"wishlist_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWishlistRequest) Reset() {
+	*x = DeleteWishlistRequest{}
+	mi := &file_wishlist_wishlist_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWishlistRequest) ProtoMessage() {}
+
+func (x *DeleteWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wishlist_wishlist_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWishlistRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_wishlist_wishlist_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteWishlistRequest) GetWishlistId() string {
+	if x != nil {
+		return x.WishlistId
+	}
+	return ""
+}
+
+type DeleteWishlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWishlistResponse) Reset() {
+	*x = DeleteWishlistResponse{}
+	mi := &file_wishlist_wishlist_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWishlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWishlistResponse) ProtoMessage() {}
+
+func (x *DeleteWishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wishlist_wishlist_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWishlistResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWishlistResponse) Descriptor() ([]byte, []int) {
+	return file_wishlist_wishlist_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteWishlistResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type MoveWishlistToCartRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	WishlistId string                 `protobuf:"bytes,1,opt,name=wishlist_id,json=wishlistId,proto3" json:"wishlist_id,omitempty"`
+	// picks the variant when the item was saved without one
+	VariantId     string `protobuf:"bytes,2,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveWishlistToCartRequest) Reset() {
+	*x = MoveWishlistToCartRequest{}
+	mi := &file_wishlist_wishlist_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveWishlistToCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveWishlistToCartRequest) ProtoMessage() {}
+
+func (x *MoveWishlistToCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wishlist_wishlist_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveWishlistToCartRequest.ProtoReflect.Descriptor instead.
+func (*MoveWishlistToCartRequest) Descriptor() ([]byte, []int) {
+	return file_wishlist_wishlist_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MoveWishlistToCartRequest) GetWishlistId() string {
+	if x != nil {
+		return x.WishlistId
+	}
+	return ""
+}
+
+func (x *MoveWishlistToCartRequest) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+type MoveWishlistToCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          *common.BaseResponse   `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CartId        string                 `protobuf:"bytes,2,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveWishlistToCartResponse) Reset() {
+	*x = MoveWishlistToCartResponse{}
+	mi := &file_wishlist_wishlist_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveWishlistToCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveWishlistToCartResponse) ProtoMessage() {}
+
+func (x *MoveWishlistToCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wishlist_wishlist_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveWishlistToCartResponse.ProtoReflect.Descriptor instead.
+func (*MoveWishlistToCartResponse) Descriptor() ([]byte, []int) {
+	return file_wishlist_wishlist_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MoveWishlistToCartResponse) GetBase() *common.BaseResponse {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *MoveWishlistToCartResponse) GetCartId() string {
+	if x != nil {
+		return x.CartId
+	}
+	return ""
+}
+
+var File_wishlist_wishlist_proto protoreflect.FileDescriptor
+
+const file_wishlist_wishlist_proto_rawDesc = "" +
+	"\n" +
+	"\x17wishlist/wishlist.proto\x12\bwishlist\x1a\x1acommon/base_response.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"q\n" +
+	"\x1bAddProductToWishlistRequest\x12)\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\tproductId\x12'\n" +
+	"\n" +
+	"variant_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\tvariantId\"X\n" +
+	"\x1cAddProductToWishlistResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\x15\n" +
+	"\x13ListWishlistRequest\"\xe3\x04\n" +
+	"\x18ListWishlistResponseItem\x12\x1f\n" +
+	"\vwishlist_id\x18\x01 \x01(\tR\n" +
+	"wishlistId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12!\n" +
+	"\fproduct_name\x18\x03 \x01(\tR\vproductName\x12*\n" +
+	"\x11product_image_url\x18\x04 \x01(\tR\x0fproductImageUrl\x12#\n" +
+	"\rproduct_price\x18\x05 \x01(\x01R\fproductPrice\x12(\n" +
+	"\x10price_when_added\x18\x06 \x01(\x01R\x0epriceWhenAdded\x12#\n" +
+	"\rprice_dropped\x18\a \x01(\bR\fpriceDropped\x12\x19\n" +
+	"\bin_stock\x18\b \x01(\bR\ainStock\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\t \x01(\tR\tvariantId\x12\x1f\n" +
+	"\vvariant_sku\x18\n" +
+	" \x01(\tR\n" +
+	"variantSku\x12h\n" +
+	"\x12variant_attributes\x18\v \x03(\v29.wishlist.ListWishlistResponseItem.VariantAttributesEntryR\x11variantAttributes\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1aD\n" +
+	"\x16VariantAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"z\n" +
+	"\x14ListWishlistResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x128\n" +
+	"\x05items\x18\x02 \x03(\v2\".wishlist.ListWishlistResponseItemR\x05items\"D\n" +
+	"\x15DeleteWishlistRequest\x12+\n" +
+	"\vwishlist_id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\n" +
+	"wishlistId\"B\n" +
+	"\x16DeleteWishlistResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\"q\n" +
+	"\x19MoveWishlistToCartRequest\x12+\n" +
+	"\vwishlist_id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\n" +
+	"wishlistId\x12'\n" +
+	"\n" +
+	"variant_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\tvariantId\"_\n" +
+	"\x1aMoveWishlistToCartResponse\x12(\n" +
+	"\x04base\x18\x01 \x01(\v2\x14.common.BaseResponseR\x04base\x12\x17\n" +
+	"\acart_id\x18\x02 \x01(\tR\x06cartId2\xfd\x02\n" +
+	"\x0fWishlistService\x12e\n" +
+	"\x14AddProductToWishlist\x12%.wishlist.AddProductToWishlistRequest\x1a&.wishlist.AddProductToWishlistResponse\x12M\n" +
+	"\fListWishlist\x12\x1d.wishlist.ListWishlistRequest\x1a\x1e.wishlist.ListWishlistResponse\x12S\n" +
+	"\x0eDeleteWishlist\x12\x1f.wishlist.DeleteWishlistRequest\x1a .wishlist.DeleteWishlistResponse\x12_\n" +
+	"\x12MoveWishlistToCart\x12#.wishlist.MoveWishlistToCartRequest\x1a$.wishlist.MoveWishlistToCartResponseB4Z2github.com/xryar/golang-grpc-ecommerce/pb/wishlistb\x06proto3"
+
+var (
+	file_wishlist_wishlist_proto_rawDescOnce sync.Once
+	file_wishlist_wishlist_proto_rawDescData []byte
+)
+
+func file_wishlist_wishlist_proto_rawDescGZIP() []byte {
+	file_wishlist_wishlist_proto_rawDescOnce.Do(func() {
+		file_wishlist_wishlist_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_wishlist_wishlist_proto_rawDesc), len(file_wishlist_wishlist_proto_rawDesc)))
+	})
+	return file_wishlist_wishlist_proto_rawDescData
+}
+
+var file_wishlist_wishlist_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_wishlist_wishlist_proto_goTypes = []any{
+	(*AddProductToWishlistRequest)(nil),  // 0: wishlist.AddProductToWishlistRequest
+	(*AddProductToWishlistResponse)(nil), // 1: wishlist.AddProductToWishlistResponse
+	(*ListWishlistRequest)(nil),          // 2: wishlist.ListWishlistRequest
+	(*ListWishlistResponseItem)(nil),     // 3: wishlist.ListWishlistResponseItem
+	(*ListWishlistResponse)(nil),         // 4: wishlist.ListWishlistResponse
+	(*DeleteWishlistRequest)(nil),        // 5: wishlist.DeleteWishlistRequest
+	(*DeleteWishlistResponse)(nil),       // 6: wishlist.DeleteWishlistResponse
+	(*MoveWishlistToCartRequest)(nil),    // 7: wishlist.MoveWishlistToCartRequest
+	(*MoveWishlistToCartResponse)(nil),   // 8: wishlist.MoveWishlistToCartResponse
+	nil,                                  // 9: wishlist.ListWishlistResponseItem.VariantAttributesEntry
+	(*common.BaseResponse)(nil),          // 10: common.BaseResponse
+	(*timestamppb.Timestamp)(nil),        // 11: google.protobuf.Timestamp
+}
+var file_wishlist_wishlist_proto_depIdxs = []int32{
+	10, // 0: wishlist.AddProductToWishlistResponse.base:type_name -> common.BaseResponse
+	9,  // 1: wishlist.ListWishlistResponseItem.variant_attributes:type_name -> wishlist.ListWishlistResponseItem.VariantAttributesEntry
+	11, // 2: wishlist.ListWishlistResponseItem.created_at:type_name -> google.protobuf.Timestamp
+	10, // 3: wishlist.ListWishlistResponse.base:type_name -> common.BaseResponse
+	3,  // 4: wishlist.ListWishlistResponse.items:type_name -> wishlist.ListWishlistResponseItem
+	10, // 5: wishlist.DeleteWishlistResponse.base:type_name -> common.BaseResponse
+	10, // 6: wishlist.MoveWishlistToCartResponse.base:type_name -> common.BaseResponse
+	0,  // 7: wishlist.WishlistService.AddProductToWishlist:input_type -> wishlist.AddProductToWishlistRequest
+	2,  // 8: wishlist.WishlistService.ListWishlist:input_type -> wishlist.ListWishlistRequest
+	5,  // 9: wishlist.WishlistService.DeleteWishlist:input_type -> wishlist.DeleteWishlistRequest
+	7,  // 10: wishlist.WishlistService.MoveWishlistToCart:input_type -> wishlist.MoveWishlistToCartRequest
+	1,  // 11: wishlist.WishlistService.AddProductToWishlist:output_type -> wishlist.AddProductToWishlistResponse
+	4,  // 12: wishlist.WishlistService.ListWishlist:output_type -> wishlist.ListWishlistResponse
+	6,  // 13: wishlist.WishlistService.DeleteWishlist:output_type -> wishlist.DeleteWishlistResponse
+	8,  // 14: wishlist.WishlistService.MoveWishlistToCart:output_type -> wishlist.MoveWishlistToCartResponse
+	11, // [11:15] is the sub-list for method output_type
+	7,  // [7:11] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_wishlist_wishlist_proto_init() }
+func file_wishlist_wishlist_proto_init() {
+	if File_wishlist_wishlist_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wishlist_wishlist_proto_rawDesc), len(file_wishlist_wishlist_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_wishlist_wishlist_proto_goTypes,
+		DependencyIndexes: file_wishlist_wishlist_proto_depIdxs,
+		MessageInfos:      file_wishlist_wishlist_proto_msgTypes,
+	}.Build()
+	File_wishlist_wishlist_proto = out.File
+	file_wishlist_wishlist_proto_goTypes = nil
+	file_wishlist_wishlist_proto_depIdxs = nil
+}